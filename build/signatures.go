@@ -0,0 +1,343 @@
+package build
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/kinecosystem/go/keypair"
+	"github.com/kinecosystem/go/network"
+	"github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// ThresholdLevel is one of the three thresholds of an account that the
+// signatures of a transaction must meet.
+type ThresholdLevel int
+
+const (
+	// ThresholdLow is needed by the transaction itself, and by allow trust,
+	// bump sequence and inflation operations.
+	ThresholdLow ThresholdLevel = iota
+	// ThresholdMedium is needed by all the other operations.
+	ThresholdMedium
+	// ThresholdHigh is needed by account merges, and by set options
+	// operations changing the signers or thresholds of the account.
+	ThresholdHigh
+)
+
+// String returns the name of the threshold level
+func (l ThresholdLevel) String() string {
+	switch l {
+	case ThresholdLow:
+		return "low"
+	case ThresholdMedium:
+		return "medium"
+	case ThresholdHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// OperationThreshold returns the threshold of its source account `op` needs.
+func OperationThreshold(op xdr.Operation) ThresholdLevel {
+	switch op.Body.Type {
+	case xdr.OperationTypeAllowTrust,
+		xdr.OperationTypeBumpSequence,
+		xdr.OperationTypeInflation:
+		return ThresholdLow
+	case xdr.OperationTypeAccountMerge:
+		return ThresholdHigh
+	case xdr.OperationTypeSetOptions:
+		so := op.Body.MustSetOptionsOp()
+		if so.MasterWeight != nil || so.LowThreshold != nil ||
+			so.MedThreshold != nil || so.HighThreshold != nil || so.Signer != nil {
+			return ThresholdHigh
+		}
+		return ThresholdMedium
+	default:
+		return ThresholdMedium
+	}
+}
+
+// AccountSigners are the signers and thresholds of an account, as used to
+// verify the signatures of the transactions it is a source account of.
+type AccountSigners struct {
+	AccountID string
+	// Thresholds holds the weight of the master key followed by the low,
+	// medium and high thresholds, as in xdr.AccountEntry.
+	Thresholds xdr.Thresholds
+	// Signers are the additional signers of the account.
+	Signers []xdr.Signer
+}
+
+// SignersFromAccountEntry returns the signers of a ledger account entry.
+func SignersFromAccountEntry(entry xdr.AccountEntry) AccountSigners {
+	return AccountSigners{
+		AccountID:  entry.AccountId.Address(),
+		Thresholds: entry.Thresholds,
+		Signers:    entry.Signers,
+	}
+}
+
+// SignersFromHorizonAccount returns the signers of an account loaded from
+// horizon, where the master key is listed along with the other signers.
+func SignersFromHorizonAccount(account horizon.Account) (AccountSigners, error) {
+	result := AccountSigners{
+		AccountID: account.AccountID,
+		Thresholds: xdr.Thresholds{
+			0,
+			account.Thresholds.LowThreshold,
+			account.Thresholds.MedThreshold,
+			account.Thresholds.HighThreshold,
+		},
+	}
+
+	for _, s := range account.Signers {
+		if s.Key == account.AccountID {
+			result.Thresholds[0] = byte(s.Weight)
+			continue
+		}
+
+		signer := xdr.Signer{Weight: xdr.Uint32(s.Weight)}
+		err := signer.Key.SetAddress(s.Key)
+		if err != nil {
+			return AccountSigners{}, errors.Wrapf(err, "invalid signer %s", s.Key)
+		}
+		result.Signers = append(result.Signers, signer)
+	}
+
+	return result, nil
+}
+
+// signers returns all the signers of the account, including its master key.
+func (a AccountSigners) signers() ([]xdr.Signer, error) {
+	var signers []xdr.Signer
+
+	if a.Thresholds[0] > 0 {
+		master := xdr.Signer{Weight: xdr.Uint32(a.Thresholds[0])}
+		err := master.Key.SetAddress(a.AccountID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid account id %s", a.AccountID)
+		}
+		signers = append(signers, master)
+	}
+
+	return append(signers, a.Signers...), nil
+}
+
+// ThresholdCheck is the outcome of checking the signatures of a transaction
+// against a threshold of one of its source accounts.
+type ThresholdCheck struct {
+	Account string
+	Level   ThresholdLevel
+	// Threshold is the weight needed to meet the threshold.
+	Threshold byte
+	// Weight is the total weight of the account signers that signed.
+	Weight uint32
+	// Met is true when the signatures meet the threshold.
+	Met bool
+	// Missing are the signers of the account that haven't signed yet.
+	// Pre-authorized transaction signers are left out, as they can't sign.
+	Missing []xdr.Signer
+}
+
+// SignatureReport is the outcome of checking the signatures of a transaction
+// envelope against the signers of its source accounts.
+type SignatureReport struct {
+	// Transaction is the check of the low threshold of the transaction source
+	// account, needed to use its sequence number and pay the fee.
+	Transaction ThresholdCheck
+	// Operations holds the check of every operation of the transaction, in
+	// order.
+	Operations []ThresholdCheck
+	// Extraneous holds the indexes of the envelope signatures that match no
+	// signer, which makes the transaction fail with txBAD_AUTH_EXTRA.
+	Extraneous []int
+}
+
+// Met returns true when the transaction and all of its operations meet their
+// thresholds, and the envelope has no extraneous signatures.
+func (r *SignatureReport) Met() bool {
+	if !r.Transaction.Met || len(r.Extraneous) > 0 {
+		return false
+	}
+
+	for _, op := range r.Operations {
+		if !op.Met {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifySignatures checks the signatures of `txe`, signed for the network
+// identified by `passphrase`, against the signers of its source accounts.
+// `accounts` must include every source account of the transaction and its
+// operations.
+func VerifySignatures(
+	passphrase string,
+	txe xdr.TransactionEnvelope,
+	accounts ...AccountSigners,
+) (*SignatureReport, error) {
+	hash, err := network.HashTransaction(&txe.Tx, passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "hash tx failed")
+	}
+
+	byID := map[string]AccountSigners{}
+	for _, a := range accounts {
+		byID[a.AccountID] = a
+	}
+
+	checker := &signatureChecker{
+		hash:       hash,
+		signatures: txe.Signatures,
+		used:       make([]bool, len(txe.Signatures)),
+	}
+
+	check := func(source xdr.AccountId, level ThresholdLevel) (ThresholdCheck, error) {
+		account, ok := byID[source.Address()]
+		if !ok {
+			return ThresholdCheck{}, errors.Errorf("missing signers of account %s", source.Address())
+		}
+		return checker.check(account, level)
+	}
+
+	var report SignatureReport
+	report.Transaction, err = check(txe.Tx.SourceAccount, ThresholdLow)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range txe.Tx.Operations {
+		source := txe.Tx.SourceAccount
+		if op.SourceAccount != nil {
+			source = *op.SourceAccount
+		}
+
+		result, err := check(source, OperationThreshold(op))
+		if err != nil {
+			return nil, errors.Wrapf(err, "operation %d", i)
+		}
+		report.Operations = append(report.Operations, result)
+	}
+
+	for i, used := range checker.used {
+		if !used {
+			report.Extraneous = append(report.Extraneous, i)
+		}
+	}
+
+	return &report, nil
+}
+
+// VerifySignatures checks the signatures of the builder's envelope against
+// the signers of its source accounts.  See VerifySignatures.
+func (b *TransactionEnvelopeBuilder) VerifySignatures(
+	accounts ...AccountSigners,
+) (*SignatureReport, error) {
+	b.Init()
+	return VerifySignatures(b.child.NetworkPassphrase, *b.E, accounts...)
+}
+
+// signatureChecker matches the signatures of a transaction to signers,
+// keeping track of the signatures used by any of the checks.
+type signatureChecker struct {
+	hash       [32]byte
+	signatures []xdr.DecoratedSignature
+	used       []bool
+}
+
+// check computes the weight of the signers of `account` that signed the
+// transaction, following the rules of stellar-core: pre-authorized
+// transaction signers sign by matching the transaction hash, every other
+// signer by a signature, and every signer counts once with a weight of at
+// most 255.
+func (c *signatureChecker) check(
+	account AccountSigners,
+	level ThresholdLevel,
+) (ThresholdCheck, error) {
+	result := ThresholdCheck{
+		Account:   account.AccountID,
+		Level:     level,
+		Threshold: account.Thresholds[1+int(level)],
+	}
+
+	signers, err := account.signers()
+	if err != nil {
+		return result, err
+	}
+
+	signed := make([]bool, len(signers))
+	matched := 0
+	sign := func(i int) {
+		signed[i] = true
+		matched++
+
+		weight := uint32(signers[i].Weight)
+		if weight > 255 {
+			weight = 255
+		}
+		result.Weight += weight
+	}
+
+	for i, signer := range signers {
+		if signer.Key.Type != xdr.SignerKeyTypeSignerKeyTypePreAuthTx {
+			continue
+		}
+		if signer.Key.MustPreAuthTx() == xdr.Uint256(c.hash) {
+			sign(i)
+		}
+	}
+
+	for j, sig := range c.signatures {
+		for i, signer := range signers {
+			if signed[i] || !c.verify(signer.Key, sig) {
+				continue
+			}
+			c.used[j] = true
+			sign(i)
+			break
+		}
+	}
+
+	result.Met = matched > 0 && result.Weight >= uint32(result.Threshold)
+
+	for i, signer := range signers {
+		if signed[i] || signer.Key.Type == xdr.SignerKeyTypeSignerKeyTypePreAuthTx {
+			continue
+		}
+		result.Missing = append(result.Missing, signer)
+	}
+
+	return result, nil
+}
+
+// verify returns true if `sig` is a signature of the transaction by `key`.
+func (c *signatureChecker) verify(key xdr.SignerKey, sig xdr.DecoratedSignature) bool {
+	switch key.Type {
+	case xdr.SignerKeyTypeSignerKeyTypeEd25519:
+		raw := key.MustEd25519()
+		if !bytes.Equal(sig.Hint[:], raw[28:]) {
+			return false
+		}
+
+		kp, err := keypair.Parse(key.Address())
+		if err != nil {
+			return false
+		}
+		return kp.Verify(c.hash[:], sig.Signature) == nil
+	case xdr.SignerKeyTypeSignerKeyTypeHashX:
+		raw := key.MustHashX()
+		if !bytes.Equal(sig.Hint[:], raw[28:]) {
+			return false
+		}
+
+		// The signature of a hash(x) signer is the preimage x.
+		return sha256.Sum256(sig.Signature) == [32]byte(raw)
+	default:
+		return false
+	}
+}
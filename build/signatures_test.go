@@ -0,0 +1,174 @@
+package build
+
+import (
+	"crypto/sha256"
+
+	"github.com/kinecosystem/go/keypair"
+	"github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/xdr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VerifySignatures", func() {
+	var (
+		master  *keypair.Full
+		other   *keypair.Full
+		cosign  *keypair.Full
+		x       []byte
+		hashX   [32]byte
+		account AccountSigners
+		tx      *TransactionBuilder
+		txe     TransactionEnvelopeBuilder
+		report  *SignatureReport
+		err     error
+	)
+
+	signer := func(address string, weight uint32) xdr.Signer {
+		s := xdr.Signer{Weight: xdr.Uint32(weight)}
+		Expect(s.Key.SetAddress(address)).To(Succeed())
+		return s
+	}
+
+	sign := func(kps ...*keypair.Full) {
+		hash, err := tx.Hash()
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, kp := range kps {
+			sig, err := kp.SignDecorated(hash[:])
+			Expect(err).NotTo(HaveOccurred())
+			txe.E.Signatures = append(txe.E.Signatures, sig)
+		}
+	}
+
+	BeforeEach(func() {
+		master = keypair.MustParse("SDOTALIMPAM2IV65IOZA7KZL7XWZI5BODFXTRVLIHLQZQCKK57PH5F3H").(*keypair.Full)
+		other, err = keypair.Random()
+		Expect(err).NotTo(HaveOccurred())
+		cosign, err = keypair.Random()
+		Expect(err).NotTo(HaveOccurred())
+
+		x = []byte("a preimage of thirty-two bytes..")
+		hashX = sha256.Sum256(x)
+		hashXAddress := xdr.SignerKey{Type: xdr.SignerKeyTypeSignerKeyTypeHashX, HashX: (*xdr.Uint256)(&hashX)}
+
+		account = AccountSigners{
+			AccountID:  master.Address(),
+			Thresholds: xdr.Thresholds{1, 1, 2, 3},
+			Signers: []xdr.Signer{
+				signer(cosign.Address(), 1),
+				signer(hashXAddress.Address(), 1),
+			},
+		}
+
+		tx, err = Transaction(
+			SourceAccount{master.Address()},
+			Sequence{1},
+			TestNetwork,
+			Payment(
+				Destination{"GAWSI2JO2CF36Z43UGMUJCDQ2IMR5B3P5TMS7XM7NUTU3JHG3YJUDQXA"},
+				NativeAmount{"5000"},
+			),
+			AddSigner(other.Address(), 1),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		txe, err = tx.Envelope()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() { report, err = txe.VerifySignatures(account) })
+
+	Context("signed by the master key", func() {
+		BeforeEach(func() { sign(master) })
+
+		It("meets the low threshold only", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Transaction.Met).To(BeTrue())
+			Expect(report.Transaction.Level).To(Equal(ThresholdLow))
+			Expect(report.Operations).To(HaveLen(2))
+
+			Expect(report.Operations[0].Level).To(Equal(ThresholdMedium))
+			Expect(report.Operations[0].Met).To(BeFalse())
+			Expect(report.Operations[0].Weight).To(BeEquivalentTo(1))
+			Expect(report.Operations[0].Missing).To(Equal(account.Signers))
+
+			Expect(report.Operations[1].Level).To(Equal(ThresholdHigh))
+			Expect(report.Operations[1].Met).To(BeFalse())
+
+			Expect(report.Extraneous).To(BeEmpty())
+			Expect(report.Met()).To(BeFalse())
+		})
+	})
+
+	Context("signed by every signer", func() {
+		BeforeEach(func() {
+			sign(master, cosign)
+			txe.E.Signatures = append(txe.E.Signatures, xdr.DecoratedSignature{
+				Hint:      xdr.SignatureHint{hashX[28], hashX[29], hashX[30], hashX[31]},
+				Signature: xdr.Signature(x),
+			})
+		})
+
+		It("meets all the thresholds", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Operations[1].Weight).To(BeEquivalentTo(3))
+			Expect(report.Operations[1].Missing).To(BeEmpty())
+			Expect(report.Met()).To(BeTrue())
+		})
+	})
+
+	Context("signed by a key that isn't a signer", func() {
+		BeforeEach(func() { sign(master, cosign, other) })
+
+		It("reports the extraneous signature", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Operations[0].Met).To(BeTrue())
+			Expect(report.Extraneous).To(Equal([]int{2}))
+			Expect(report.Met()).To(BeFalse())
+		})
+	})
+
+	Context("pre-authorized", func() {
+		BeforeEach(func() {
+			hash, err := tx.Hash()
+			Expect(err).NotTo(HaveOccurred())
+
+			account.Thresholds = xdr.Thresholds{0, 1, 1, 1}
+			account.Signers = []xdr.Signer{{
+				Key:    xdr.SignerKey{Type: xdr.SignerKeyTypeSignerKeyTypePreAuthTx, PreAuthTx: (*xdr.Uint256)(&hash)},
+				Weight: 1,
+			}}
+		})
+
+		It("meets the thresholds without signatures", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Met()).To(BeTrue())
+			Expect(report.Transaction.Missing).To(BeEmpty())
+		})
+	})
+
+	Context("missing the signers of a source account", func() {
+		BeforeEach(func() { account.AccountID = other.Address() })
+
+		It("fails", func() { Expect(err).To(HaveOccurred()) })
+	})
+})
+
+var _ = Describe("SignersFromHorizonAccount", func() {
+	It("splits the master key from the signers", func() {
+		var account horizon.Account
+		account.AccountID = "GAWSI2JO2CF36Z43UGMUJCDQ2IMR5B3P5TMS7XM7NUTU3JHG3YJUDQXA"
+		account.Thresholds = horizon.AccountThresholds{LowThreshold: 1, MedThreshold: 2, HighThreshold: 3}
+		account.Signers = []horizon.Signer{
+			{Key: "GAWSI2JO2CF36Z43UGMUJCDQ2IMR5B3P5TMS7XM7NUTU3JHG3YJUDQXA", Weight: 2, Type: "ed25519_public_key"},
+			{Key: "GAXEMCEXBERNSRXOEKD4JAIKVECIXQCENHEBRVSPX2TTYZPMNEDSQCNQ", Weight: 1, Type: "ed25519_public_key"},
+		}
+
+		signers, err := SignersFromHorizonAccount(account)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signers.Thresholds).To(Equal(xdr.Thresholds{2, 1, 2, 3}))
+		Expect(signers.Signers).To(HaveLen(1))
+		Expect(signers.Signers[0].Key.Address()).To(Equal("GAXEMCEXBERNSRXOEKD4JAIKVECIXQCENHEBRVSPX2TTYZPMNEDSQCNQ"))
+	})
+})
@@ -1,18 +1,14 @@
 package horizon
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 
-	"github.com/manucorporat/sse"
 	"github.com/kinecosystem/go/support/errors"
 	"github.com/kinecosystem/go/xdr"
 )
@@ -110,6 +106,180 @@ func (c *Client) LoadAccountOffers(
 	return
 }
 
+// LoadAccountData loads the value of the data entry named `key` of an account.
+// err can be either error object or horizon.Error object.
+func (c *Client) LoadAccountData(accountID string, key string) (data AccountData, err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	endpoint, err := buildEndpoint(c.URL, fmt.Sprintf("accounts/%s/data/%s", accountID, url.PathEscape(key)), nil)
+	if err != nil {
+		return
+	}
+
+	err = c.load(endpoint, &data)
+	return
+}
+
+// LoadAssets loads a page of the assets matching `request`. err can be either
+// error object or horizon.Error object.
+func (c *Client) LoadAssets(request AssetRequest) (assets AssetsPage, err error) {
+	err = c.loadRequest(request, &assets)
+	return
+}
+
+// LoadBalanceHistory loads a page of the balance history of an account.  err
+// can be either error object or horizon.Error object.
+func (c *Client) LoadBalanceHistory(
+	request BalanceHistoryRequest,
+) (history BalanceHistoryPage, err error) {
+	err = c.loadRequest(request, &history)
+	return
+}
+
+// LoadEffects loads a page of the effects matching `request`. err can be
+// either error object or horizon.Error object.
+func (c *Client) LoadEffects(request EffectRequest) (effects EffectsPage, err error) {
+	err = c.loadRequest(request, &effects)
+	return
+}
+
+// LoadLedger loads a single ledger by sequence. err can be either error object
+// or horizon.Error object.
+func (c *Client) LoadLedger(sequence int32) (ledger Ledger, err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	err = c.load(fmt.Sprintf("%s/ledgers/%d", c.URL, sequence), &ledger)
+	return
+}
+
+// LoadLedgers loads a page of ledgers. err can be either error object or
+// horizon.Error object.
+func (c *Client) LoadLedgers(request LedgerRequest) (ledgers LedgersPage, err error) {
+	err = c.loadRequest(request, &ledgers)
+	return
+}
+
+// LoadOffer loads a single offer by id. err can be either error object or
+// horizon.Error object.
+func (c *Client) LoadOffer(offerID int64) (offer Offer, err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	err = c.load(fmt.Sprintf("%s/offers/%d", c.URL, offerID), &offer)
+	return
+}
+
+// LoadOffers loads a page of the offers matching `request`. err can be either
+// error object or horizon.Error object.
+func (c *Client) LoadOffers(request OfferRequest) (offers OffersPage, err error) {
+	err = c.loadRequest(request, &offers)
+	return
+}
+
+// LoadOperationFeeStats loads the fees accepted in the recent ledgers. err can
+// be either error object or horizon.Error object.
+func (c *Client) LoadOperationFeeStats() (stats OperationFeeStats, err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	err = c.load(c.URL+"/operation_fee_stats", &stats)
+	return
+}
+
+// LoadOperations loads a page of the operations matching `request`. err can
+// be either error object or horizon.Error object.
+func (c *Client) LoadOperations(request OperationRequest) (ops OperationsPage, err error) {
+	err = c.loadRequest(request, &ops)
+	return
+}
+
+// LoadPaths loads the payment paths matching `request`. err can be either
+// error object or horizon.Error object.
+func (c *Client) LoadPaths(request PathRequest) (paths PathsPage, err error) {
+	err = c.loadRequest(request, &paths)
+	return
+}
+
+// LoadPayments loads a page of the payments matching `request`. err can be
+// either error object or horizon.Error object.
+func (c *Client) LoadPayments(request PaymentRequest) (payments PaymentsPage, err error) {
+	err = c.loadRequest(request, &payments)
+	return
+}
+
+// LoadStrictSendPaths loads the payment paths matching `request`. err can be
+// either error object or horizon.Error object.
+func (c *Client) LoadStrictSendPaths(request StrictSendPathRequest) (paths PathsPage, err error) {
+	err = c.loadRequest(request, &paths)
+	return
+}
+
+// LoadTradesPage loads a page of the trades matching `request`. Unlike
+// LoadTrades, none of its filters are required. err can be either error
+// object or horizon.Error object.
+func (c *Client) LoadTradesPage(request TradeRequest) (trades TradesPage, err error) {
+	err = c.loadRequest(request, &trades)
+	return
+}
+
+// LoadTransactions loads a page of the transactions matching `request`. err
+// can be either error object or horizon.Error object.
+func (c *Client) LoadTransactions(
+	request TransactionRequest,
+) (transactions TransactionsPage, err error) {
+	err = c.loadRequest(request, &transactions)
+	return
+}
+
+// LoadTransactionStatus loads the status of a transaction submitted with
+// SubmitTransactionAsync. err can be either error object or horizon.Error
+// object.
+func (c *Client) LoadTransactionStatus(hash string) (status TransactionStatus, err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	err = c.load(c.URL+"/transactions_async/"+hash, &status)
+	return
+}
+
+// Next loads the page following `page` into `next`, a pointer to a page of
+// the same type. err can be either error object or horizon.Error object.
+func (c *Client) Next(page Page, next Page) error {
+	href := page.PageLinks().Next.Href
+	if href == "" {
+		return errors.New("page has no next link")
+	}
+	return c.load(href, next)
+}
+
+// Prev loads the page preceding `page` into `prev`, a pointer to a page of
+// the same type. err can be either error object or horizon.Error object.
+func (c *Client) Prev(page Page, prev Page) error {
+	href := page.PageLinks().Prev.Href
+	if href == "" {
+		return errors.New("page has no prev link")
+	}
+	return c.load(href, prev)
+}
+
+// requestBuilder is implemented by the request types building the url of a
+// horizon endpoint.
+type requestBuilder interface {
+	BuildURL(horizonURL string) (string, error)
+}
+
+// loadRequest decodes the response of the endpoint built by `request` into
+// `dest`.
+func (c *Client) loadRequest(request requestBuilder, dest interface{}) error {
+	c.fixURLOnce.Do(c.fixURL)
+	endpoint, err := request.BuildURL(c.URL)
+	if err != nil {
+		return err
+	}
+	return c.load(endpoint, dest)
+}
+
+// load decodes the response of `endpoint` into `dest`.
+func (c *Client) load(endpoint string, dest interface{}) error {
+	resp, err := c.HTTP.Get(endpoint)
+	if err != nil {
+		return errors.Wrap(err, "failed to load endpoint")
+	}
+	return decodeResponse(resp, dest)
+}
+
 // LoadTradeAggregations loads the trade aggregation from horizon.
 func (c *Client) LoadTradeAggregations(
 	baseAsset Asset,
@@ -236,12 +406,16 @@ func (c *Client) LoadTransaction(transactionID string) (transaction Transaction,
 }
 
 func addAssetToQuery(v map[string][]string, assetPrefix string, asset Asset) {
+	if assetPrefix != "" {
+		assetPrefix += "_"
+	}
+
 	if asset.Type == "native" {
-		v[assetPrefix+"_asset_type"] = []string{asset.Type}
+		v[assetPrefix+"asset_type"] = []string{asset.Type}
 	} else {
-		v[assetPrefix+"_asset_type"] = []string{asset.Type}
-		v[assetPrefix+"_asset_code"] = []string{asset.Code}
-		v[assetPrefix+"_asset_issuer"] = []string{asset.Issuer}
+		v[assetPrefix+"asset_type"] = []string{asset.Type}
+		v[assetPrefix+"asset_code"] = []string{asset.Code}
+		v[assetPrefix+"asset_issuer"] = []string{asset.Issuer}
 	}
 }
 
@@ -259,6 +433,14 @@ func (c *Client) LoadOperation(operationID string) (payment Payment, err error)
 
 // LoadMemo loads memo for a transaction in Payment
 func (c *Client) LoadMemo(p *Payment) (err error) {
+	// newer versions of horizon include the memo of the transaction in the
+	// payments they return
+	if p.TransactionMemoType != "" {
+		p.Memo.Type = p.TransactionMemoType
+		p.Memo.Value = p.TransactionMemo
+		return nil
+	}
+
 	res, err := c.HTTP.Get(p.Links.Transaction.Href)
 	if err != nil {
 		return errors.Wrap(err, "load transaction failed")
@@ -348,123 +530,29 @@ func (c *Client) LoadOrderBook(
 	return
 }
 
-func (c *Client) stream(
+// StreamEffects streams the effects matching `request`. Use context.WithCancel
+// to stop streaming or context.Background() if you want to stream
+// indefinitely.
+func (c *Client) StreamEffects(
 	ctx context.Context,
-	baseURL string,
-	cursor *Cursor,
-	handler func(data []byte) error,
-) error {
-	query := url.Values{}
-	if cursor != nil {
-		query.Set("cursor", string(*cursor))
+	request EffectRequest,
+	handler EffectHandler,
+) (err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	endpoint, err := request.BuildURL(c.URL)
+	if err != nil {
+		return
 	}
 
-	client := http.Client{}
-
-	for {
-		req, err := http.NewRequest("GET", fmt.Sprintf("%s?%s", baseURL, query.Encode()), nil)
+	return c.stream(ctx, endpoint, func(data []byte) error {
+		var effect Effect
+		err = json.Unmarshal(data, &effect)
 		if err != nil {
-			return errors.Wrap(err, "Error creating HTTP request")
-		}
-		req.Header.Set("Accept", "text/event-stream")
-
-		// Make sure we don't use c.HTTP that can have Timeout set.
-		resp, err := client.Do(req)
-		if err != nil {
-			return errors.Wrap(err, "Error sending HTTP request")
-		}
-		if resp.StatusCode/100 != 2 {
-			return fmt.Errorf("Got bad HTTP status code %d", resp.StatusCode)
-		}
-		defer resp.Body.Close()
-
-		reader := bufio.NewReader(resp.Body)
-
-		// Read events one by one. Break this loop when there is no more data to be
-		// read from resp.Body (io.EOF).
-	Events:
-		for {
-			// Read until empty line = event delimiter. The perfect solution would be to read
-			// as many bytes as possible and forward them to sse.Decode. However this
-			// requires much more complicated code.
-			// We could also write our own `sse` package that works fine with streams directly
-			// (github.com/manucorporat/sse is just using io/ioutils.ReadAll).
-			var buffer bytes.Buffer
-			nonEmptylinesRead := 0
-			for {
-				// Check if ctx is not cancelled
-				select {
-				case <-ctx.Done():
-					return nil
-				default:
-					// Continue
-				}
-
-				line, err := reader.ReadString('\n')
-				if err != nil {
-					if err == io.EOF || err == io.ErrUnexpectedEOF {
-						// We catch EOF errors to handle two possible situations:
-						// - The last line before closing the stream was not empty. This should never
-						//   happen in Horizon as it always sends an empty line after each event.
-						// - The stream was closed by the server/proxy because the connection was idle.
-						//
-						// In the former case, that (again) should never happen in Horizon, we need to
-						// check if there are any events we need to decode. We do this in the `if`
-						// statement below just in case if Horizon behaviour changes in a future.
-						//
-						// From spec:
-						// > Once the end of the file is reached, the user agent must dispatch the
-						// > event one final time, as defined below.
-						if nonEmptylinesRead == 0 {
-							break Events
-						}
-					} else {
-						return errors.Wrap(err, "Error reading line")
-					}
-				}
-
-				buffer.WriteString(line)
-
-				if strings.TrimRight(line, "\n\r") == "" {
-					break
-				}
-
-				nonEmptylinesRead++
-			}
-
-			events, err := sse.Decode(strings.NewReader(buffer.String()))
-			if err != nil {
-				return errors.Wrap(err, "Error decoding event")
-			}
-
-			// Right now len(events) should always be 1. This loop will be helpful after writing
-			// new SSE decoder that can handle io.Reader without using ioutils.ReadAll().
-			for _, event := range events {
-				if event.Event != "message" {
-					continue
-				}
-
-				// Update cursor with event ID
-				if event.Id != "" {
-					query.Set("cursor", event.Id)
-				}
-
-				switch data := event.Data.(type) {
-				case string:
-					err = handler([]byte(data))
-					err = errors.Wrap(err, "Handler error")
-				case []byte:
-					err = handler(data)
-					err = errors.Wrap(err, "Handler error")
-				default:
-					err = errors.New("Invalid event.Data type")
-				}
-				if err != nil {
-					return err
-				}
-			}
+			return errors.Wrap(err, "Error unmarshaling data")
 		}
-	}
+		handler(effect)
+		return nil
+	})
 }
 
 // StreamLedgers streams incoming ledgers. Use context.WithCancel to stop streaming or
@@ -475,8 +563,12 @@ func (c *Client) StreamLedgers(
 	handler LedgerHandler,
 ) (err error) {
 	c.fixURLOnce.Do(c.fixURL)
-	url := fmt.Sprintf("%s/ledgers", c.URL)
-	return c.stream(ctx, url, cursor, func(data []byte) error {
+	endpoint, err := buildEndpoint(c.URL, "ledgers", cursorQuery(cursor))
+	if err != nil {
+		return
+	}
+
+	return c.stream(ctx, endpoint, func(data []byte) error {
 		var ledger Ledger
 		err = json.Unmarshal(data, &ledger)
 		if err != nil {
@@ -487,6 +579,56 @@ func (c *Client) StreamLedgers(
 	})
 }
 
+// StreamOperations streams the operations matching `request`. Use
+// context.WithCancel to stop streaming or context.Background() if you want to
+// stream indefinitely.
+func (c *Client) StreamOperations(
+	ctx context.Context,
+	request OperationRequest,
+	handler OperationHandler,
+) (err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	endpoint, err := request.BuildURL(c.URL)
+	if err != nil {
+		return
+	}
+
+	return c.stream(ctx, endpoint, func(data []byte) error {
+		var operation Operation
+		err = json.Unmarshal(data, &operation)
+		if err != nil {
+			return errors.Wrap(err, "Error unmarshaling data")
+		}
+		handler(operation)
+		return nil
+	})
+}
+
+// StreamOrderBook streams the order book of an asset pair, receiving the
+// whole order book every time it changes. Use context.WithCancel to stop
+// streaming or context.Background() if you want to stream indefinitely.
+func (c *Client) StreamOrderBook(
+	ctx context.Context,
+	request OrderBookRequest,
+	handler OrderBookHandler,
+) (err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	endpoint, err := request.BuildURL(c.URL)
+	if err != nil {
+		return
+	}
+
+	return c.stream(ctx, endpoint, func(data []byte) error {
+		var orderBook OrderBookSummary
+		err = json.Unmarshal(data, &orderBook)
+		if err != nil {
+			return errors.Wrap(err, "Error unmarshaling data")
+		}
+		handler(orderBook)
+		return nil
+	})
+}
+
 // StreamPayments streams payments, for which the given `accountID` was either the sender or receiver.
 // Use context.WithCancel to stop streaming or context.Background() if you want to stream indefinitely.
 func (c *Client) StreamPayments(
@@ -496,8 +638,16 @@ func (c *Client) StreamPayments(
 	handler PaymentHandler,
 ) (err error) {
 	c.fixURLOnce.Do(c.fixURL)
-	url := fmt.Sprintf("%s/accounts/%s/payments", c.URL, accountID)
-	return c.stream(ctx, url, cursor, func(data []byte) error {
+	endpoint, err := buildEndpoint(
+		c.URL,
+		fmt.Sprintf("accounts/%s/payments", accountID),
+		cursorQuery(cursor),
+	)
+	if err != nil {
+		return
+	}
+
+	return c.stream(ctx, endpoint, func(data []byte) error {
 		var payment Payment
 		err = json.Unmarshal(data, &payment)
 		if err != nil {
@@ -517,8 +667,16 @@ func (c *Client) StreamTransactions(
 	handler TransactionHandler,
 ) (err error) {
 	c.fixURLOnce.Do(c.fixURL)
-	url := fmt.Sprintf("%s/accounts/%s/transactions", c.URL, accountID)
-	return c.stream(ctx, url, cursor, func(data []byte) error {
+	endpoint, err := buildEndpoint(
+		c.URL,
+		fmt.Sprintf("accounts/%s/transactions", accountID),
+		cursorQuery(cursor),
+	)
+	if err != nil {
+		return
+	}
+
+	return c.stream(ctx, endpoint, func(data []byte) error {
 		var transaction Transaction
 		err = json.Unmarshal(data, &transaction)
 		if err != nil {
@@ -529,6 +687,52 @@ func (c *Client) StreamTransactions(
 	})
 }
 
+// StreamTrades streams the trades matching `request`. Use context.WithCancel
+// to stop streaming or context.Background() if you want to stream
+// indefinitely.
+func (c *Client) StreamTrades(
+	ctx context.Context,
+	request TradeRequest,
+	handler TradeHandler,
+) (err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	endpoint, err := request.BuildURL(c.URL)
+	if err != nil {
+		return
+	}
+
+	return c.stream(ctx, endpoint, func(data []byte) error {
+		var trade Trade
+		err = json.Unmarshal(data, &trade)
+		if err != nil {
+			return errors.Wrap(err, "Error unmarshaling data")
+		}
+		handler(trade)
+		return nil
+	})
+}
+
+// SubmitTransactionAsync submits a transaction to the network without waiting
+// for it to be applied.  The response holds stellar-core's status for the
+// transaction; follow its result with LoadTransactionStatus. err can be either
+// error object or horizon.Error object.
+func (c *Client) SubmitTransactionAsync(
+	transactionEnvelopeXdr string,
+) (response AsyncTransactionSubmission, err error) {
+	c.fixURLOnce.Do(c.fixURL)
+	v := url.Values{}
+	v.Set("tx", transactionEnvelopeXdr)
+
+	resp, err := c.HTTP.PostForm(c.URL+"/transactions_async", v)
+	if err != nil {
+		err = errors.Wrap(err, "http post failed")
+		return
+	}
+
+	err = decodeResponse(resp, &response)
+	return
+}
+
 // SubmitTransaction submits a transaction to the network. err can be either error object or horizon.Error object.
 func (c *Client) SubmitTransaction(
 	transactionEnvelopeXdr string,
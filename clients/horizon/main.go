@@ -42,10 +42,10 @@ type Limit uint
 // Order represents `order` param in queries
 type Order string
 
-// StartTime is an integer values of timestamp
+// StartTime is an integer values of timestamp, in milliseconds since epoch
 type StartTime int64
 
-// EndTime is an integer values of timestamp
+// EndTime is an integer values of timestamp, in milliseconds since epoch
 type EndTime int64
 
 const (
@@ -80,6 +80,10 @@ type Client struct {
 	// HTTP client to make requests with
 	HTTP HTTP
 
+	// StreamHTTP is the HTTP client to stream with. Streams are long lived, so
+	// it must not have a Timeout set. When nil, a default http.Client is used.
+	StreamHTTP HTTP
+
 	fixURLOnce sync.Once
 }
 
@@ -87,6 +91,7 @@ type ClientInterface interface {
 	Root() (Root, error)
 	HomeDomainForAccount(aid string) (string, error)
 	LoadAccount(accountID string) (Account, error)
+	LoadAccountData(accountID string, key string) (data AccountData, err error)
 	LoadAccountOffers(accountID string, params ...interface{}) (offers OffersPage, err error)
 	LoadTradeAggregations(
 		baseAsset Asset,
@@ -102,15 +107,37 @@ type ClientInterface interface {
 		params ...interface{},
 	) (tradesPage TradesPage, err error)
 	LoadAccountMergeAmount(p *Payment) error
+	LoadAssets(request AssetRequest) (assets AssetsPage, err error)
+	LoadBalanceHistory(request BalanceHistoryRequest) (history BalanceHistoryPage, err error)
+	LoadEffects(request EffectRequest) (effects EffectsPage, err error)
+	LoadLedger(sequence int32) (ledger Ledger, err error)
+	LoadLedgers(request LedgerRequest) (ledgers LedgersPage, err error)
 	LoadMemo(p *Payment) error
+	LoadOffer(offerID int64) (offer Offer, err error)
+	LoadOffers(request OfferRequest) (offers OffersPage, err error)
 	LoadOperation(operationID string) (payment Payment, err error)
+	LoadOperationFeeStats() (stats OperationFeeStats, err error)
+	LoadOperations(request OperationRequest) (ops OperationsPage, err error)
 	LoadOrderBook(selling Asset, buying Asset, params ...interface{}) (orderBook OrderBookSummary, err error)
+	LoadPaths(request PathRequest) (paths PathsPage, err error)
+	LoadPayments(request PaymentRequest) (payments PaymentsPage, err error)
+	LoadStrictSendPaths(request StrictSendPathRequest) (paths PathsPage, err error)
+	LoadTradesPage(request TradeRequest) (trades TradesPage, err error)
 	LoadTransaction(transactionID string) (transaction Transaction, err error)
+	LoadTransactions(request TransactionRequest) (transactions TransactionsPage, err error)
+	LoadTransactionStatus(hash string) (status TransactionStatus, err error)
+	Next(page Page, next Page) error
+	Prev(page Page, prev Page) error
 	SequenceForAccount(accountID string) (xdr.SequenceNumber, error)
+	StreamEffects(ctx context.Context, request EffectRequest, handler EffectHandler) error
 	StreamLedgers(ctx context.Context, cursor *Cursor, handler LedgerHandler) error
+	StreamOperations(ctx context.Context, request OperationRequest, handler OperationHandler) error
+	StreamOrderBook(ctx context.Context, request OrderBookRequest, handler OrderBookHandler) error
 	StreamPayments(ctx context.Context, accountID string, cursor *Cursor, handler PaymentHandler) error
+	StreamTrades(ctx context.Context, request TradeRequest, handler TradeHandler) error
 	StreamTransactions(ctx context.Context, accountID string, cursor *Cursor, handler TransactionHandler) error
 	SubmitTransaction(txeBase64 string) (TransactionSuccess, error)
+	SubmitTransactionAsync(txeBase64 string) (AsyncTransactionSubmission, error)
 }
 
 // Error struct contains the problem returned by Horizon
@@ -126,12 +153,24 @@ type HTTP interface {
 	PostForm(url string, data url.Values) (resp *http.Response, err error)
 }
 
+// EffectHandler is a function that is called when a new effect is received
+type EffectHandler func(Effect)
+
 // LedgerHandler is a function that is called when a new ledger is received
 type LedgerHandler func(Ledger)
 
+// OperationHandler is a function that is called when a new operation is received
+type OperationHandler func(Operation)
+
+// OrderBookHandler is a function that is called when the order book changes
+type OrderBookHandler func(OrderBookSummary)
+
 // PaymentHandler is a function that is called when a new payment is received
 type PaymentHandler func(Payment)
 
+// TradeHandler is a function that is called when a new trade is received
+type TradeHandler func(Trade)
+
 // TransactionHandler is a function that is called when a new transaction is received
 type TransactionHandler func(Transaction)
 
@@ -8,6 +8,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kinecosystem/go/protocols/horizon/effects"
+	"github.com/kinecosystem/go/protocols/horizon/operations"
 	"github.com/kinecosystem/go/support/errors"
 	"github.com/kinecosystem/go/support/http/httptest"
 	"github.com/stretchr/testify/assert"
@@ -267,6 +269,200 @@ func TestLoadTransaction(t *testing.T) {
 
 }
 
+func TestLoadTransactions(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		URL:  "https://localhost",
+		HTTP: hmock,
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/transactions?end_time=1524100800000&limit=1&start_time=1524096000000",
+	).ReturnString(200, accountTransactionsResponse)
+
+	transactions, err := client.LoadTransactions(TransactionRequest{
+		ForAccount: "GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4",
+		StartTime:  1524096000000,
+		EndTime:    1524100800000,
+		Limit:      1,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, len(transactions.Embedded.Records), 1)
+		assert.Equal(t, transactions.Embedded.Records[0].Hash, "a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312")
+		assert.Equal(t, transactions.Embedded.Records[0].Ledger, int32(17425656))
+	}
+}
+
+func TestLoadPayments(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		URL:  "https://localhost",
+		HTTP: hmock,
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/payments?order=desc&start_time=1524096000000",
+	).ReturnString(200, accountPaymentsResponse)
+
+	payments, err := client.LoadPayments(PaymentRequest{
+		ForAccount: "GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4",
+		StartTime:  1524096000000,
+		Order:      OrderDesc,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, len(payments.Embedded.Records), 1)
+		assert.Equal(t, payments.Embedded.Records[0].Type, "create_account")
+		assert.Equal(t, payments.Embedded.Records[0].Account, "GBMIWZ3LUYPZXCNO3WQGROMORGJK73CUYZMZTAVJ7GDN43XO7AFYNKDJ")
+		assert.Equal(t, payments.Embedded.Records[0].TransactionHash, "a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312")
+	}
+}
+
+func TestLoadMemo(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		URL:  "https://localhost",
+		HTTP: hmock,
+	}
+
+	// memo of the transaction included in the payment
+	payment := Payment{TransactionMemoType: "text", TransactionMemo: "deposit"}
+	err := client.LoadMemo(&payment)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "text", payment.Memo.Type)
+		assert.Equal(t, "deposit", payment.Memo.Value)
+	}
+
+	// memo loaded from the transaction
+	hmock.On(
+		"GET",
+		"https://localhost/transactions/a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312",
+	).ReturnString(200, transactionResponse)
+
+	payment = Payment{}
+	payment.Links.Transaction.Href = "https://localhost/transactions/a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312"
+	err = client.LoadMemo(&payment)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "none", payment.Memo.Type)
+	}
+}
+
+func TestLoadEffects(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		URL:  "https://localhost",
+		HTTP: hmock,
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/operations/43989725060534273/effects?limit=10",
+	).ReturnString(200, accountMergeEffectsResponse)
+
+	page, err := client.LoadEffects(EffectRequest{ForOperation: "43989725060534273", Limit: 10})
+	if assert.NoError(t, err) {
+		assert.Len(t, page.Embedded.Records, 3)
+		assert.Equal(t, "account_credited", page.Embedded.Records[1].Type)
+		assert.Equal(t, "9999.9999900", page.Embedded.Records[1].Amount)
+
+		var credited effects.AccountCredited
+		err = page.Embedded.Records[1].Decode(&credited)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "GBO7LQUWCC7M237TU2PAXVPOLLYNHYCYYFCLVMX3RBJCML4WA742X3UB", credited.Account)
+			assert.Equal(t, "native", credited.Asset.Type)
+		}
+	}
+
+	// following the links of the page
+	hmock.On(
+		"GET",
+		"https://horizon-testnet.stellar.org/operations/43989725060534273/effects?cursor=43989725060534273-3&limit=10&order=asc",
+	).ReturnString(200, emptyEffectsResponse)
+
+	var next EffectsPage
+	err = client.Next(page, &next)
+	if assert.NoError(t, err) {
+		assert.Len(t, next.Embedded.Records, 0)
+		assert.Equal(t, "https://horizon-testnet.stellar.org/operations/43989725060534273/effects?cursor=43989725060534273-3&limit=10&order=desc", next.Links.Prev.Href)
+	}
+
+	hmock.On(
+		"GET",
+		"https://horizon-testnet.stellar.org/operations/43989725060534273/effects?cursor=43989725060534273-3&limit=10&order=desc",
+	).ReturnString(200, accountMergeEffectsResponse)
+
+	var prev EffectsPage
+	err = client.Prev(next, &prev)
+	if assert.NoError(t, err) {
+		assert.Len(t, prev.Embedded.Records, 3)
+	}
+
+	// pages without links
+	err = client.Next(EffectsPage{}, &next)
+	assert.Error(t, err)
+}
+
+func TestLoadOperations(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		URL:  "https://localhost",
+		HTTP: hmock,
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/operations?include_failed=true&order=desc",
+	).ReturnString(200, accountOperationsResponse)
+
+	page, err := client.LoadOperations(OperationRequest{
+		ForAccount:    "GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4",
+		IncludeFailed: true,
+		Order:         OrderDesc,
+	})
+	if assert.NoError(t, err) {
+		assert.Len(t, page.Embedded.Records, 1)
+		op := page.Embedded.Records[0]
+		assert.Equal(t, "payment", op.Type)
+		assert.Equal(t, "74842622631374849", op.PT)
+
+		var payment operations.Payment
+		err = op.Decode(&payment)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "GBMIWZ3LUYPZXCNO3WQGROMORGJK73CUYZMZTAVJ7GDN43XO7AFYNKDJ", payment.To)
+			assert.Equal(t, "100.00000", payment.Amount)
+			assert.Equal(t, "74842622631374849", payment.PT)
+		}
+	}
+
+	// an operations request can only be scoped to one resource
+	_, err = client.LoadOperations(OperationRequest{ForAccount: "GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4", ForLedger: 1})
+	assert.Equal(t, ErrManyResourceFilters, err)
+}
+
+func TestLoadOperationFeeStats(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		URL:  "https://localhost",
+		HTTP: hmock,
+	}
+
+	hmock.On("GET", "https://localhost/operation_fee_stats").
+		ReturnString(200, operationFeeStatsResponse)
+
+	stats, err := client.LoadOperationFeeStats()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "100", stats.Min)
+		assert.Equal(t, "250", stats.P99)
+		assert.Equal(t, "0.97", stats.LedgerCapacityUsage)
+		assert.Equal(t, "22606298", stats.LastLedger)
+		if assert.Len(t, stats.LedgerMaxFees, 2) {
+			assert.Equal(t, "22606298", stats.LedgerMaxFees[0].Ledger)
+			assert.Equal(t, "300", stats.LedgerMaxFees[0].MaxFee)
+		}
+	}
+}
+
 func TestLoadOrderBook(t *testing.T) {
 	hmock := httptest.NewClient()
 	client := &Client{
@@ -507,6 +703,97 @@ var accountMergeEffectsResponse = `{
   }
 }`
 
+var emptyEffectsResponse = `{
+  "_links": {
+    "self": {
+      "href": "https://horizon-testnet.stellar.org/operations/43989725060534273/effects?cursor=43989725060534273-3&limit=10&order=asc"
+    },
+    "next": {
+      "href": "https://horizon-testnet.stellar.org/operations/43989725060534273/effects?cursor=43989725060534273-3&limit=10&order=asc"
+    },
+    "prev": {
+      "href": "https://horizon-testnet.stellar.org/operations/43989725060534273/effects?cursor=43989725060534273-3&limit=10&order=desc"
+    }
+  },
+  "_embedded": {
+    "records": []
+  }
+}`
+
+var accountOperationsResponse = `{
+  "_links": {
+    "self": {
+      "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/operations?cursor=&include_failed=true&limit=10&order=desc"
+    },
+    "next": {
+      "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/operations?cursor=74842622631374849&include_failed=true&limit=10&order=desc"
+    },
+    "prev": {
+      "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/operations?cursor=74842622631374849&include_failed=true&limit=10&order=asc"
+    }
+  },
+  "_embedded": {
+    "records": [
+      {
+        "_links": {
+          "self": {
+            "href": "https://horizon.stellar.org/operations/74842622631374849"
+          },
+          "transaction": {
+            "href": "https://horizon.stellar.org/transactions/a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312"
+          },
+          "effects": {
+            "href": "https://horizon.stellar.org/operations/74842622631374849/effects"
+          },
+          "succeeds": {
+            "href": "https://horizon.stellar.org/effects?order=desc&cursor=74842622631374849"
+          },
+          "precedes": {
+            "href": "https://horizon.stellar.org/effects?order=asc&cursor=74842622631374849"
+          }
+        },
+        "id": "74842622631374849",
+        "paging_token": "74842622631374849",
+        "transaction_successful": true,
+        "source_account": "GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4",
+        "type": "payment",
+        "type_i": 1,
+        "created_at": "2018-04-19T00:16:25Z",
+        "transaction_hash": "a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312",
+        "transaction_memo_type": "none",
+        "asset_type": "native",
+        "from": "GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4",
+        "to": "GBMIWZ3LUYPZXCNO3WQGROMORGJK73CUYZMZTAVJ7GDN43XO7AFYNKDJ",
+        "amount": "100.00000"
+      }
+    ]
+  }
+}`
+
+var operationFeeStatsResponse = `{
+  "min_accepted_fee": "100",
+  "mode_accepted_fee": "100",
+  "max_accepted_fee": "300",
+  "p10_accepted_fee": "100",
+  "p20_accepted_fee": "100",
+  "p30_accepted_fee": "100",
+  "p40_accepted_fee": "100",
+  "p50_accepted_fee": "100",
+  "p60_accepted_fee": "100",
+  "p70_accepted_fee": "100",
+  "p80_accepted_fee": "100",
+  "p90_accepted_fee": "200",
+  "p95_accepted_fee": "200",
+  "p99_accepted_fee": "250",
+  "ledger_capacity_usage": "0.97",
+  "last_ledger_base_fee": "100",
+  "last_ledger": "22606298",
+  "ledger_max_fees": [
+    {"ledger": "22606298", "max_fee": "300"},
+    {"ledger": "22606297", "max_fee": "100"}
+  ]
+}`
+
 var accountMergeEffectsResponseIncomplete = `{
   "_links": {
     "self": {
@@ -747,6 +1034,115 @@ var transactionResponse = `{
   ]
 }`
 
+var accountTransactionsResponse = `{
+  "_links": {
+    "self": {
+      "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/transactions?cursor=&end_time=1524100800000&limit=1&order=asc&start_time=1524096000000"
+    },
+    "next": {
+      "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/transactions?cursor=74842622631374848&end_time=1524100800000&limit=1&order=asc&start_time=1524096000000"
+    },
+    "prev": {
+      "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/transactions?cursor=74842622631374848&end_time=1524100800000&limit=1&order=desc&start_time=1524096000000"
+    }
+  },
+  "_embedded": {
+    "records": [
+      {
+        "_links": {
+          "self": {
+            "href": "https://horizon.stellar.org/transactions/a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312"
+          },
+          "account": {
+            "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4"
+          },
+          "ledger": {
+            "href": "https://horizon.stellar.org/ledgers/17425656"
+          },
+          "operations": {
+            "href": "https://horizon.stellar.org/transactions/a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312/operations{?cursor,limit,order}",
+            "templated": true
+          },
+          "effects": {
+            "href": "https://horizon.stellar.org/transactions/a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312/effects{?cursor,limit,order}",
+            "templated": true
+          },
+          "precedes": {
+            "href": "https://horizon.stellar.org/transactions?order=asc&cursor=74842622631374848"
+          },
+          "succeeds": {
+            "href": "https://horizon.stellar.org/transactions?order=desc&cursor=74842622631374848"
+          }
+        },
+        "id": "a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312",
+        "paging_token": "74842622631374848",
+        "hash": "a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312",
+        "ledger": 17425656,
+        "created_at": "2018-04-19T00:16:25Z",
+        "source_account": "GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4",
+        "source_account_sequence": "74842446537687041",
+        "fee_paid": 100,
+        "operation_count": 1,
+        "envelope_xdr": "AAAAAGG+6AIbvDIZHNScjgxB93jfh+MDXcjIJgdFlbOc/0UGAAAAZAEJ5M8AAAABAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAWItna6Yfm4mu3aBouY6Jkq/sVMZZmYKp+Ybebu74C4YAAAAAAJiWgAAAAAAAAAABnP9FBgAAAEB/ufrWJGD1YeVvoxoku9U6CWQTUIO9SGf7NnbZY50Tn7+pNOtNslZy0bYlAabSgoCfJ2ZXRmDMue9v9nrFsLEA",
+        "result_xdr": "AAAAAAAAAGQAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAA=",
+        "result_meta_xdr": "AAAAAAAAAAEAAAADAAAAAAEJ5PgAAAAAAAAAAFiLZ2umH5uJrt2gaLmOiZKv7FTGWZmCqfmG3m7u+AuGAAAAAACYloABCeT4AAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAwEJ5PgAAAAAAAAAAGG+6AIbvDIZHNScjgxB93jfh+MDXcjIJgdFlbOc/0UGAAAAAAIWDlwBCeTPAAAAAQAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAQEJ5PgAAAAAAAAAAGG+6AIbvDIZHNScjgxB93jfh+MDXcjIJgdFlbOc/0UGAAAAAAF9d9wBCeTPAAAAAQAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAA",
+        "fee_meta_xdr": "AAAAAgAAAAMBCeT0AAAAAAAAAABhvugCG7wyGRzUnI4MQfd434fjA13IyCYHRZWznP9FBgAAAAACFg7AAQnkzwAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAEBCeT4AAAAAAAAAABhvugCG7wyGRzUnI4MQfd434fjA13IyCYHRZWznP9FBgAAAAACFg5cAQnkzwAAAAEAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAA==",
+        "memo_type": "none",
+        "signatures": [
+          "f7n61iRg9WHlb6MaJLvVOglkE1CDvUhn+zZ22WOdE5+/qTTrTbJWctG2JQGm0oKAnydmV0ZgzLnvb/Z6xbCxAA=="
+        ]
+      }
+    ]
+  }
+}`
+
+var accountPaymentsResponse = `{
+  "_links": {
+    "self": {
+      "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/payments?cursor=&limit=10&order=desc&start_time=1524096000000"
+    },
+    "next": {
+      "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/payments?cursor=74842622631374849&limit=10&order=desc&start_time=1524096000000"
+    },
+    "prev": {
+      "href": "https://horizon.stellar.org/accounts/GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4/payments?cursor=74842622631374849&limit=10&order=asc&start_time=1524096000000"
+    }
+  },
+  "_embedded": {
+    "records": [
+      {
+        "_links": {
+          "self": {
+            "href": "https://horizon.stellar.org/operations/74842622631374849"
+          },
+          "transaction": {
+            "href": "https://horizon.stellar.org/transactions/a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312"
+          },
+          "effects": {
+            "href": "https://horizon.stellar.org/operations/74842622631374849/effects"
+          },
+          "succeeds": {
+            "href": "https://horizon.stellar.org/effects?order=desc&cursor=74842622631374849"
+          },
+          "precedes": {
+            "href": "https://horizon.stellar.org/effects?order=asc&cursor=74842622631374849"
+          }
+        },
+        "id": "74842622631374849",
+        "paging_token": "74842622631374849",
+        "source_account": "GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4",
+        "type": "create_account",
+        "type_i": 0,
+        "created_at": "2018-04-19T00:16:25Z",
+        "transaction_hash": "a4ca51d09610154409890763e2c8ecbaa36688c957dea1df0578bdbc1f65d312",
+        "starting_balance": "100.00000",
+        "funder": "GBQ352ACDO6DEGI42SOI4DCB654N7B7DANO4RSBGA5CZLM4475CQNID4",
+        "account": "GBMIWZ3LUYPZXCNO3WQGROMORGJK73CUYZMZTAVJ7GDN43XO7AFYNKDJ"
+      }
+    ]
+  }
+}`
+
 var orderBookResponse = `{
   "bids": [
     {
@@ -30,6 +30,12 @@ func (m *MockClient) LoadAccount(accountID string) (Account, error) {
 	return a.Get(0).(Account), a.Error(1)
 }
 
+// LoadAccountData is a mocking a method
+func (m *MockClient) LoadAccountData(accountID string, key string) (data AccountData, err error) {
+	a := m.Called(accountID, key)
+	return a.Get(0).(AccountData), a.Error(1)
+}
+
 // LoadAccountOffers is a mocking a method
 func (m *MockClient) LoadAccountOffers(
 	accountID string,
@@ -79,18 +85,74 @@ func (m *MockClient) LoadAccountMergeAmount(p *Payment) error {
 	return a.Error(0)
 }
 
+// LoadAssets is a mocking a method
+func (m *MockClient) LoadAssets(request AssetRequest) (assets AssetsPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(AssetsPage), a.Error(1)
+}
+
+// LoadBalanceHistory is a mocking a method
+func (m *MockClient) LoadBalanceHistory(
+	request BalanceHistoryRequest,
+) (history BalanceHistoryPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(BalanceHistoryPage), a.Error(1)
+}
+
+// LoadEffects is a mocking a method
+func (m *MockClient) LoadEffects(request EffectRequest) (effects EffectsPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(EffectsPage), a.Error(1)
+}
+
+// LoadLedger is a mocking a method
+func (m *MockClient) LoadLedger(sequence int32) (ledger Ledger, err error) {
+	a := m.Called(sequence)
+	return a.Get(0).(Ledger), a.Error(1)
+}
+
+// LoadLedgers is a mocking a method
+func (m *MockClient) LoadLedgers(request LedgerRequest) (ledgers LedgersPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(LedgersPage), a.Error(1)
+}
+
 // LoadMemo is a mocking a method
 func (m *MockClient) LoadMemo(p *Payment) error {
 	a := m.Called(p)
 	return a.Error(0)
 }
 
+// LoadOffer is a mocking a method
+func (m *MockClient) LoadOffer(offerID int64) (offer Offer, err error) {
+	a := m.Called(offerID)
+	return a.Get(0).(Offer), a.Error(1)
+}
+
+// LoadOffers is a mocking a method
+func (m *MockClient) LoadOffers(request OfferRequest) (offers OffersPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(OffersPage), a.Error(1)
+}
+
 // LoadMemo is a mocking a method
 func (m *MockClient) LoadOperation(operationID string) (payment Payment, err error) {
 	a := m.Called(operationID)
 	return a.Get(0).(Payment), a.Error(1)
 }
 
+// LoadOperationFeeStats is a mocking a method
+func (m *MockClient) LoadOperationFeeStats() (stats OperationFeeStats, err error) {
+	a := m.Called()
+	return a.Get(0).(OperationFeeStats), a.Error(1)
+}
+
+// LoadOperations is a mocking a method
+func (m *MockClient) LoadOperations(request OperationRequest) (ops OperationsPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(OperationsPage), a.Error(1)
+}
+
 // LoadOrderBook is a mocking a method
 func (m *MockClient) LoadOrderBook(
 	selling Asset,
@@ -101,18 +163,78 @@ func (m *MockClient) LoadOrderBook(
 	return a.Get(0).(OrderBookSummary), a.Error(1)
 }
 
+// LoadPaths is a mocking a method
+func (m *MockClient) LoadPaths(request PathRequest) (paths PathsPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(PathsPage), a.Error(1)
+}
+
+// LoadPayments is a mocking a method
+func (m *MockClient) LoadPayments(request PaymentRequest) (payments PaymentsPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(PaymentsPage), a.Error(1)
+}
+
+// LoadStrictSendPaths is a mocking a method
+func (m *MockClient) LoadStrictSendPaths(request StrictSendPathRequest) (paths PathsPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(PathsPage), a.Error(1)
+}
+
+// LoadTradesPage is a mocking a method
+func (m *MockClient) LoadTradesPage(request TradeRequest) (trades TradesPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(TradesPage), a.Error(1)
+}
+
 // LoadTransaction is a mocking a method
 func (m *MockClient) LoadTransaction(transactionID string) (transaction Transaction, err error) {
 	a := m.Called(transactionID)
 	return a.Get(0).(Transaction), a.Error(1)
 }
 
+// LoadTransactions is a mocking a method
+func (m *MockClient) LoadTransactions(
+	request TransactionRequest,
+) (transactions TransactionsPage, err error) {
+	a := m.Called(request)
+	return a.Get(0).(TransactionsPage), a.Error(1)
+}
+
+// LoadTransactionStatus is a mocking a method
+func (m *MockClient) LoadTransactionStatus(hash string) (status TransactionStatus, err error) {
+	a := m.Called(hash)
+	return a.Get(0).(TransactionStatus), a.Error(1)
+}
+
+// Next is a mocking a method
+func (m *MockClient) Next(page Page, next Page) error {
+	a := m.Called(page, next)
+	return a.Error(0)
+}
+
+// Prev is a mocking a method
+func (m *MockClient) Prev(page Page, prev Page) error {
+	a := m.Called(page, prev)
+	return a.Error(0)
+}
+
 // SequenceForAccount is a mocking a method
 func (m *MockClient) SequenceForAccount(accountID string) (xdr.SequenceNumber, error) {
 	a := m.Called(accountID)
 	return a.Get(0).(xdr.SequenceNumber), a.Error(1)
 }
 
+// StreamEffects is a mocking a method
+func (m *MockClient) StreamEffects(
+	ctx context.Context,
+	request EffectRequest,
+	handler EffectHandler,
+) error {
+	a := m.Called(ctx, request, handler)
+	return a.Error(0)
+}
+
 // StreamLedgers is a mocking a method
 func (m *MockClient) StreamLedgers(
 	ctx context.Context,
@@ -123,6 +245,26 @@ func (m *MockClient) StreamLedgers(
 	return a.Error(0)
 }
 
+// StreamOperations is a mocking a method
+func (m *MockClient) StreamOperations(
+	ctx context.Context,
+	request OperationRequest,
+	handler OperationHandler,
+) error {
+	a := m.Called(ctx, request, handler)
+	return a.Error(0)
+}
+
+// StreamOrderBook is a mocking a method
+func (m *MockClient) StreamOrderBook(
+	ctx context.Context,
+	request OrderBookRequest,
+	handler OrderBookHandler,
+) error {
+	a := m.Called(ctx, request, handler)
+	return a.Error(0)
+}
+
 // StreamPayments is a mocking a method
 func (m *MockClient) StreamPayments(
 	ctx context.Context,
@@ -134,6 +276,16 @@ func (m *MockClient) StreamPayments(
 	return a.Error(0)
 }
 
+// StreamTrades is a mocking a method
+func (m *MockClient) StreamTrades(
+	ctx context.Context,
+	request TradeRequest,
+	handler TradeHandler,
+) error {
+	a := m.Called(ctx, request, handler)
+	return a.Error(0)
+}
+
 // StreamTransactions is a mocking a method
 func (m *MockClient) StreamTransactions(
 	ctx context.Context,
@@ -153,5 +305,13 @@ func (m *MockClient) SubmitTransaction(
 	return a.Get(0).(TransactionSuccess), a.Error(1)
 }
 
+// SubmitTransactionAsync is a mocking a method
+func (m *MockClient) SubmitTransactionAsync(
+	txeBase64 string,
+) (AsyncTransactionSubmission, error) {
+	a := m.Called(txeBase64)
+	return a.Get(0).(AsyncTransactionSubmission), a.Error(1)
+}
+
 // ensure that the MockClient implements ClientInterface
 var _ ClientInterface = &MockClient{}
@@ -0,0 +1,430 @@
+package horizon
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/kinecosystem/go/support/errors"
+)
+
+// ErrManyResourceFilters is the error returned when building the url of a
+// request scoped to more than one of an account, ledger, transaction or
+// operation.
+var ErrManyResourceFilters = errors.New("only one of the account, ledger, transaction or operation filters can be set")
+
+// LedgerRequest builds the url of the /ledgers collection.
+type LedgerRequest struct {
+	Cursor Cursor
+	Limit  Limit
+	Order  Order
+}
+
+// BuildURL returns the url of the ledgers of `horizonURL` matching the request.
+func (r LedgerRequest) BuildURL(horizonURL string) (string, error) {
+	query := url.Values{}
+	addPageToQuery(query, r.Cursor, r.Limit, r.Order)
+	return buildEndpoint(horizonURL, "ledgers", query)
+}
+
+// TransactionRequest builds the url of a transactions collection, either all
+// of them or those of an account or a ledger.
+type TransactionRequest struct {
+	ForAccount    string
+	ForLedger     int32
+	IncludeFailed bool
+	StartTime     StartTime
+	EndTime       EndTime
+	MemoType      string
+	Memo          string
+	Cursor        Cursor
+	Limit         Limit
+	Order         Order
+}
+
+// BuildURL returns the url of the transactions of `horizonURL` matching the
+// request.
+func (r TransactionRequest) BuildURL(horizonURL string) (string, error) {
+	path, err := collectionPath("transactions", r.ForAccount, r.ForLedger, "", "")
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	addPageToQuery(query, r.Cursor, r.Limit, r.Order)
+	addTimeRangeToQuery(query, r.StartTime, r.EndTime)
+	addMemoToQuery(query, r.MemoType, r.Memo)
+	if r.IncludeFailed {
+		query.Add("include_failed", "true")
+	}
+	return buildEndpoint(horizonURL, path, query)
+}
+
+// OperationRequest builds the url of an operations collection, either all of
+// them or those of an account, a ledger or a transaction.  Set Asset to only
+// request the operations involving an asset.
+type OperationRequest struct {
+	ForAccount     string
+	ForLedger      int32
+	ForTransaction string
+	Asset          *Asset
+	IncludeFailed  bool
+	StartTime      StartTime
+	EndTime        EndTime
+	Cursor         Cursor
+	Limit          Limit
+	Order          Order
+}
+
+// BuildURL returns the url of the operations of `horizonURL` matching the
+// request.
+func (r OperationRequest) BuildURL(horizonURL string) (string, error) {
+	path, err := collectionPath("operations", r.ForAccount, r.ForLedger, r.ForTransaction, "")
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	addPageToQuery(query, r.Cursor, r.Limit, r.Order)
+	addTimeRangeToQuery(query, r.StartTime, r.EndTime)
+	if r.Asset != nil {
+		addAssetToQuery(query, "", *r.Asset)
+	}
+	if r.IncludeFailed {
+		query.Add("include_failed", "true")
+	}
+	return buildEndpoint(horizonURL, path, query)
+}
+
+// PaymentRequest builds the url of a payments collection, either all of them
+// or those of an account, a ledger or a transaction.
+type PaymentRequest struct {
+	ForAccount     string
+	ForLedger      int32
+	ForTransaction string
+	Asset          *Asset
+	StartTime      StartTime
+	EndTime        EndTime
+	MemoType       string
+	Memo           string
+	Cursor         Cursor
+	Limit          Limit
+	Order          Order
+}
+
+// BuildURL returns the url of the payments of `horizonURL` matching the
+// request.
+func (r PaymentRequest) BuildURL(horizonURL string) (string, error) {
+	path, err := collectionPath("payments", r.ForAccount, r.ForLedger, r.ForTransaction, "")
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	addPageToQuery(query, r.Cursor, r.Limit, r.Order)
+	addTimeRangeToQuery(query, r.StartTime, r.EndTime)
+	addMemoToQuery(query, r.MemoType, r.Memo)
+	if r.Asset != nil {
+		addAssetToQuery(query, "", *r.Asset)
+	}
+	return buildEndpoint(horizonURL, path, query)
+}
+
+// EffectRequest builds the url of an effects collection, either all of them
+// or those of an account, a ledger, a transaction or an operation.
+type EffectRequest struct {
+	ForAccount     string
+	ForLedger      int32
+	ForTransaction string
+	ForOperation   string
+	Asset          *Asset
+	StartTime      StartTime
+	EndTime        EndTime
+	Cursor         Cursor
+	Limit          Limit
+	Order          Order
+}
+
+// BuildURL returns the url of the effects of `horizonURL` matching the
+// request.
+func (r EffectRequest) BuildURL(horizonURL string) (string, error) {
+	path, err := collectionPath("effects", r.ForAccount, r.ForLedger, r.ForTransaction, r.ForOperation)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	addPageToQuery(query, r.Cursor, r.Limit, r.Order)
+	addTimeRangeToQuery(query, r.StartTime, r.EndTime)
+	if r.Asset != nil {
+		addAssetToQuery(query, "", *r.Asset)
+	}
+	return buildEndpoint(horizonURL, path, query)
+}
+
+// TradeRequest builds the url of a trades collection, either all of them or
+// those of an account or an offer.  BaseAsset and CounterAsset select the
+// trades of an order book, and must be set together.
+type TradeRequest struct {
+	ForAccount   string
+	ForOffer     int64
+	BaseAsset    *Asset
+	CounterAsset *Asset
+	Asset        *Asset
+	Cursor       Cursor
+	Limit        Limit
+	Order        Order
+}
+
+// BuildURL returns the url of the trades of `horizonURL` matching the request.
+func (r TradeRequest) BuildURL(horizonURL string) (string, error) {
+	if r.ForAccount != "" && r.ForOffer != 0 {
+		return "", ErrManyResourceFilters
+	}
+	if (r.BaseAsset == nil) != (r.CounterAsset == nil) {
+		return "", errors.New("base and counter assets must be set together")
+	}
+
+	path := "trades"
+	switch {
+	case r.ForAccount != "":
+		path = fmt.Sprintf("accounts/%s/trades", r.ForAccount)
+	case r.ForOffer != 0:
+		path = fmt.Sprintf("offers/%d/trades", r.ForOffer)
+	}
+
+	query := url.Values{}
+	addPageToQuery(query, r.Cursor, r.Limit, r.Order)
+	if r.BaseAsset != nil {
+		addAssetToQuery(query, "base", *r.BaseAsset)
+		addAssetToQuery(query, "counter", *r.CounterAsset)
+	}
+	if r.Asset != nil {
+		addAssetToQuery(query, "", *r.Asset)
+	}
+	return buildEndpoint(horizonURL, path, query)
+}
+
+// OfferRequest builds the url of the /offers collection, listing every offer
+// in the ledger.  Use LoadAccountOffers for the offers of an account.
+type OfferRequest struct {
+	Seller  string
+	Selling *Asset
+	Buying  *Asset
+	Cursor  Cursor
+	Limit   Limit
+	Order   Order
+}
+
+// BuildURL returns the url of the offers of `horizonURL` matching the request.
+func (r OfferRequest) BuildURL(horizonURL string) (string, error) {
+	query := url.Values{}
+	addPageToQuery(query, r.Cursor, r.Limit, r.Order)
+	if r.Seller != "" {
+		query.Add("seller", r.Seller)
+	}
+	if r.Selling != nil {
+		addAssetToQuery(query, "selling", *r.Selling)
+	}
+	if r.Buying != nil {
+		addAssetToQuery(query, "buying", *r.Buying)
+	}
+	return buildEndpoint(horizonURL, "offers", query)
+}
+
+// OrderBookRequest builds the url of the order book of an asset pair.
+type OrderBookRequest struct {
+	Selling Asset
+	Buying  Asset
+	Limit   Limit
+}
+
+// BuildURL returns the url of the order book of `horizonURL` matching the
+// request.
+func (r OrderBookRequest) BuildURL(horizonURL string) (string, error) {
+	query := url.Values{}
+	addPageToQuery(query, "", r.Limit, "")
+	addAssetToQuery(query, "selling", r.Selling)
+	addAssetToQuery(query, "buying", r.Buying)
+	return buildEndpoint(horizonURL, "order_book", query)
+}
+
+// AssetRequest builds the url of the /assets collection.
+type AssetRequest struct {
+	Code   string
+	Issuer string
+	Cursor Cursor
+	Limit  Limit
+	Order  Order
+}
+
+// BuildURL returns the url of the assets of `horizonURL` matching the request.
+func (r AssetRequest) BuildURL(horizonURL string) (string, error) {
+	query := url.Values{}
+	addPageToQuery(query, r.Cursor, r.Limit, r.Order)
+	if r.Code != "" {
+		query.Add("asset_code", r.Code)
+	}
+	if r.Issuer != "" {
+		query.Add("asset_issuer", r.Issuer)
+	}
+	return buildEndpoint(horizonURL, "assets", query)
+}
+
+// PathRequest builds the url of the /paths endpoint, finding the paths
+// delivering DestinationAmount of DestinationAsset to DestinationAccount.
+// The source assets are the assets SourceAccount holds.
+type PathRequest struct {
+	SourceAccount      string
+	DestinationAccount string
+	DestinationAsset   Asset
+	DestinationAmount  string
+}
+
+// BuildURL returns the url of the paths of `horizonURL` matching the request.
+func (r PathRequest) BuildURL(horizonURL string) (string, error) {
+	query := url.Values{}
+	query.Add("source_account", r.SourceAccount)
+	query.Add("destination_account", r.DestinationAccount)
+	query.Add("destination_amount", r.DestinationAmount)
+	addAssetToQuery(query, "destination", r.DestinationAsset)
+	return buildEndpoint(horizonURL, "paths", query)
+}
+
+// StrictSendPathRequest builds the url of the /paths/strict-send endpoint,
+// finding the paths sending SourceAmount of SourceAsset to the assets
+// DestinationAccount holds.
+type StrictSendPathRequest struct {
+	SourceAsset        Asset
+	SourceAmount       string
+	DestinationAccount string
+}
+
+// BuildURL returns the url of the paths of `horizonURL` matching the request.
+func (r StrictSendPathRequest) BuildURL(horizonURL string) (string, error) {
+	query := url.Values{}
+	query.Add("source_amount", r.SourceAmount)
+	query.Add("destination_account", r.DestinationAccount)
+	addAssetToQuery(query, "source", r.SourceAsset)
+	return buildEndpoint(horizonURL, "paths/strict-send", query)
+}
+
+// BalanceHistoryRequest builds the url of the balance history of an account
+// in an asset, bucketed by Resolution milliseconds.
+type BalanceHistoryRequest struct {
+	ForAccount string
+	Asset      Asset
+	Resolution int64
+	Offset     int64
+	StartTime  StartTime
+	EndTime    EndTime
+	Limit      Limit
+	Order      Order
+}
+
+// BuildURL returns the url of the balance history of `horizonURL` matching
+// the request.
+func (r BalanceHistoryRequest) BuildURL(horizonURL string) (string, error) {
+	if r.ForAccount == "" {
+		return "", errors.New("account is required")
+	}
+
+	query := url.Values{}
+	addPageToQuery(query, "", r.Limit, r.Order)
+	addTimeRangeToQuery(query, r.StartTime, r.EndTime)
+	addAssetToQuery(query, "", r.Asset)
+	query.Add("resolution", strconv.FormatInt(r.Resolution, 10))
+	if r.Offset != 0 {
+		query.Add("offset", strconv.FormatInt(r.Offset, 10))
+	}
+	return buildEndpoint(horizonURL, fmt.Sprintf("accounts/%s/balance_history", r.ForAccount), query)
+}
+
+// collectionPath returns the path of `collection`, scoped to at most one of
+// an account, a ledger, a transaction or an operation.
+func collectionPath(
+	collection string,
+	account string,
+	ledger int32,
+	transaction string,
+	operation string,
+) (string, error) {
+	path := collection
+	filters := 0
+
+	if account != "" {
+		path = fmt.Sprintf("accounts/%s/%s", account, collection)
+		filters++
+	}
+	if ledger != 0 {
+		path = fmt.Sprintf("ledgers/%d/%s", ledger, collection)
+		filters++
+	}
+	if transaction != "" {
+		path = fmt.Sprintf("transactions/%s/%s", transaction, collection)
+		filters++
+	}
+	if operation != "" {
+		path = fmt.Sprintf("operations/%s/%s", operation, collection)
+		filters++
+	}
+
+	if filters > 1 {
+		return "", ErrManyResourceFilters
+	}
+	return path, nil
+}
+
+// buildEndpoint returns the url of `path` on `horizonURL` with the `query`
+// parameters.
+func buildEndpoint(horizonURL string, path string, query url.Values) (string, error) {
+	endpoint := fmt.Sprintf("%s/%s", horizonURL, path)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	// ensure our endpoint is a real url
+	_, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse endpoint")
+	}
+	return endpoint, nil
+}
+
+func cursorQuery(cursor *Cursor) url.Values {
+	query := url.Values{}
+	if cursor != nil {
+		query.Set("cursor", string(*cursor))
+	}
+	return query
+}
+
+func addPageToQuery(query url.Values, cursor Cursor, limit Limit, order Order) {
+	if cursor != "" {
+		query.Add("cursor", string(cursor))
+	}
+	if limit != 0 {
+		query.Add("limit", strconv.Itoa(int(limit)))
+	}
+	if order != "" {
+		query.Add("order", string(order))
+	}
+}
+
+func addTimeRangeToQuery(query url.Values, start StartTime, end EndTime) {
+	if start != 0 {
+		query.Add("start_time", strconv.FormatInt(int64(start), 10))
+	}
+	if end != 0 {
+		query.Add("end_time", strconv.FormatInt(int64(end), 10))
+	}
+}
+
+func addMemoToQuery(query url.Values, memoType string, memo string) {
+	if memoType != "" {
+		query.Add("memo_type", memoType)
+	}
+	if memo != "" {
+		query.Add("memo", memo)
+	}
+}
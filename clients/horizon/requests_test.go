@@ -0,0 +1,122 @@
+package horizon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestBuildURL(t *testing.T) {
+	usd := Asset{Type: "credit_alphanum4", Code: "USD", Issuer: "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON"}
+	native := Asset{Type: "native"}
+
+	testCases := []struct {
+		name     string
+		request  requestBuilder
+		expected string
+	}{
+		{
+			"ledgers",
+			LedgerRequest{Cursor: "now", Limit: 2, Order: OrderDesc},
+			"https://localhost/ledgers?cursor=now&limit=2&order=desc",
+		},
+		{
+			"transactions of a ledger",
+			TransactionRequest{ForLedger: 17425656, IncludeFailed: true},
+			"https://localhost/ledgers/17425656/transactions?include_failed=true",
+		},
+		{
+			"transactions of an account by memo",
+			TransactionRequest{ForAccount: usd.Issuer, MemoType: "id", Memo: "123"},
+			"https://localhost/accounts/GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON/transactions?memo=123&memo_type=id",
+		},
+		{
+			"operations of a transaction",
+			OperationRequest{ForTransaction: "a4ca51d0", Limit: 200},
+			"https://localhost/transactions/a4ca51d0/operations?limit=200",
+		},
+		{
+			"operations involving an asset",
+			OperationRequest{Asset: &usd, StartTime: 1524096000000, EndTime: 1524100800000},
+			"https://localhost/operations?asset_code=USD&asset_issuer=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON&asset_type=credit_alphanum4&end_time=1524100800000&start_time=1524096000000",
+		},
+		{
+			"payments",
+			PaymentRequest{Asset: &native},
+			"https://localhost/payments?asset_type=native",
+		},
+		{
+			"effects of an operation",
+			EffectRequest{ForOperation: "43989725060534273", Cursor: "43989725060534273-1"},
+			"https://localhost/operations/43989725060534273/effects?cursor=43989725060534273-1",
+		},
+		{
+			"trades of an order book",
+			TradeRequest{BaseAsset: &native, CounterAsset: &usd},
+			"https://localhost/trades?base_asset_type=native&counter_asset_code=USD&counter_asset_issuer=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON&counter_asset_type=credit_alphanum4",
+		},
+		{
+			"trades of an offer",
+			TradeRequest{ForOffer: 187430},
+			"https://localhost/offers/187430/trades",
+		},
+		{
+			"offers",
+			OfferRequest{Seller: usd.Issuer, Selling: &native},
+			"https://localhost/offers?seller=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON&selling_asset_type=native",
+		},
+		{
+			"assets",
+			AssetRequest{Code: "USD"},
+			"https://localhost/assets?asset_code=USD",
+		},
+		{
+			"paths",
+			PathRequest{
+				SourceAccount:      usd.Issuer,
+				DestinationAccount: usd.Issuer,
+				DestinationAsset:   usd,
+				DestinationAmount:  "10",
+			},
+			"https://localhost/paths?destination_account=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON&destination_amount=10&destination_asset_code=USD&destination_asset_issuer=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON&destination_asset_type=credit_alphanum4&source_account=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON",
+		},
+		{
+			"strict send paths",
+			StrictSendPathRequest{SourceAsset: native, SourceAmount: "10", DestinationAccount: usd.Issuer},
+			"https://localhost/paths/strict-send?destination_account=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON&source_amount=10&source_asset_type=native",
+		},
+		{
+			"balance history",
+			BalanceHistoryRequest{ForAccount: usd.Issuer, Asset: native, Resolution: 3600000},
+			"https://localhost/accounts/GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON/balance_history?asset_type=native&resolution=3600000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			endpoint, err := tc.request.BuildURL("https://localhost")
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.expected, endpoint)
+			}
+		})
+	}
+}
+
+func TestRequestBuildURL_Errors(t *testing.T) {
+	usd := Asset{Type: "credit_alphanum4", Code: "USD", Issuer: "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON"}
+
+	// more than one resource
+	_, err := OperationRequest{ForAccount: usd.Issuer, ForLedger: 2}.BuildURL("https://localhost")
+	assert.Equal(t, ErrManyResourceFilters, err)
+
+	_, err = TradeRequest{ForAccount: usd.Issuer, ForOffer: 1}.BuildURL("https://localhost")
+	assert.Equal(t, ErrManyResourceFilters, err)
+
+	// half an order book
+	_, err = TradeRequest{BaseAsset: &usd}.BuildURL("https://localhost")
+	assert.Error(t, err)
+
+	// balance history of no account
+	_, err = BalanceHistoryRequest{Asset: usd}.BuildURL("https://localhost")
+	assert.Error(t, err)
+}
@@ -2,7 +2,10 @@ package horizon
 
 import (
 	"encoding/json"
+
 	hProtocol "github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/protocols/horizon/effects"
+	"github.com/kinecosystem/go/protocols/horizon/operations"
 	"github.com/kinecosystem/go/support/render/hal"
 )
 
@@ -46,21 +49,179 @@ type Link = hal.Link
 // Deprecated: use protocols/horizon instead
 type Offer = hProtocol.Offer
 
-// EffectsPageResponse contains page of effects returned by Horizon.
-// Currently used by LoadAccountMergeAmount only.
+// Page is implemented by the pages of records returned by Horizon, allowing
+// Client.Next and Client.Prev to load the pages they link to.
+type Page interface {
+	PageLinks() hal.Links
+}
+
+// AccountData is the value of a data entry of an account, base64 encoded.
+type AccountData struct {
+	Value string `json:"value"`
+}
+
+// Deprecated: use protocols/horizon instead
+type AssetStat = hProtocol.AssetStat
+
+// AssetsPage returns a list of asset stats
+type AssetsPage struct {
+	Links    hal.Links `json:"_links"`
+	Embedded struct {
+		Records []AssetStat `json:"records"`
+	} `json:"_embedded"`
+}
+
+// PageLinks implements Page
+func (p AssetsPage) PageLinks() hal.Links { return p.Links }
+
+// Deprecated: use protocols/horizon instead
+type AsyncTransactionSubmission = hProtocol.AsyncTransactionSubmission
+
+// Deprecated: use protocols/horizon instead
+type BalanceHistory = hProtocol.BalanceHistory
+
+// BalanceHistoryPage returns a list of balance history buckets
+type BalanceHistoryPage struct {
+	Links    hal.Links `json:"_links"`
+	Embedded struct {
+		Records []BalanceHistory `json:"records"`
+	} `json:"_embedded"`
+}
+
+// PageLinks implements Page
+func (p BalanceHistoryPage) PageLinks() hal.Links { return p.Links }
+
+// EffectsPage returns a list of effects
 type EffectsPage struct {
+	Links    hal.Links `json:"_links"`
 	Embedded struct {
-		Records []Effect
+		Records []Effect `json:"records"`
 	} `json:"_embedded"`
 }
 
-// EffectResponse contains effect data returned by Horizon.
-// Currently used by LoadAccountMergeAmount only.
+// PageLinks implements Page
+func (p EffectsPage) PageLinks() hal.Links { return p.Links }
+
+// Effect contains the attributes shared by every type of effect, and the
+// amount of the effects moving funds.  Use Decode to load the attributes
+// specific to the effect's type.
 type Effect struct {
-	Type   string `json:"type"`
+	effects.Base
 	Amount string `json:"amount"`
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping the raw effect for Decode.
+func (e *Effect) UnmarshalJSON(data []byte) error {
+	type effect Effect
+	err := json.Unmarshal(data, (*effect)(e))
+	if err != nil {
+		return err
+	}
+
+	e.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// Decode unmarshals the effect into `dest`, the protocols/horizon/effects
+// struct matching its type, e.g. *effects.AccountCredited.
+func (e Effect) Decode(dest interface{}) error {
+	return json.Unmarshal(e.raw, dest)
+}
+
+// LedgersPage returns a list of ledgers
+type LedgersPage struct {
+	Links    hal.Links `json:"_links"`
+	Embedded struct {
+		Records []Ledger `json:"records"`
+	} `json:"_embedded"`
+}
+
+// PageLinks implements Page
+func (p LedgersPage) PageLinks() hal.Links { return p.Links }
+
+// OperationsPage returns a list of operations
+type OperationsPage struct {
+	Links    hal.Links `json:"_links"`
+	Embedded struct {
+		Records []Operation `json:"records"`
+	} `json:"_embedded"`
+}
+
+// PageLinks implements Page
+func (p OperationsPage) PageLinks() hal.Links { return p.Links }
+
+// Operation contains the attributes shared by every type of operation.  Use
+// Decode to load the attributes specific to the operation's type.
+type Operation struct {
+	operations.Base
+
+	raw json.RawMessage
 }
 
+// UnmarshalJSON implements json.Unmarshaler, keeping the raw operation for
+// Decode.
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	err := json.Unmarshal(data, &o.Base)
+	if err != nil {
+		return err
+	}
+
+	o.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// Decode unmarshals the operation into `dest`, the
+// protocols/horizon/operations struct matching its type, e.g.
+// *operations.Payment.
+func (o Operation) Decode(dest interface{}) error {
+	return json.Unmarshal(o.raw, dest)
+}
+
+// OperationFeeStats contains the fees per operation accepted in the recent
+// ledgers, in stroops, and the share of their capacity used.
+type OperationFeeStats struct {
+	Min                 string         `json:"min_accepted_fee"`
+	Mode                string         `json:"mode_accepted_fee"`
+	Max                 string         `json:"max_accepted_fee"`
+	P10                 string         `json:"p10_accepted_fee"`
+	P20                 string         `json:"p20_accepted_fee"`
+	P30                 string         `json:"p30_accepted_fee"`
+	P40                 string         `json:"p40_accepted_fee"`
+	P50                 string         `json:"p50_accepted_fee"`
+	P60                 string         `json:"p60_accepted_fee"`
+	P70                 string         `json:"p70_accepted_fee"`
+	P80                 string         `json:"p80_accepted_fee"`
+	P90                 string         `json:"p90_accepted_fee"`
+	P95                 string         `json:"p95_accepted_fee"`
+	P99                 string         `json:"p99_accepted_fee"`
+	LedgerCapacityUsage string         `json:"ledger_capacity_usage"`
+	LastLedgerBaseFee   string         `json:"last_ledger_base_fee"`
+	LastLedger          string         `json:"last_ledger"`
+	LedgerMaxFees       []LedgerMaxFee `json:"ledger_max_fees"`
+}
+
+// LedgerMaxFee is the max fee per operation accepted in a ledger, in stroops.
+type LedgerMaxFee struct {
+	Ledger string `json:"ledger"`
+	MaxFee string `json:"max_fee"`
+}
+
+// Deprecated: use protocols/horizon instead
+type Path = hProtocol.Path
+
+// PathsPage returns a list of payment paths
+type PathsPage struct {
+	Links    hal.Links `json:"_links"`
+	Embedded struct {
+		Records []Path `json:"records"`
+	} `json:"_embedded"`
+}
+
+// PageLinks implements Page
+func (p PathsPage) PageLinks() hal.Links { return p.Links }
+
 // TradeAggregationsPage returns a list of aggregated trade records, aggregated by resolution
 type TradeAggregationsPage struct {
 	Links    hal.Links `json:"_links"`
@@ -69,6 +230,9 @@ type TradeAggregationsPage struct {
 	} `json:"_embedded"`
 }
 
+// PageLinks implements Page
+func (p TradeAggregationsPage) PageLinks() hal.Links { return p.Links }
+
 // Deprecated: use protocols/horizon instead
 type TradeAggregation = hProtocol.TradeAggregation
 
@@ -80,6 +244,9 @@ type TradesPage struct {
 	} `json:"_embedded"`
 }
 
+// PageLinks implements Page
+func (p TradesPage) PageLinks() hal.Links { return p.Links }
+
 // Deprecated: use protocols/horizon instead
 type Trade = hProtocol.Trade
 
@@ -103,6 +270,20 @@ type OffersPage struct {
 	} `json:"_embedded"`
 }
 
+// PageLinks implements Page
+func (p OffersPage) PageLinks() hal.Links { return p.Links }
+
+// PaymentsPage returns a list of payments
+type PaymentsPage struct {
+	Links    hal.Links `json:"_links"`
+	Embedded struct {
+		Records []Payment `json:"records"`
+	} `json:"_embedded"`
+}
+
+// PageLinks implements Page
+func (p PaymentsPage) PageLinks() hal.Links { return p.Links }
+
 type Payment struct {
 	ID          string `json:"id"`
 	Type        string `json:"type"`
@@ -139,8 +320,10 @@ type Payment struct {
 	Amount      string `json:"amount"`
 
 	// transaction fields
-	TransactionHash string `json:"transaction_hash"`
-	Memo            struct {
+	TransactionHash     string `json:"transaction_hash"`
+	TransactionMemoType string `json:"transaction_memo_type"`
+	TransactionMemo     string `json:"transaction_memo"`
+	Memo                struct {
 		Type  string `json:"memo_type"`
 		Value string `json:"memo"`
 	}
@@ -154,3 +337,17 @@ type PriceLevel = hProtocol.PriceLevel
 
 // Deprecated: use protocols/horizon instead
 type Transaction = hProtocol.Transaction
+
+// Deprecated: use protocols/horizon instead
+type TransactionStatus = hProtocol.TransactionStatus
+
+// TransactionsPage returns a list of transactions
+type TransactionsPage struct {
+	Links    hal.Links `json:"_links"`
+	Embedded struct {
+		Records []Transaction `json:"records"`
+	} `json:"_embedded"`
+}
+
+// PageLinks implements Page
+func (p TransactionsPage) PageLinks() hal.Links { return p.Links }
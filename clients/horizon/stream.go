@@ -0,0 +1,280 @@
+package horizon
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kinecosystem/go/support/errors"
+)
+
+var (
+	// DefaultStreamRetry is the delay before a stream reconnects when horizon
+	// did not send a `retry:` hint.  Failed reconnects double the delay.
+	DefaultStreamRetry = time.Second
+
+	// MaxStreamRetry caps the delay between the reconnects of a stream.
+	MaxStreamRetry = time.Minute
+)
+
+// event is a single server-sent event read from a horizon stream.
+type event struct {
+	ID       string
+	HasID    bool
+	Event    string
+	Data     []byte
+	Retry    time.Duration
+	HasRetry bool
+}
+
+// readEvent reads the next event from an event stream, as described in
+// https://html.spec.whatwg.org/multipage/server-sent-events.html#event-stream-interpretation.
+// An event that was not terminated by an empty line when the stream ended is
+// discarded and io.EOF is returned.
+func readEvent(reader *bufio.Reader) (ev event, err error) {
+	var data bytes.Buffer
+	fieldsRead := 0
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if err == io.ErrUnexpectedEOF {
+				err = io.EOF
+			}
+			return event{}, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			if fieldsRead == 0 {
+				continue
+			}
+			ev.Data = bytes.TrimSuffix(data.Bytes(), []byte("\n"))
+			return ev, nil
+		}
+		fieldsRead++
+
+		// Lines starting with a colon are comments, used by servers to keep
+		// idle connections open.
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			field = line[:i]
+			value = strings.TrimPrefix(line[i+1:], " ")
+		}
+
+		switch field {
+		case "event":
+			ev.Event = value
+		case "data":
+			data.WriteString(value)
+			data.WriteByte('\n')
+		case "id":
+			ev.ID = value
+			ev.HasID = true
+		case "retry":
+			ms, err := strconv.ParseUint(value, 10, 32)
+			if err == nil {
+				ev.Retry = time.Duration(ms) * time.Millisecond
+				ev.HasRetry = true
+			}
+		}
+	}
+}
+
+// eventStream is a stream of horizon events, following the stream across
+// reconnects from the id of the last event received.
+type eventStream struct {
+	http     HTTP
+	url      url.URL
+	handler  func(data []byte) error
+	lastID   string
+	retry    time.Duration
+	failures uint
+}
+
+// stream opens the stream of `endpoint` and calls `handler` with the data of
+// every message received, until `ctx` is done or `handler` returns an error.
+// Closed connections are reopened from the last event received: after the
+// delay of horizon's `retry:` hint when the connection was healthy, and with
+// an exponential backoff otherwise.
+func (c *Client) stream(
+	ctx context.Context,
+	endpoint string,
+	handler func(data []byte) error,
+) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing stream url")
+	}
+
+	// Make sure we don't use c.HTTP that can have Timeout set.
+	client := c.StreamHTTP
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	s := &eventStream{
+		http:    client,
+		url:     *u,
+		handler: handler,
+		lastID:  u.Query().Get("cursor"),
+		retry:   DefaultStreamRetry,
+	}
+
+	for {
+		delay, err := s.connect(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+	}
+}
+
+// connect opens a single connection to the stream and dispatches its events
+// until the connection ends.  It returns how long to wait before reconnecting,
+// or an error when the stream can't be resumed.
+func (s *eventStream) connect(ctx context.Context) (time.Duration, error) {
+	u := s.url
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "Error creating HTTP request")
+	}
+	if s.lastID != "" {
+		query := u.Query()
+		query.Set("cursor", s.lastID)
+		u.RawQuery = query.Encode()
+		req.URL = &u
+		req.Header.Set("Last-Event-ID", s.lastID)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := s.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return s.backoff(), nil
+	}
+
+	switch {
+	case resp.StatusCode/100 == 2:
+		// Stream the events below.
+	case resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == http.StatusServiceUnavailable:
+		resp.Body.Close()
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay, nil
+		}
+		return s.backoff(), nil
+	case resp.StatusCode/100 == 5:
+		resp.Body.Close()
+		return s.backoff(), nil
+	default:
+		return 0, decodeResponse(resp, nil)
+	}
+	defer resp.Body.Close()
+
+	reader := bufio.NewReader(resp.Body)
+	healthy := false
+	for {
+		ev, err := readEvent(reader)
+		if err != nil {
+			// Either horizon closed the stream (io.EOF) or the connection broke.
+			// Both are resumed from the last event.
+			break
+		}
+
+		if ev.HasRetry {
+			s.retry = ev.Retry
+		}
+		if ev.HasID {
+			s.lastID = ev.ID
+		}
+
+		switch ev.Event {
+		case "", "message":
+			// Dispatch below.
+		case "err":
+			// horizon reports errors streaming the resource before closing the
+			// connection, reconnect as if it had failed.
+			healthy = false
+			continue
+		default:
+			// "open" and "close" events frame each connection.
+			healthy = true
+			continue
+		}
+
+		if len(ev.Data) == 0 {
+			continue
+		}
+		healthy = true
+
+		err = s.handler(ev.Data)
+		if err != nil {
+			return 0, errors.Wrap(err, "Handler error")
+		}
+	}
+
+	if !healthy {
+		return s.backoff(), nil
+	}
+	s.failures = 0
+	return s.retry, nil
+}
+
+// backoff records a failed connection and returns the delay before the next
+// one, doubling the retry delay for every consecutive failure.
+func (s *eventStream) backoff() time.Duration {
+	delay := s.retry
+	if delay == 0 {
+		delay = DefaultStreamRetry
+	}
+	for i := uint(0); i < s.failures && delay < MaxStreamRetry; i++ {
+		delay *= 2
+	}
+	s.failures++
+
+	if delay > MaxStreamRetry {
+		delay = MaxStreamRetry
+	}
+	return delay
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	delay := at.Sub(time.Now())
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, true
+}
@@ -0,0 +1,151 @@
+package horizon
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/kinecosystem/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEvent(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader(
+		"retry: 1000\nevent: open\ndata: \"hello\"\n\n" +
+			": keep-alive\n\n" +
+			"id: 1-1\r\ndata: {\r\ndata:   \"a\": 1\r\ndata: }\r\n\r\n" +
+			"id: 1-2\ndata: {}\n",
+	))
+
+	ev, err := readEvent(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "open", ev.Event)
+	assert.Equal(t, `"hello"`, string(ev.Data))
+	assert.True(t, ev.HasRetry)
+	assert.Equal(t, time.Second, ev.Retry)
+	assert.False(t, ev.HasID)
+
+	// comments make an event without any data
+	ev, err = readEvent(reader)
+	require.NoError(t, err)
+	assert.Len(t, ev.Data, 0)
+
+	ev, err = readEvent(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "", ev.Event)
+	assert.Equal(t, "1-1", ev.ID)
+	assert.Equal(t, "{\n  \"a\": 1\n}", string(ev.Data))
+
+	// the last event wasn't terminated
+	_, err = readEvent(reader)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestStream(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		URL:        "https://localhost",
+		HTTP:       http.DefaultClient,
+		StreamHTTP: hmock,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var requests []*http.Request
+	hmock.On("GET", "https://localhost/effects").Return(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req)
+		switch len(requests) {
+		case 1:
+			resp := httpmock.NewStringResponse(http.StatusServiceUnavailable, "")
+			resp.Header.Set("Retry-After", "0")
+			return resp, nil
+		case 2:
+			return httpmock.NewStringResponse(http.StatusOK, "retry: 1\nevent: open\ndata: \"hello\"\n\n"+
+				"id: 1-1\ndata: {\"id\": \"1-1\", \"type\": \"account_created\"}\n\n"+
+				"retry: 1\nevent: close\ndata: \"byebye\"\n\n"), nil
+		default:
+			return httpmock.NewStringResponse(http.StatusOK, "retry: 1\nevent: open\ndata: \"hello\"\n\n"+
+				"id: 1-2\ndata: {\"id\": \"1-2\", \"type\": \"account_debited\", \"amount\": \"10.00000\"}\n\n"), nil
+		}
+	})
+
+	var effects []Effect
+	err := client.StreamEffects(ctx, EffectRequest{Cursor: "now"}, func(effect Effect) {
+		effects = append(effects, effect)
+		if len(effects) == 2 {
+			cancel()
+		}
+	})
+	require.NoError(t, err)
+
+	require.Len(t, effects, 2)
+	assert.Equal(t, "account_created", effects[0].Type)
+	assert.Equal(t, "10.00000", effects[1].Amount)
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, "now", requests[0].URL.Query().Get("cursor"))
+	assert.Equal(t, "text/event-stream", requests[0].Header.Get("Accept"))
+	assert.Equal(t, "now", requests[1].URL.Query().Get("cursor"))
+	assert.Equal(t, "1-1", requests[2].URL.Query().Get("cursor"))
+	assert.Equal(t, "1-1", requests[2].Header.Get("Last-Event-ID"))
+}
+
+func TestStream_Errors(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		URL:        "https://localhost",
+		HTTP:       http.DefaultClient,
+		StreamHTTP: hmock,
+	}
+
+	// resources that can't be streamed are not retried
+	hmock.On("GET", "https://localhost/ledgers").ReturnString(http.StatusNotFound, notFoundResponse)
+
+	err := client.StreamLedgers(context.Background(), nil, func(Ledger) {})
+	if assert.Error(t, err) {
+		herr, ok := err.(*Error)
+		if assert.True(t, ok) {
+			assert.Equal(t, "Resource Missing", herr.Problem.Title)
+		}
+	}
+
+	// invalid messages stop the stream
+	hmock.On("GET", "https://localhost/operations").ReturnString(http.StatusOK, "data: [\n\n")
+
+	err = client.StreamOperations(context.Background(), OperationRequest{}, func(Operation) {})
+	assert.Error(t, err)
+}
+
+func TestStreamBackoff(t *testing.T) {
+	s := &eventStream{retry: time.Second}
+
+	assert.Equal(t, time.Second, s.backoff())
+	assert.Equal(t, 2*time.Second, s.backoff())
+	assert.Equal(t, 4*time.Second, s.backoff())
+
+	s.failures = 20
+	assert.Equal(t, MaxStreamRetry, s.backoff())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	delay, ok := parseRetryAfter("120")
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, delay)
+
+	delay, ok = parseRetryAfter("Wed, 21 Oct 2015 07:28:00 GMT")
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
@@ -0,0 +1,307 @@
+package inspect
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kinecosystem/go/amount"
+	"github.com/kinecosystem/go/keypair"
+	"github.com/kinecosystem/go/network"
+	"github.com/kinecosystem/go/protocols/horizon/operations"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// InspectEnvelope returns the annotated form of `txe`.
+func InspectEnvelope(txe xdr.TransactionEnvelope, opts Options) (*Envelope, error) {
+	tx := txe.Tx
+	result := &Envelope{
+		SourceAccount: tx.SourceAccount.Address(),
+		Fee:           uint32(tx.Fee),
+		Sequence:      strconv.FormatInt(int64(tx.SeqNum), 10),
+		Memo:          memo(tx.Memo),
+		Operations:    make([]Operation, len(tx.Operations)),
+		Signatures:    make([]Signature, len(txe.Signatures)),
+	}
+
+	var hash []byte
+	if opts.NetworkPassphrase != "" {
+		h, err := network.HashTransaction(&tx, opts.NetworkPassphrase)
+		if err != nil {
+			return nil, errors.Wrap(err, "hash tx failed")
+		}
+		hash = h[:]
+		result.Hash = hex.EncodeToString(hash)
+	}
+
+	if tx.TimeBounds != nil {
+		result.TimeBounds = &TimeBounds{
+			MinTime:     uint64(tx.TimeBounds.MinTime),
+			MaxTime:     uint64(tx.TimeBounds.MaxTime),
+			ValidAfter:  timestamp(tx.TimeBounds.MinTime),
+			ValidBefore: timestamp(tx.TimeBounds.MaxTime),
+		}
+	}
+
+	for i, op := range tx.Operations {
+		source := tx.SourceAccount
+		if op.SourceAccount != nil {
+			source = *op.SourceAccount
+		}
+
+		details, err := operationDetails(source, op)
+		if err != nil {
+			return nil, errors.Wrapf(err, "operation %d", i)
+		}
+
+		result.Operations[i] = Operation{
+			Type:          operations.TypeNames[op.Body.Type],
+			SourceAccount: source.Address(),
+			Details:       details,
+		}
+	}
+
+	signers := make([]keypair.KP, 0, len(opts.Signers))
+	for _, address := range opts.Signers {
+		kp, err := keypair.Parse(address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid signer %s", address)
+		}
+		signers = append(signers, kp)
+	}
+
+	for i, sig := range txe.Signatures {
+		result.Signatures[i] = signature(sig, signers, hash)
+	}
+
+	return result, nil
+}
+
+// signature annotates `sig` with the first of `signers` its hint matches.
+// When `hash` is not empty, the signature is also verified.
+func signature(sig xdr.DecoratedSignature, signers []keypair.KP, hash []byte) Signature {
+	result := Signature{
+		Hint:      hex.EncodeToString(sig.Hint[:]),
+		Signature: base64.StdEncoding.EncodeToString(sig.Signature),
+	}
+
+	for _, kp := range signers {
+		hint := kp.Hint()
+		if !bytes.Equal(hint[:], sig.Hint[:]) {
+			continue
+		}
+
+		result.Signer = kp.Address()
+		if len(hash) > 0 {
+			verified := kp.Verify(hash, sig.Signature) == nil
+			result.Verified = &verified
+		}
+		break
+	}
+
+	return result
+}
+
+func memo(m xdr.Memo) Memo {
+	switch m.Type {
+	case xdr.MemoTypeMemoText:
+		return Memo{Type: "text", Value: m.MustText()}
+	case xdr.MemoTypeMemoId:
+		return Memo{Type: "id", Value: strconv.FormatUint(uint64(m.MustId()), 10)}
+	case xdr.MemoTypeMemoHash:
+		hash := m.MustHash()
+		return Memo{Type: "hash", Value: base64.StdEncoding.EncodeToString(hash[:])}
+	case xdr.MemoTypeMemoReturn:
+		hash := m.MustRetHash()
+		return Memo{Type: "return", Value: base64.StdEncoding.EncodeToString(hash[:])}
+	default:
+		return Memo{Type: "none"}
+	}
+}
+
+func timestamp(t xdr.Uint64) string {
+	if t == 0 {
+		return ""
+	}
+	return time.Unix(int64(t), 0).UTC().Format(time.RFC3339)
+}
+
+// operationDetails returns the details of `op`, using the keys of horizon's
+// operation resources.
+func operationDetails(source xdr.AccountId, op xdr.Operation) (map[string]interface{}, error) {
+	details := map[string]interface{}{}
+	var err error
+
+	switch op.Body.Type {
+	case xdr.OperationTypeCreateAccount:
+		op := op.Body.MustCreateAccountOp()
+		details["funder"] = source.Address()
+		details["account"] = op.Destination.Address()
+		details["starting_balance"] = amount.String(op.StartingBalance)
+	case xdr.OperationTypePayment:
+		op := op.Body.MustPaymentOp()
+		details["from"] = source.Address()
+		details["to"] = op.Destination.Address()
+		details["amount"] = amount.String(op.Amount)
+		err = assetDetails(details, op.Asset, "")
+	case xdr.OperationTypePathPayment:
+		op := op.Body.MustPathPaymentOp()
+		details["from"] = source.Address()
+		details["to"] = op.Destination.Address()
+		details["amount"] = amount.String(op.DestAmount)
+		details["source_max"] = amount.String(op.SendMax)
+		err = assetDetails(details, op.DestAsset, "")
+		if err != nil {
+			break
+		}
+		err = assetDetails(details, op.SendAsset, "source_")
+		if err != nil {
+			break
+		}
+
+		path := make([]map[string]interface{}, len(op.Path))
+		for i := range op.Path {
+			path[i] = map[string]interface{}{}
+			err = assetDetails(path[i], op.Path[i], "")
+			if err != nil {
+				break
+			}
+		}
+		details["path"] = path
+	case xdr.OperationTypeManageOffer:
+		op := op.Body.MustManageOfferOp()
+		details["offer_id"] = op.OfferId
+		details["amount"] = amount.String(op.Amount)
+		priceDetails(details, op.Price)
+		err = offerAssetDetails(details, op.Selling, op.Buying)
+	case xdr.OperationTypeCreatePassiveOffer:
+		op := op.Body.MustCreatePassiveOfferOp()
+		details["amount"] = amount.String(op.Amount)
+		priceDetails(details, op.Price)
+		err = offerAssetDetails(details, op.Selling, op.Buying)
+	case xdr.OperationTypeSetOptions:
+		op := op.Body.MustSetOptionsOp()
+		if op.InflationDest != nil {
+			details["inflation_dest"] = op.InflationDest.Address()
+		}
+		if op.SetFlags != nil && *op.SetFlags > 0 {
+			details["set_flags_s"] = flagNames(*op.SetFlags)
+		}
+		if op.ClearFlags != nil && *op.ClearFlags > 0 {
+			details["clear_flags_s"] = flagNames(*op.ClearFlags)
+		}
+		if op.MasterWeight != nil {
+			details["master_key_weight"] = *op.MasterWeight
+		}
+		if op.LowThreshold != nil {
+			details["low_threshold"] = *op.LowThreshold
+		}
+		if op.MedThreshold != nil {
+			details["med_threshold"] = *op.MedThreshold
+		}
+		if op.HighThreshold != nil {
+			details["high_threshold"] = *op.HighThreshold
+		}
+		if op.HomeDomain != nil {
+			details["home_domain"] = *op.HomeDomain
+		}
+		if op.Signer != nil {
+			details["signer_key"] = op.Signer.Key.Address()
+			details["signer_weight"] = op.Signer.Weight
+		}
+	case xdr.OperationTypeChangeTrust:
+		op := op.Body.MustChangeTrustOp()
+		err = assetDetails(details, op.Line, "")
+		details["trustor"] = source.Address()
+		details["trustee"] = details["asset_issuer"]
+		details["limit"] = amount.String(op.Limit)
+	case xdr.OperationTypeAllowTrust:
+		op := op.Body.MustAllowTrustOp()
+		err = assetDetails(details, op.Asset.ToAsset(source), "")
+		details["trustee"] = source.Address()
+		details["trustor"] = op.Trustor.Address()
+		details["authorize"] = op.Authorize
+	case xdr.OperationTypeAccountMerge:
+		destination := op.Body.MustDestination()
+		details["account"] = source.Address()
+		details["into"] = destination.Address()
+	case xdr.OperationTypeInflation:
+		// no inflation details
+	case xdr.OperationTypeManageData:
+		op := op.Body.MustManageDataOp()
+		details["name"] = string(op.DataName)
+		if op.DataValue != nil {
+			details["value"] = base64.StdEncoding.EncodeToString(*op.DataValue)
+		} else {
+			details["value"] = nil
+		}
+	case xdr.OperationTypeBumpSequence:
+		op := op.Body.MustBumpSequenceOp()
+		details["bump_to"] = fmt.Sprintf("%d", op.BumpTo)
+	default:
+		return nil, errors.Errorf("unknown operation type: %s", op.Body.Type)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// assetDetails sets the type, code and issuer of `a` on `result`.
+func assetDetails(result map[string]interface{}, a xdr.Asset, prefix string) error {
+	var (
+		t      string
+		code   string
+		issuer string
+	)
+	err := a.Extract(&t, &code, &issuer)
+	if err != nil {
+		return errors.Wrap(err, "xdr.Asset.Extract error")
+	}
+	result[prefix+"asset_type"] = t
+
+	if a.Type == xdr.AssetTypeAssetTypeNative {
+		return nil
+	}
+
+	result[prefix+"asset_code"] = code
+	result[prefix+"asset_issuer"] = issuer
+	return nil
+}
+
+func offerAssetDetails(result map[string]interface{}, selling, buying xdr.Asset) error {
+	err := assetDetails(result, selling, "selling_")
+	if err != nil {
+		return err
+	}
+	return assetDetails(result, buying, "buying_")
+}
+
+func priceDetails(result map[string]interface{}, p xdr.Price) {
+	if p.D != 0 {
+		result["price"] = p.String()
+	}
+	result["price_r"] = map[string]interface{}{
+		"n": p.N,
+		"d": p.D,
+	}
+}
+
+func flagNames(flags xdr.Uint32) []string {
+	var names []string
+	if flags&xdr.Uint32(xdr.AccountFlagsAuthRequiredFlag) != 0 {
+		names = append(names, "auth_required")
+	}
+	if flags&xdr.Uint32(xdr.AccountFlagsAuthRevocableFlag) != 0 {
+		names = append(names, "auth_revocable")
+	}
+	if flags&xdr.Uint32(xdr.AccountFlagsAuthImmutableFlag) != 0 {
+		names = append(names, "auth_immutable")
+	}
+	return names
+}
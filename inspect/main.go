@@ -0,0 +1,165 @@
+// Package inspect decodes the xdr of transaction envelopes, results and metas
+// into annotated structures that read like horizon's resources: accounts are
+// encoded as strkeys, amounts and prices as decimal strings and result codes
+// as the strings of horizon, like "tx_bad_seq" or "op_underfunded".  The
+// structures can be encoded to JSON, or written as indented text using
+// WriteText.
+package inspect
+
+import (
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// ErrUnknownXDR is returned by Decode when the xdr is neither a transaction
+// envelope, a transaction result nor a transaction meta.
+var ErrUnknownXDR = errors.New("xdr is not a transaction envelope, result or meta")
+
+// Options configures how envelopes are inspected.
+type Options struct {
+	// NetworkPassphrase is the passphrase of the network the transaction was
+	// signed for.  When set, the envelope is annotated with the hash of the
+	// transaction and signatures are verified.
+	NetworkPassphrase string
+
+	// Signers are the public keys the hints of the signatures are matched to.
+	Signers []string
+}
+
+// Envelope is the annotated form of a transaction envelope.
+type Envelope struct {
+	Hash          string      `json:"hash,omitempty"`
+	SourceAccount string      `json:"source_account"`
+	Fee           uint32      `json:"fee"`
+	Sequence      string      `json:"sequence"`
+	Memo          Memo        `json:"memo"`
+	TimeBounds    *TimeBounds `json:"time_bounds,omitempty"`
+	Operations    []Operation `json:"operations"`
+	Signatures    []Signature `json:"signatures"`
+}
+
+// Memo is the memo of a transaction.  Hash memos are base64 encoded.
+type Memo struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+// TimeBounds are the time bounds of a transaction, in seconds since epoch.  A
+// max time of 0 leaves the transaction valid forever.
+type TimeBounds struct {
+	MinTime     uint64 `json:"min_time"`
+	MaxTime     uint64 `json:"max_time"`
+	ValidAfter  string `json:"valid_after,omitempty"`
+	ValidBefore string `json:"valid_before,omitempty"`
+}
+
+// Operation is an operation of a transaction.  Its details use the same keys
+// as the operation resources of horizon.
+type Operation struct {
+	Type          string                 `json:"type"`
+	SourceAccount string                 `json:"source_account"`
+	Details       map[string]interface{} `json:"details"`
+}
+
+// Signature is a signature of an envelope.  Signer is the public key whose
+// hint matches the signature, if any of the provided signers does, and
+// Verified tells whether the signature is valid when the network passphrase
+// was provided.
+type Signature struct {
+	Hint      string `json:"hint"`
+	Signature string `json:"signature"`
+	Signer    string `json:"signer,omitempty"`
+	Verified  *bool  `json:"verified,omitempty"`
+}
+
+// Result is the annotated form of a transaction result.
+type Result struct {
+	FeeCharged int64             `json:"fee_charged"`
+	Code       string            `json:"result_code"`
+	Operations []OperationResult `json:"operations,omitempty"`
+}
+
+// OperationResult is the result of an operation of a transaction.  Code is
+// the code of the operation itself when it was applied, and the reason it
+// couldn't be applied otherwise.
+type OperationResult struct {
+	Type string `json:"type,omitempty"`
+	Code string `json:"result_code"`
+}
+
+// Meta is the annotated form of a transaction meta, holding the ledger entry
+// changes of the transaction and of each of its operations.
+type Meta struct {
+	Changes    []Change        `json:"changes,omitempty"`
+	Operations []OperationMeta `json:"operations"`
+}
+
+// OperationMeta holds the ledger entry changes of an operation.
+type OperationMeta struct {
+	Changes []Change `json:"changes"`
+}
+
+// Change is the change of a ledger entry.  Type is one of "created",
+// "updated", "removed" and "state", the state of the entry before it was
+// updated or removed.  The details of removed entries only hold their key.
+type Change struct {
+	Type               string                 `json:"type"`
+	Entry              string                 `json:"entry"`
+	LastModifiedLedger uint32                 `json:"last_modified_ledger,omitempty"`
+	Details            map[string]interface{} `json:"details"`
+}
+
+// Decode decodes base64 encoded xdr, which is either a transaction envelope,
+// a transaction result or a transaction meta, and returns its annotated form:
+// an *Envelope, a *Result or a *Meta.
+func Decode(b64 string, opts Options) (interface{}, error) {
+	var txe xdr.TransactionEnvelope
+	if xdr.SafeUnmarshalBase64(b64, &txe) == nil {
+		return InspectEnvelope(txe, opts)
+	}
+
+	var result xdr.TransactionResult
+	if xdr.SafeUnmarshalBase64(b64, &result) == nil {
+		return InspectResult(result)
+	}
+
+	var meta xdr.TransactionMeta
+	if xdr.SafeUnmarshalBase64(b64, &meta) == nil {
+		return InspectMeta(meta)
+	}
+
+	return nil, ErrUnknownXDR
+}
+
+// DecodeEnvelope decodes a base64 encoded transaction envelope.
+func DecodeEnvelope(b64 string, opts Options) (*Envelope, error) {
+	var txe xdr.TransactionEnvelope
+	err := xdr.SafeUnmarshalBase64(b64, &txe)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode envelope failed")
+	}
+
+	return InspectEnvelope(txe, opts)
+}
+
+// DecodeResult decodes a base64 encoded transaction result.
+func DecodeResult(b64 string) (*Result, error) {
+	var result xdr.TransactionResult
+	err := xdr.SafeUnmarshalBase64(b64, &result)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode result failed")
+	}
+
+	return InspectResult(result)
+}
+
+// DecodeMeta decodes a base64 encoded transaction meta.
+func DecodeMeta(b64 string) (*Meta, error) {
+	var meta xdr.TransactionMeta
+	err := xdr.SafeUnmarshalBase64(b64, &meta)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode meta failed")
+	}
+
+	return InspectMeta(meta)
+}
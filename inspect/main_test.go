@@ -0,0 +1,208 @@
+package inspect
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kinecosystem/go/build"
+	"github.com/kinecosystem/go/keypair"
+	"github.com/kinecosystem/go/network"
+	"github.com/kinecosystem/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	seed   = "SDOTALIMPAM2IV65IOZA7KZL7XWZI5BODFXTRVLIHLQZQCKK57PH5F3H"
+	issuer = "GAXEMCEXBERNSRXOEKD4JAIKVECIXQCENHEBRVSPX2TTYZPMNEDSQCNQ"
+)
+
+func TestDecodeEnvelope(t *testing.T) {
+	source := keypair.MustParse(seed)
+	other, err := keypair.Random()
+	require.NoError(t, err)
+
+	tx, err := build.Transaction(
+		build.SourceAccount{seed},
+		build.Sequence{42},
+		build.TestNetwork,
+		build.MemoText{"kin"},
+		build.Timebounds{MinTime: 1500000000},
+		build.Payment(
+			build.Destination{issuer},
+			build.NativeAmount{"12.5"},
+		),
+		build.CreateOffer(
+			build.Rate{
+				Selling: build.CreditAsset("USD", issuer),
+				Buying:  build.NativeAsset(),
+				Price:   build.Price("0.5"),
+			},
+			build.Amount("100"),
+		),
+	)
+	require.NoError(t, err)
+
+	txe, err := tx.Sign(seed)
+	require.NoError(t, err)
+	b64, err := txe.Base64()
+	require.NoError(t, err)
+
+	envelope, err := DecodeEnvelope(b64, Options{
+		NetworkPassphrase: network.TestNetworkPassphrase,
+		Signers:           []string{other.Address(), source.Address()},
+	})
+	require.NoError(t, err)
+
+	hash, err := tx.HashHex()
+	require.NoError(t, err)
+	assert.Equal(t, hash, envelope.Hash)
+	assert.Equal(t, source.Address(), envelope.SourceAccount)
+	assert.Equal(t, "42", envelope.Sequence)
+	assert.Equal(t, Memo{Type: "text", Value: "kin"}, envelope.Memo)
+	assert.Equal(t, "2017-07-14T02:40:00Z", envelope.TimeBounds.ValidAfter)
+	assert.Equal(t, "", envelope.TimeBounds.ValidBefore)
+
+	require.Len(t, envelope.Operations, 2)
+	payment := envelope.Operations[0]
+	assert.Equal(t, "payment", payment.Type)
+	assert.Equal(t, source.Address(), payment.SourceAccount)
+	assert.Equal(t, "12.50000", payment.Details["amount"])
+	assert.Equal(t, "native", payment.Details["asset_type"])
+	assert.Equal(t, issuer, payment.Details["to"])
+
+	offer := envelope.Operations[1]
+	assert.Equal(t, "manage_offer", offer.Type)
+	assert.Equal(t, "0.5000000", offer.Details["price"])
+	assert.Equal(t, "USD", offer.Details["selling_asset_code"])
+	assert.Equal(t, "native", offer.Details["buying_asset_type"])
+
+	require.Len(t, envelope.Signatures, 1)
+	assert.Equal(t, source.Address(), envelope.Signatures[0].Signer)
+	if assert.NotNil(t, envelope.Signatures[0].Verified) {
+		assert.True(t, *envelope.Signatures[0].Verified)
+	}
+
+	// without a passphrase nor signers
+	envelope, err = DecodeEnvelope(b64, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, "", envelope.Hash)
+	assert.Equal(t, "", envelope.Signatures[0].Signer)
+	assert.Nil(t, envelope.Signatures[0].Verified)
+
+	decoded, err := Decode(b64, Options{})
+	require.NoError(t, err)
+	assert.IsType(t, &Envelope{}, decoded)
+}
+
+func TestDecodeResult(t *testing.T) {
+	result := xdr.TransactionResult{
+		FeeCharged: 200,
+		Result: xdr.TransactionResultResult{
+			Code: xdr.TransactionResultCodeTxFailed,
+			Results: &[]xdr.OperationResult{
+				{
+					Code: xdr.OperationResultCodeOpInner,
+					Tr: &xdr.OperationResultTr{
+						Type: xdr.OperationTypePayment,
+						PaymentResult: &xdr.PaymentResult{
+							Code: xdr.PaymentResultCodePaymentUnderfunded,
+						},
+					},
+				},
+				{Code: xdr.OperationResultCodeOpNoAccount},
+			},
+		},
+	}
+
+	b64, err := xdr.MarshalBase64(result)
+	require.NoError(t, err)
+
+	decoded, err := DecodeResult(b64)
+	require.NoError(t, err)
+	assert.Equal(t, &Result{
+		FeeCharged: 200,
+		Code:       "tx_failed",
+		Operations: []OperationResult{
+			{Type: "payment", Code: "op_underfunded"},
+			{Code: "op_no_account"},
+		},
+	}, decoded)
+}
+
+func TestDecodeMeta(t *testing.T) {
+	var account xdr.AccountId
+	require.NoError(t, account.SetAddress(issuer))
+
+	meta := xdr.TransactionMeta{
+		V: 0,
+		Operations: &[]xdr.OperationMeta{{
+			Changes: xdr.LedgerEntryChanges{
+				{
+					Type: xdr.LedgerEntryChangeTypeLedgerEntryUpdated,
+					Updated: &xdr.LedgerEntry{
+						LastModifiedLedgerSeq: 7,
+						Data: xdr.LedgerEntryData{
+							Type: xdr.LedgerEntryTypeAccount,
+							Account: &xdr.AccountEntry{
+								AccountId:  account,
+								Balance:    1250000,
+								SeqNum:     12,
+								Thresholds: xdr.Thresholds{1, 0, 0, 0},
+							},
+						},
+					},
+				},
+				{
+					Type: xdr.LedgerEntryChangeTypeLedgerEntryRemoved,
+					Removed: &xdr.LedgerKey{
+						Type:  xdr.LedgerEntryTypeOffer,
+						Offer: &xdr.LedgerKeyOffer{SellerId: account, OfferId: 3},
+					},
+				},
+			},
+		}},
+	}
+
+	b64, err := xdr.MarshalBase64(meta)
+	require.NoError(t, err)
+
+	decoded, err := DecodeMeta(b64)
+	require.NoError(t, err)
+	require.Len(t, decoded.Operations, 1)
+
+	changes := decoded.Operations[0].Changes
+	require.Len(t, changes, 2)
+	assert.Equal(t, "updated", changes[0].Type)
+	assert.Equal(t, "account", changes[0].Entry)
+	assert.Equal(t, uint32(7), changes[0].LastModifiedLedger)
+	assert.Equal(t, "12.50000", changes[0].Details["balance"])
+	assert.Equal(t, "12", changes[0].Details["sequence"])
+
+	assert.Equal(t, "removed", changes[1].Type)
+	assert.Equal(t, "offer", changes[1].Entry)
+	assert.Equal(t, issuer, changes[1].Details["seller_id"])
+}
+
+func TestWriteText(t *testing.T) {
+	var out bytes.Buffer
+	err := WriteText(&out, &Result{
+		FeeCharged: 100,
+		Code:       "tx_success",
+		Operations: []OperationResult{{Type: "payment", Code: "op_success"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `fee_charged: 100
+result_code: tx_success
+operations:
+  0:
+    type: payment
+    result_code: op_success
+`, out.String())
+}
+
+func TestDecode_Unknown(t *testing.T) {
+	_, err := Decode("AAAA", Options{})
+	assert.Equal(t, ErrUnknownXDR, err)
+}
@@ -0,0 +1,176 @@
+package inspect
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/kinecosystem/go/amount"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// InspectMeta returns the annotated form of `meta`.
+func InspectMeta(meta xdr.TransactionMeta) (*Meta, error) {
+	var (
+		result Meta
+		ops    []xdr.OperationMeta
+		err    error
+	)
+
+	switch meta.V {
+	case 0:
+		ops = meta.MustOperations()
+	case 1:
+		v1 := meta.MustV1()
+		ops = v1.Operations
+
+		result.Changes, err = changes(v1.TxChanges)
+		if err != nil {
+			return nil, errors.Wrap(err, "transaction changes")
+		}
+	default:
+		return nil, errors.Errorf("unknown meta version: %d", meta.V)
+	}
+
+	result.Operations = make([]OperationMeta, len(ops))
+	for i, op := range ops {
+		result.Operations[i].Changes, err = changes(op.Changes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "operation %d", i)
+		}
+	}
+
+	return &result, nil
+}
+
+func changes(changes xdr.LedgerEntryChanges) ([]Change, error) {
+	result := make([]Change, len(changes))
+
+	for i, c := range changes {
+		var err error
+
+		switch c.Type {
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			result[i], err = entryChange("created", c.MustCreated())
+		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+			result[i], err = entryChange("updated", c.MustUpdated())
+		case xdr.LedgerEntryChangeTypeLedgerEntryState:
+			result[i], err = entryChange("state", c.MustState())
+		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+			result[i], err = removedChange(c.MustRemoved())
+		default:
+			err = errors.Errorf("unknown change type: %d", c.Type)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func entryChange(typ string, entry xdr.LedgerEntry) (Change, error) {
+	change := Change{
+		Type:               typ,
+		LastModifiedLedger: uint32(entry.LastModifiedLedgerSeq),
+		Details:            map[string]interface{}{},
+	}
+	d := change.Details
+
+	switch entry.Data.Type {
+	case xdr.LedgerEntryTypeAccount:
+		account := entry.Data.MustAccount()
+		change.Entry = "account"
+		d["account_id"] = account.AccountId.Address()
+		d["balance"] = amount.String(account.Balance)
+		d["sequence"] = strconv.FormatInt(int64(account.SeqNum), 10)
+		d["subentry_count"] = account.NumSubEntries
+		if account.InflationDest != nil {
+			d["inflation_destination"] = account.InflationDest.Address()
+		}
+		if account.HomeDomain != "" {
+			d["home_domain"] = string(account.HomeDomain)
+		}
+		d["flags"] = flagNames(account.Flags)
+		d["master_key_weight"] = account.Thresholds[0]
+		d["low_threshold"] = account.Thresholds[1]
+		d["med_threshold"] = account.Thresholds[2]
+		d["high_threshold"] = account.Thresholds[3]
+
+		signers := make([]map[string]interface{}, len(account.Signers))
+		for i, s := range account.Signers {
+			signers[i] = map[string]interface{}{
+				"key":    s.Key.Address(),
+				"weight": s.Weight,
+			}
+		}
+		d["signers"] = signers
+	case xdr.LedgerEntryTypeTrustline:
+		line := entry.Data.MustTrustLine()
+		change.Entry = "trustline"
+		d["account_id"] = line.AccountId.Address()
+		d["balance"] = amount.String(line.Balance)
+		d["limit"] = amount.String(line.Limit)
+		d["authorized"] = line.Flags&xdr.Uint32(xdr.TrustLineFlagsAuthorizedFlag) != 0
+		if err := assetDetails(d, line.Asset, ""); err != nil {
+			return change, err
+		}
+	case xdr.LedgerEntryTypeOffer:
+		offer := entry.Data.MustOffer()
+		change.Entry = "offer"
+		d["seller_id"] = offer.SellerId.Address()
+		d["offer_id"] = offer.OfferId
+		d["amount"] = amount.String(offer.Amount)
+		priceDetails(d, offer.Price)
+		if err := offerAssetDetails(d, offer.Selling, offer.Buying); err != nil {
+			return change, err
+		}
+	case xdr.LedgerEntryTypeData:
+		data := entry.Data.MustData()
+		change.Entry = "data"
+		d["account_id"] = data.AccountId.Address()
+		d["name"] = string(data.DataName)
+		d["value"] = base64.StdEncoding.EncodeToString(data.DataValue)
+	default:
+		return change, errors.Errorf("unknown ledger entry type: %d", entry.Data.Type)
+	}
+
+	return change, nil
+}
+
+func removedChange(key xdr.LedgerKey) (Change, error) {
+	change := Change{
+		Type:    "removed",
+		Details: map[string]interface{}{},
+	}
+	d := change.Details
+
+	switch key.Type {
+	case xdr.LedgerEntryTypeAccount:
+		account := key.MustAccount()
+		change.Entry = "account"
+		d["account_id"] = account.AccountId.Address()
+	case xdr.LedgerEntryTypeTrustline:
+		line := key.MustTrustLine()
+		change.Entry = "trustline"
+		d["account_id"] = line.AccountId.Address()
+		if err := assetDetails(d, line.Asset, ""); err != nil {
+			return change, err
+		}
+	case xdr.LedgerEntryTypeOffer:
+		offer := key.MustOffer()
+		change.Entry = "offer"
+		d["seller_id"] = offer.SellerId.Address()
+		d["offer_id"] = offer.OfferId
+	case xdr.LedgerEntryTypeData:
+		data := key.MustData()
+		change.Entry = "data"
+		d["account_id"] = data.AccountId.Address()
+		d["name"] = string(data.DataName)
+	default:
+		return change, errors.Errorf("unknown ledger entry type: %d", key.Type)
+	}
+
+	return change, nil
+}
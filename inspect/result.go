@@ -0,0 +1,57 @@
+package inspect
+
+import (
+	"github.com/kinecosystem/go/protocols/horizon/codes"
+	"github.com/kinecosystem/go/protocols/horizon/operations"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// InspectResult returns the annotated form of `result`.
+func InspectResult(result xdr.TransactionResult) (*Result, error) {
+	code, err := codes.String(result.Result.Code)
+	if err != nil {
+		return nil, errors.Wrap(err, "transaction result code")
+	}
+
+	inspected := &Result{
+		FeeCharged: int64(result.FeeCharged),
+		Code:       code,
+	}
+
+	if result.Result.Results == nil {
+		return inspected, nil
+	}
+
+	for i, opResult := range *result.Result.Results {
+		r, err := operationResult(opResult)
+		if err != nil {
+			return nil, errors.Wrapf(err, "operation %d", i)
+		}
+		inspected.Operations = append(inspected.Operations, r)
+	}
+
+	return inspected, nil
+}
+
+// operationResult returns the result code of the operation when it was
+// applied, and the reason it wasn't otherwise.
+func operationResult(result xdr.OperationResult) (OperationResult, error) {
+	if result.Code != xdr.OperationResultCodeOpInner || result.Tr == nil {
+		code, err := codes.String(result.Code)
+		if err != nil {
+			return OperationResult{}, err
+		}
+		return OperationResult{Code: code}, nil
+	}
+
+	code, err := codes.ForOperationResult(result)
+	if err != nil {
+		return OperationResult{}, err
+	}
+
+	return OperationResult{
+		Type: operations.TypeNames[result.Tr.Type],
+		Code: code,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package inspect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kinecosystem/go/support/errors"
+)
+
+// WriteText writes `v` to `w` as indented text, one field per line, in the
+// order of its JSON encoding.  Array elements are labeled by their index.
+func WriteText(w io.Writer, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	t := &textWriter{dec: dec, w: w}
+	return t.value("", "")
+}
+
+type textWriter struct {
+	dec *json.Decoder
+	w   io.Writer
+}
+
+// value writes the next JSON value of the decoder, labeled by `label`.
+func (t *textWriter) value(indent string, label string) error {
+	token, err := t.dec.Token()
+	if err != nil {
+		return errors.Wrap(err, "read token failed")
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return t.line(indent, label, scalar(token))
+	}
+
+	if label != "" {
+		err = t.line(indent, label, "")
+		if err != nil {
+			return err
+		}
+		indent += "  "
+	}
+
+	for i := 0; t.dec.More(); i++ {
+		label := strconv.Itoa(i)
+		if delim == '{' {
+			key, err := t.dec.Token()
+			if err != nil {
+				return errors.Wrap(err, "read key failed")
+			}
+			label = key.(string)
+		}
+
+		err = t.value(indent, label)
+		if err != nil {
+			return err
+		}
+	}
+
+	// consume the closing delimiter
+	_, err = t.dec.Token()
+	return err
+}
+
+func (t *textWriter) line(indent, label, value string) error {
+	_, err := fmt.Fprintln(t.w, strings.TrimRight(indent+label+": "+value, " "))
+	return err
+}
+
+func scalar(token interface{}) string {
+	if token == nil {
+		return "null"
+	}
+	return fmt.Sprint(token)
+}
@@ -39,6 +39,43 @@ func (b *Bundle) Changes(target xdr.LedgerKey) (ret []xdr.LedgerEntryChange) {
 	return b.changes(target, math.MaxInt32)
 }
 
+// ChangedAccounts returns the accounts whose account, trust line or data
+// entries were created, updated or removed within the bundle, in the order
+// they were first changed.
+func (b *Bundle) ChangedAccounts() (ret []xdr.AccountId) {
+	seen := map[string]bool{}
+
+	for _, change := range b.allChanges(math.MaxInt32) {
+		if change.Type == xdr.LedgerEntryChangeTypeLedgerEntryState {
+			continue
+		}
+
+		var aid xdr.AccountId
+		key := change.LedgerKey()
+
+		switch key.Type {
+		case xdr.LedgerEntryTypeAccount:
+			aid = key.MustAccount().AccountId
+		case xdr.LedgerEntryTypeTrustline:
+			aid = key.MustTrustLine().AccountId
+		case xdr.LedgerEntryTypeData:
+			aid = key.MustData().AccountId
+		default:
+			continue
+		}
+
+		address := aid.Address()
+		if seen[address] {
+			continue
+		}
+
+		seen[address] = true
+		ret = append(ret, aid)
+	}
+
+	return ret
+}
+
 // StateAfter returns the state of entry `key` after the application of the
 // operation at `opidx`
 func (b *Bundle) StateAfter(key xdr.LedgerKey, opidx int) (*xdr.LedgerEntry, error) {
@@ -104,6 +141,57 @@ func (b *Bundle) StateBefore(key xdr.LedgerKey, opidx int) (*xdr.LedgerEntry, er
 	}
 }
 
+// EntryState is the state of a ledger entry, nil when it does not exist.
+type EntryState struct {
+	Key   xdr.LedgerKey
+	Entry *xdr.LedgerEntry
+}
+
+// InitialStates returns the state of each ledger entry changed within
+// `bundles`, just prior to their application, in the order the entries were
+// first changed.  `bundles` are the transactions of a single ledger in
+// application order: since stellar-core charges the fees of every transaction
+// of a ledger before applying them, the fee metas of all bundles precede their
+// transaction metas.
+func InitialStates(bundles []Bundle) ([]EntryState, error) {
+	var all []xdr.LedgerEntryChange
+	for i := range bundles {
+		all = append(all, bundles[i].FeeMeta...)
+	}
+	for i := range bundles {
+		all = append(all, bundles[i].metaChanges(math.MaxInt32)...)
+	}
+
+	var ret []EntryState
+	seen := map[string]bool{}
+	for _, change := range all {
+		key := change.LedgerKey()
+		id, err := xdr.MarshalBase64(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		switch change.Type {
+		case xdr.LedgerEntryChangeTypeLedgerEntryState:
+			entry := change.MustState()
+			ret = append(ret, EntryState{Key: key, Entry: &entry})
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			ret = append(ret, EntryState{Key: key})
+		default:
+			// stellar-core emits the state of an entry before updating or
+			// removing it
+			return nil, fmt.Errorf("Unexpected %v change without prior state", change.Type)
+		}
+	}
+
+	return ret, nil
+}
+
 //OperationMetas retrieves all operation metas from a transaction bundle
 func (b *Bundle) OperationsMetas() []xdr.OperationMeta {
 	switch b.TransactionMeta.V {
@@ -131,20 +219,31 @@ func filterChanges(changes []xdr.LedgerEntryChange, target xdr.LedgerKey) (filte
 // changes returns any changes within the bundle that apply to the entry
 // identified by `key` that occurred at or before `maxOp`.
 func (b *Bundle) changes(target xdr.LedgerKey, maxOp int) []xdr.LedgerEntryChange {
+	return filterChanges(b.allChanges(maxOp), target)
+}
+
+// allChanges returns every change within the bundle that occurred at or
+// before `maxOp`.
+func (b *Bundle) allChanges(maxOp int) []xdr.LedgerEntryChange {
 
 	//allChanges accumulates all ledger changes
 	allChanges := b.FeeMeta
+	return append(allChanges, b.metaChanges(maxOp)...)
+}
 
+// metaChanges returns every change of the transaction meta of the bundle that
+// occurred at or before `maxOp`, leaving out the fee meta.
+func (b *Bundle) metaChanges(maxOp int) (ret []xdr.LedgerEntryChange) {
 	if b.TransactionMeta.V > 0 {
-		allChanges = append(allChanges, b.TransactionMeta.V1.TxChanges...)
+		ret = append(ret, b.TransactionMeta.V1.TxChanges...)
 	}
 
 	for i, op := range b.OperationsMetas() {
 		if i > maxOp {
 			break
 		}
-		allChanges = append(allChanges, op.Changes...)
+		ret = append(ret, op.Changes...)
 	}
 
-	return filterChanges(allChanges, target)
+	return ret
 }
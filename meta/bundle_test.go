@@ -65,6 +65,56 @@ var _ = Describe("meta.Bundle", func() {
 		})
 	})
 
+	Describe("InitialStates", func() {
+		It("returns nil for entries created within the bundles", func() {
+			states, err := InitialStates([]Bundle{createAccount})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(states).To(HaveLen(2))
+
+			Expect(states[0].Key.Equals(masterAccount.LedgerKey())).To(BeTrue())
+			Expect(states[0].Entry).ToNot(BeNil())
+			Expect(states[1].Key.Equals(newAccount.LedgerKey())).To(BeTrue())
+			Expect(states[1].Entry).To(BeNil())
+		})
+
+		It("returns the state prior to the first bundle changing an entry", func() {
+			var line xdr.Asset
+			var tlkey xdr.LedgerKey
+			line.SetCredit("USD", gatewayAccount)
+			tlkey.SetTrustline(newAccount, line)
+
+			states, err := InitialStates([]Bundle{updateTrustline, removeTrustline})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(states).To(HaveLen(2))
+
+			expected, err := updateTrustline.InitialState(newAccount.LedgerKey())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(states[0].Key.Equals(newAccount.LedgerKey())).To(BeTrue())
+			Expect(states[0].Entry).To(Equal(expected))
+
+			expected, err = updateTrustline.InitialState(tlkey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(states[1].Key.Equals(tlkey)).To(BeTrue())
+			Expect(states[1].Entry.Data.MustTrustLine().Limit).To(BeNumerically(">", 40000000000))
+			Expect(states[1].Entry).To(Equal(expected))
+		})
+	})
+
+	Describe("ChangedAccounts", func() {
+		It("returns the accounts that were changed", func() {
+			changed := createAccount.ChangedAccounts()
+			Expect(changed).To(HaveLen(2))
+			Expect(changed[0].Equals(masterAccount)).To(BeTrue())
+			Expect(changed[1].Equals(newAccount)).To(BeTrue())
+		})
+
+		It("includes the owners of changed trustlines", func() {
+			changed := removeTrustline.ChangedAccounts()
+			Expect(changed).To(HaveLen(1))
+			Expect(changed[0].Equals(newAccount)).To(BeTrue())
+		})
+	})
+
 	Describe("StateAfter", func() {
 		It("returns newly created entries correctly", func() {
 			state, err := createAccount.StateAfter(newAccount.LedgerKey(), 0)
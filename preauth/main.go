@@ -0,0 +1,224 @@
+// Package preauth builds the transactions of escrow and time-lock flows, where
+// an account is controlled by pre-authorized transactions and hash(x) signers
+// rather than by the keys of its owners.
+//
+// A Chain holds the future transactions of an account, each using the next
+// sequence number of the account and usually bounded in time using
+// build.Timebounds.  Its setup transaction, signed by the current signers of
+// the account, registers the hash of every transaction of the chain as a
+// signer, so that they can later be submitted by anyone without signatures.
+package preauth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+
+	"github.com/kinecosystem/go/build"
+	"github.com/kinecosystem/go/strkey"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/xdr"
+)
+
+const (
+	// PreimageLength is the length of the preimages returned by NewPreimage.
+	PreimageLength = 32
+
+	// MaxPreimageLength is the length of the longest preimage that fits in a
+	// signature.
+	MaxPreimageLength = 64
+)
+
+// Chain is a chain of pre-authorized transactions of an account.
+type Chain struct {
+	// Network is the network the transactions are built for.
+	Network build.Network
+
+	// Source is the address of the account.
+	Source string
+
+	// Sequence is the current sequence number of the account.  The setup
+	// transaction uses the next one.
+	Sequence xdr.SequenceNumber
+
+	// Weight is the weight of the pre-authorized transaction signers. It
+	// should meet the thresholds needed by the operations of the
+	// transactions. When zero, the signers are added with a weight of 1.
+	Weight uint32
+
+	// Transactions are the pre-authorized transactions of the chain, in the
+	// order they were added.
+	Transactions []*build.TransactionBuilder
+
+	last xdr.SequenceNumber
+}
+
+// Add appends a transaction to the chain, using the sequence number following
+// the one of the previous transaction.  `muts` add the operations of the
+// transaction and usually its build.Timebounds.
+func (c *Chain) Add(muts ...build.TransactionMutator) (*build.TransactionBuilder, error) {
+	sequence := c.last + 1
+	if len(c.Transactions) == 0 {
+		// The setup transaction uses the next sequence number.
+		sequence = c.Sequence + 2
+	}
+
+	return c.add(sequence, muts)
+}
+
+// AddAlternative appends a transaction to the chain using the same sequence
+// number as the previous transaction, so that only one of the two can be
+// applied.  It is used to build a refund of an escrow that becomes valid once
+// the release transaction has expired, for example.
+func (c *Chain) AddAlternative(muts ...build.TransactionMutator) (*build.TransactionBuilder, error) {
+	if len(c.Transactions) == 0 {
+		return nil, errors.New("chain has no transaction to add an alternative to")
+	}
+
+	return c.add(c.last, muts)
+}
+
+func (c *Chain) add(
+	sequence xdr.SequenceNumber,
+	muts []build.TransactionMutator,
+) (*build.TransactionBuilder, error) {
+	all := []build.TransactionMutator{
+		build.SourceAccount{c.Source},
+		build.Sequence{uint64(sequence)},
+		c.Network,
+	}
+
+	tx, err := build.Transaction(append(all, muts...)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "build transaction failed")
+	}
+
+	c.last = sequence
+	c.Transactions = append(c.Transactions, tx)
+	return tx, nil
+}
+
+// Setup builds the transaction registering the transactions of the chain as
+// signers of the account, along with the operations added by `muts`.  It must
+// be signed by the current signers of the account and submitted before any
+// transaction of the chain.  Every signer is a subentry of the account,
+// raising its minimum balance, and is removed once its transaction is applied.
+func (c *Chain) Setup(muts ...build.TransactionMutator) (*build.TransactionBuilder, error) {
+	all := []build.TransactionMutator{
+		build.SourceAccount{c.Source},
+		build.Sequence{uint64(c.Sequence + 1)},
+		c.Network,
+	}
+	all = append(all, muts...)
+
+	weight := c.Weight
+	if weight == 0 {
+		weight = 1
+	}
+
+	for i, tx := range c.Transactions {
+		signer, err := Signer(tx, weight)
+		if err != nil {
+			return nil, errors.Wrapf(err, "transaction %d", i)
+		}
+		all = append(all, signer)
+	}
+
+	tx, err := build.Transaction(all...)
+	if err != nil {
+		return nil, errors.Wrap(err, "build transaction failed")
+	}
+
+	return tx, nil
+}
+
+// Envelopes returns the base64 encoded envelopes of the transactions of the
+// chain, ready to be submitted once their time bounds are met.
+func (c *Chain) Envelopes() ([]string, error) {
+	envelopes := make([]string, len(c.Transactions))
+
+	for i, tx := range c.Transactions {
+		txe, err := tx.Envelope()
+		if err != nil {
+			return nil, errors.Wrapf(err, "transaction %d", i)
+		}
+
+		envelopes[i], err = txe.Base64()
+		if err != nil {
+			return nil, errors.Wrapf(err, "transaction %d", i)
+		}
+	}
+
+	return envelopes, nil
+}
+
+// Signer returns a mutator adding the hash of `tx` as a pre-authorized
+// transaction signer of weight `weight`.
+func Signer(tx *build.TransactionBuilder, weight uint32) (build.Signer, error) {
+	hash, err := tx.Hash()
+	if err != nil {
+		return build.Signer{}, errors.Wrap(err, "hash tx failed")
+	}
+
+	address, err := strkey.Encode(strkey.VersionByteHashTx, hash[:])
+	if err != nil {
+		return build.Signer{}, errors.Wrap(err, "encode hash failed")
+	}
+
+	return build.AddSigner(address, weight), nil
+}
+
+// NewPreimage returns a random preimage to use with a hash(x) signer.
+func NewPreimage() ([]byte, error) {
+	preimage := make([]byte, PreimageLength)
+	_, err := rand.Read(preimage)
+	if err != nil {
+		return nil, errors.Wrap(err, "read random bytes failed")
+	}
+	return preimage, nil
+}
+
+// HashXSigner returns a mutator adding the hash of `preimage` as a hash(x)
+// signer of weight `weight`.  Revealing the preimage, by signing a
+// transaction with it, lets anyone sign with the signer.
+func HashXSigner(preimage []byte, weight uint32) (build.Signer, error) {
+	hash := sha256.Sum256(preimage)
+
+	address, err := strkey.Encode(strkey.VersionByteHashX, hash[:])
+	if err != nil {
+		return build.Signer{}, errors.Wrap(err, "encode hash failed")
+	}
+
+	return build.AddSigner(address, weight), nil
+}
+
+// HashXSignature returns the signature of the hash(x) signer of `preimage`:
+// the preimage itself, decorated with the hint of its hash.
+func HashXSignature(preimage []byte) xdr.DecoratedSignature {
+	hash := sha256.Sum256(preimage)
+
+	var hint xdr.SignatureHint
+	copy(hint[:], hash[28:])
+
+	return xdr.DecoratedSignature{
+		Hint:      hint,
+		Signature: xdr.Signature(preimage),
+	}
+}
+
+// Preimage is a transaction envelope mutator adding the signature of the
+// hash(x) signer of its preimage.
+type Preimage []byte
+
+// MutateTransactionEnvelope adds the hash(x) signature of the preimage to the
+// provided envelope.
+func (m Preimage) MutateTransactionEnvelope(txe *build.TransactionEnvelopeBuilder) error {
+	if len(m) == 0 {
+		return errors.New("empty preimage")
+	}
+	if len(m) > MaxPreimageLength {
+		return errors.Errorf("preimage longer than %d bytes", MaxPreimageLength)
+	}
+
+	txe.E.Signatures = append(txe.E.Signatures, HashXSignature(m))
+	return nil
+}
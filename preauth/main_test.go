@@ -0,0 +1,115 @@
+package preauth
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/kinecosystem/go/build"
+	"github.com/kinecosystem/go/keypair"
+	"github.com/kinecosystem/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	escrowSeed  = "SDOTALIMPAM2IV65IOZA7KZL7XWZI5BODFXTRVLIHLQZQCKK57PH5F3H"
+	destination = "GAXEMCEXBERNSRXOEKD4JAIKVECIXQCENHEBRVSPX2TTYZPMNEDSQCNQ"
+)
+
+func TestChain(t *testing.T) {
+	escrow := keypair.MustParse(escrowSeed)
+	chain := &Chain{
+		Network:  build.TestNetwork,
+		Source:   escrow.Address(),
+		Sequence: 100,
+	}
+
+	_, err := chain.AddAlternative(build.Inflation())
+	assert.Error(t, err)
+
+	release, err := chain.Add(
+		build.Timebounds{MaxTime: 2000},
+		build.AccountMerge(build.Destination{destination}),
+	)
+	require.NoError(t, err)
+
+	refund, err := chain.AddAlternative(
+		build.Timebounds{MinTime: 2000},
+		build.AccountMerge(build.Destination{escrow.Address()}),
+	)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 102, release.TX.SeqNum)
+	assert.EqualValues(t, 102, refund.TX.SeqNum)
+	assert.EqualValues(t, 2000, refund.TX.TimeBounds.MinTime)
+
+	setup, err := chain.Setup(build.MasterWeight(0), build.SetThresholds(1, 1, 1))
+	require.NoError(t, err)
+	assert.EqualValues(t, 101, setup.TX.SeqNum)
+	require.Len(t, setup.TX.Operations, 4)
+	assert.EqualValues(t, 400, setup.TX.Fee)
+
+	// the setup transaction registers the chain as signers
+	account := build.AccountSigners{
+		AccountID:  escrow.Address(),
+		Thresholds: xdr.Thresholds{0, 1, 1, 1},
+	}
+	for _, op := range setup.TX.Operations[2:] {
+		account.Signers = append(account.Signers, *op.Body.MustSetOptionsOp().Signer)
+	}
+
+	for _, tx := range []*build.TransactionBuilder{release, refund} {
+		txe, err := tx.Envelope()
+		require.NoError(t, err)
+
+		report, err := txe.VerifySignatures(account)
+		require.NoError(t, err)
+		assert.True(t, report.Met())
+	}
+
+	envelopes, err := chain.Envelopes()
+	require.NoError(t, err)
+	assert.Len(t, envelopes, 2)
+}
+
+func TestHashX(t *testing.T) {
+	escrow := keypair.MustParse(escrowSeed)
+
+	preimage, err := NewPreimage()
+	require.NoError(t, err)
+	assert.Len(t, preimage, PreimageLength)
+
+	signer, err := HashXSigner(preimage, 1)
+	require.NoError(t, err)
+
+	account := build.AccountSigners{
+		AccountID:  escrow.Address(),
+		Thresholds: xdr.Thresholds{0, 1, 1, 1},
+		Signers:    []xdr.Signer{{Weight: 1}},
+	}
+	require.NoError(t, account.Signers[0].Key.SetAddress(signer.Address))
+
+	hash := sha256.Sum256(preimage)
+	assert.Equal(t, xdr.Uint256(hash), account.Signers[0].Key.MustHashX())
+
+	tx, err := build.Transaction(
+		build.SourceAccount{escrow.Address()},
+		build.Sequence{101},
+		build.TestNetwork,
+		build.Payment(
+			build.Destination{destination},
+			build.NativeAmount{"10"},
+		),
+	)
+	require.NoError(t, err)
+
+	txe, err := tx.Envelope(Preimage(preimage))
+	require.NoError(t, err)
+
+	report, err := txe.VerifySignatures(account)
+	require.NoError(t, err)
+	assert.True(t, report.Met())
+
+	_, err = tx.Envelope(Preimage(nil))
+	assert.Error(t, err)
+}
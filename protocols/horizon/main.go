@@ -7,6 +7,7 @@ import (
 
 	"encoding/base64"
 	"encoding/json"
+	"strconv"
 
 	"github.com/kinecosystem/go/protocols/horizon/base"
 	"github.com/kinecosystem/go/strkey"
@@ -133,6 +134,21 @@ type Balance struct {
 	base.Asset
 }
 
+// BalanceHistory represents the balance of an account in an asset at the end of
+// a time bucket, and the amounts credited and debited during that bucket.
+type BalanceHistory struct {
+	Timestamp   int64  `json:"timestamp"`
+	ChangeCount int64  `json:"change_count"`
+	Credited    string `json:"credited"`
+	Debited     string `json:"debited"`
+	Balance     string `json:"balance"`
+}
+
+// PagingToken implementation for hal.Pageable. Not actually used
+func (res BalanceHistory) PagingToken() string {
+	return strconv.FormatInt(res.Timestamp, 10)
+}
+
 // HistoryAccount is a simple resource, used for the account collection actions.
 // It provides only the "TotalOrderID" of the account and its account id.
 type HistoryAccount struct {
@@ -363,24 +379,26 @@ type Transaction struct {
 		Precedes   hal.Link `json:"precedes"`
 		Succeeds   hal.Link `json:"succeeds"`
 	} `json:"_links"`
-	ID              string    `json:"id"`
-	PT              string    `json:"paging_token"`
-	Hash            string    `json:"hash"`
-	Ledger          int32     `json:"ledger"`
-	LedgerCloseTime time.Time `json:"created_at"`
-	Account         string    `json:"source_account"`
-	AccountSequence string    `json:"source_account_sequence"`
-	FeePaid         int32     `json:"fee_paid"`
-	OperationCount  int32     `json:"operation_count"`
-	EnvelopeXdr     string    `json:"envelope_xdr"`
-	ResultXdr       string    `json:"result_xdr"`
-	ResultMetaXdr   string    `json:"result_meta_xdr"`
-	FeeMetaXdr      string    `json:"fee_meta_xdr"`
-	MemoType        string    `json:"memo_type"`
-	Memo            string    `json:"memo,omitempty"`
-	Signatures      []string  `json:"signatures"`
-	ValidAfter      string    `json:"valid_after,omitempty"`
-	ValidBefore     string    `json:"valid_before,omitempty"`
+	ID              string                  `json:"id"`
+	PT              string                  `json:"paging_token"`
+	Successful      bool                    `json:"successful"`
+	ResultCodes     *TransactionResultCodes `json:"result_codes,omitempty"`
+	Hash            string                  `json:"hash"`
+	Ledger          int32                   `json:"ledger"`
+	LedgerCloseTime time.Time               `json:"created_at"`
+	Account         string                  `json:"source_account"`
+	AccountSequence string                  `json:"source_account_sequence"`
+	FeePaid         int32                   `json:"fee_paid"`
+	OperationCount  int32                   `json:"operation_count"`
+	EnvelopeXdr     string                  `json:"envelope_xdr"`
+	ResultXdr       string                  `json:"result_xdr"`
+	ResultMetaXdr   string                  `json:"result_meta_xdr"`
+	FeeMetaXdr      string                  `json:"fee_meta_xdr"`
+	MemoType        string                  `json:"memo_type"`
+	Memo            string                  `json:"memo,omitempty"`
+	Signatures      []string                `json:"signatures"`
+	ValidAfter      string                  `json:"valid_after,omitempty"`
+	ValidBefore     string                  `json:"valid_before,omitempty"`
 }
 
 // MarshalJSON implements a custom marshaler for Transaction.
@@ -412,17 +430,78 @@ type TransactionResultCodes struct {
 	OperationCodes  []string `json:"operations,omitempty"`
 }
 
+// AsyncTransactionSubmission represents the response of an asynchronous
+// transaction submission: the status stellar-core responded with.
+type AsyncTransactionSubmission struct {
+	Links struct {
+		Status hal.Link `json:"status"`
+	} `json:"_links"`
+	Hash           string `json:"hash"`
+	TxStatus       string `json:"tx_status"`
+	ErrorResultXDR string `json:"error_result_xdr,omitempty"`
+}
+
+// TransactionStatus represents the status of an asynchronously submitted
+// transaction: `pending`, `success` or `failed`.  Once the transaction is
+// applied, the ledger and the XDR fields are populated.
+type TransactionStatus struct {
+	Links struct {
+		Transaction hal.Link `json:"transaction"`
+	} `json:"_links"`
+	Hash   string `json:"hash"`
+	Status string `json:"status"`
+	Ledger int32  `json:"ledger,omitempty"`
+	Env    string `json:"envelope_xdr,omitempty"`
+	Result string `json:"result_xdr,omitempty"`
+	Meta   string `json:"result_meta_xdr,omitempty"`
+}
+
 // TransactionSuccess represents the result of a successful transaction
 // submission.
 type TransactionSuccess struct {
 	Links struct {
 		Transaction hal.Link `json:"transaction"`
 	} `json:"_links"`
-	Hash   string `json:"hash"`
-	Ledger int32  `json:"ledger"`
-	Env    string `json:"envelope_xdr"`
-	Result string `json:"result_xdr"`
-	Meta   string `json:"result_meta_xdr"`
+	Hash     string              `json:"hash"`
+	Ledger   int32               `json:"ledger"`
+	Env      string              `json:"envelope_xdr"`
+	Result   string              `json:"result_xdr"`
+	Meta     string              `json:"result_meta_xdr"`
+	Attempts []SubmissionAttempt `json:"attempts,omitempty"`
+}
+
+// SubmissionAttempt describes one submission to stellar-core of a
+// transaction horizon resubmitted with a higher fee.
+type SubmissionAttempt struct {
+	Hash        string                  `json:"hash"`
+	Env         string                  `json:"envelope_xdr"`
+	FeePaid     int32                   `json:"fee_paid"`
+	ResultCodes *TransactionResultCodes `json:"result_codes,omitempty"`
+}
+
+// WebhookSubscription represents a subscription to webhook notifications of
+// ingested effects.  The secret notifications are signed with is only shown
+// when the subscription is created.
+type WebhookSubscription struct {
+	Links struct {
+		Self hal.Link `json:"self"`
+	} `json:"_links"`
+
+	ID          string    `json:"id"`
+	PT          string    `json:"paging_token"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"secret,omitempty"`
+	Account     string    `json:"account,omitempty"`
+	AssetType   string    `json:"asset_type,omitempty"`
+	AssetCode   string    `json:"asset_code,omitempty"`
+	AssetIssuer string    `json:"asset_issuer,omitempty"`
+	EffectType  string    `json:"effect_type,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// PagingToken implementation for hal.Pageable
+func (res WebhookSubscription) PagingToken() string {
+	return res.PT
 }
 
 // KeyTypeFromAddress converts the version byte of the provided strkey encoded
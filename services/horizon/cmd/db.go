@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -21,9 +22,13 @@ var dbCmd = &cobra.Command{
 	Short: "commands to manage horizon's postgres db",
 }
 
+var backfillArchiveURL string
+
 var dbBackfillCmd = &cobra.Command{
 	Use:   "backfill [COUNT]",
 	Short: "backfills horizon history for COUNT ledgers",
+	Long: "backfill ingests COUNT ledgers older than the oldest ledger in the history database, " +
+		"from stellar-core's database or, with --history-archive-url, from a history archive.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) == 0 {
 			log.Println("Missing COUNT. Usage: backfill [COUNT].")
@@ -34,6 +39,15 @@ var dbBackfillCmd = &cobra.Command{
 
 		i := ingestSystem(ingest.Config{})
 		i.SkipCursorUpdate = true
+
+		if backfillArchiveURL != "" {
+			source, err := ingest.NewArchiveSource(backfillArchiveURL, i.Network)
+			if err != nil {
+				log.Fatal(err)
+			}
+			i.BackfillSource = source
+		}
+
 		parsed, err := strconv.ParseUint(args[0], 10, 32)
 		if err != nil {
 			log.Fatal(err)
@@ -182,10 +196,18 @@ var dbRebaseCmd = &cobra.Command{
 	},
 }
 
+var (
+	reingestWorkers   int
+	reingestChunkSize int32
+	reingestResume    bool
+)
+
 var dbReingestCmd = &cobra.Command{
-	Use:   "reingest",
+	Use:   "reingest [outdated|range START END|SEQUENCE...]",
 	Short: "imports all data",
-	Long:  "reingest runs the ingestion pipeline over every ledger",
+	Long: "reingest runs the ingestion pipeline over every ledger, the outdated ones, the ledgers from START to END " +
+		"or the listed ledgers.  Every ledger and ranges of ledgers can be reingested in parallel chunks, " +
+		"each committed on its own, see --parallel-workers, --parallel-job-size and --resume.",
 	Run: func(cmd *cobra.Command, args []string) {
 		initConfig()
 
@@ -230,6 +252,11 @@ var dbReingestCmd = &cobra.Command{
 }
 
 func init() {
+	dbBackfillCmd.Flags().StringVar(&backfillArchiveURL, "history-archive-url", "", "history archive to load the backfilled ledgers from instead of stellar-core's database, e.g. https://history.stellar.org/prd/core-live/core_live_001")
+	dbReingestCmd.Flags().IntVar(&reingestWorkers, "parallel-workers", 1, "number of ledger chunks reingested concurrently when reingesting every ledger or a range")
+	dbReingestCmd.Flags().Int32Var(&reingestChunkSize, "parallel-job-size", 10000, "number of ledgers in a chunk, each chunk is reingested in its own database transaction")
+	dbReingestCmd.Flags().BoolVar(&reingestResume, "resume", false, "skip the chunks already reingested by the current version of the ingestion, to resume an interrupted reingestion; a chunk interrupted midway is rolled back and reingested whole")
+
 	rootCmd.AddCommand(dbCmd)
 	dbCmd.AddCommand(
 		dbInitCmd,
@@ -263,7 +290,12 @@ func ingestSystem(ingestConfig ingest.Config) *ingest.System {
 }
 
 func reingest(i *ingest.System, args []string) (int, error) {
+	parallel := reingestWorkers > 1 || reingestResume
+
 	if len(args) == 0 {
+		if parallel {
+			return i.ReingestAllParallel(parallelReingest())
+		}
 		return i.ReingestAll()
 	}
 
@@ -271,6 +303,27 @@ func reingest(i *ingest.System, args []string) (int, error) {
 		return i.ReingestOutdated()
 	}
 
+	if args[0] == "range" {
+		if len(args) != 3 {
+			return 0, errors.New("Usage: reingest range START END")
+		}
+
+		start, err := strconv.Atoi(args[1])
+		if err != nil {
+			return 0, err
+		}
+
+		end, err := strconv.Atoi(args[2])
+		if err != nil {
+			return 0, err
+		}
+
+		if parallel {
+			return i.ReingestRangeParallel(int32(start), int32(end), parallelReingest())
+		}
+		return i.ReingestRange(int32(start), int32(end))
+	}
+
 	for idx, arg := range args {
 		seq, err := strconv.Atoi(arg)
 		if err != nil {
@@ -284,3 +337,25 @@ func reingest(i *ingest.System, args []string) (int, error) {
 	}
 	return len(args), nil
 }
+
+func parallelReingest() ingest.ParallelReingest {
+	return ingest.ParallelReingest{
+		Workers:   reingestWorkers,
+		ChunkSize: reingestChunkSize,
+		Resume:    reingestResume,
+		Progress: func(p ingest.ReingestProgress) {
+			entry := hlog.WithField("first", p.First).
+				WithField("last", p.Last).
+				WithField("done", fmt.Sprintf("%d/%d", p.Done, p.Chunks)).
+				WithField("skipped", p.Skipped).
+				WithField("ingested", p.Ingested)
+
+			if p.Err != nil {
+				entry.WithField("err", p.Err.Error()).Error("reingest: chunk failed")
+				return
+			}
+
+			entry.Info("reingest: chunk complete")
+		},
+	}
+}
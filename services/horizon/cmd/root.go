@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"go/types"
 	stdLog "log"
+	"net"
 	"os"
+	"strings"
 
+	"github.com/kinecosystem/go/keypair"
 	horizon "github.com/kinecosystem/go/services/horizon/internal"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/schema"
 	apkg "github.com/kinecosystem/go/support/app"
@@ -151,17 +154,111 @@ var configOpts = []*support.ConfigOption{
 		},
 		Usage: "max count of requests allowed in a one hour period, by remote ip address",
 	},
+	&support.ConfigOption{
+		Name:      "rate-limit-tiers",
+		ConfigKey: &config.RateLimitTiers,
+		OptType:   types.String,
+		CustomSetValue: func(co *support.ConfigOption) {
+			path := viper.GetString(co.Name)
+			if path == "" {
+				return
+			}
+
+			tiers, err := horizon.LoadRateLimitTiers(path)
+			if err != nil {
+				stdLog.Fatalf("Could not load rate-limit-tiers: %v", err)
+			}
+			*(co.ConfigKey.(*[]horizon.RateLimitTier)) = tiers
+		},
+		Usage: "JSON file defining rate limit tiers: each tier has a name, api_keys sent in the X-Api-Key header and cidrs identifying its clients, and requests_per_hour, streams_per_hour and submissions_per_hour limits (0 means unlimited) replacing per-hour-rate-limit for them",
+	},
+	&support.ConfigOption{
+		Name:      "trusted-proxies",
+		ConfigKey: &config.TrustedProxies,
+		OptType:   types.String,
+		CustomSetValue: func(co *support.ConfigOption) {
+			value := viper.GetString(co.Name)
+			if value == "" {
+				return
+			}
+
+			var networks []*net.IPNet
+			for _, cidr := range strings.Split(value, ",") {
+				_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+				if err != nil {
+					stdLog.Fatalf("Could not parse %s: %v", co.Name, err)
+				}
+				networks = append(networks, network)
+			}
+			*(co.ConfigKey.(*[]*net.IPNet)) = networks
+		},
+		Usage: "comma-separated CIDRs of the proxies trusted to send the client address in X-Forwarded-For, the address of any other peer is used as the client address",
+	},
 	&support.ConfigOption{
 		Name:      "rate-limit-redis-key",
 		ConfigKey: &config.RateLimitRedisKey,
 		OptType:   types.String,
 		Usage:     "redis key for storing rate limit data, useful when deploying a cluster of Horizons, ignored when redis-url is empty",
 	},
+	&support.ConfigOption{
+		Name:      "txsub-redis-key",
+		ConfigKey: &config.TxSubRedisKey,
+		OptType:   types.String,
+		Usage:     "redis key for sharing open transaction submissions, useful when deploying a cluster of Horizons, ignored when redis-url is empty",
+	},
+	&support.ConfigOption{
+		Name:      "fee-bump-seeds",
+		ConfigKey: &config.FeeBumpSeeds,
+		OptType:   types.String,
+		CustomSetValue: func(co *support.ConfigOption) {
+			value := viper.GetString(co.Name)
+			if value == "" {
+				return
+			}
+
+			var seeds []string
+			for _, seed := range strings.Split(value, ",") {
+				seed = strings.TrimSpace(seed)
+				kp, err := keypair.Parse(seed)
+				if err != nil {
+					stdLog.Fatalf("Could not parse %s: %v", co.Name, err)
+				}
+				if _, ok := kp.(*keypair.Full); !ok {
+					stdLog.Fatalf("Invalid config: %s must only contain secret seeds", co.Name)
+				}
+				seeds = append(seeds, seed)
+			}
+			*(co.ConfigKey.(*[]string)) = seeds
+		},
+		Usage: "comma separated secret seeds of server-owned accounts: their transactions rejected with tx_insufficient_fee are re-signed and resubmitted with a higher fee, unless they carry signatures of other signers",
+	},
+	&support.ConfigOption{
+		Name:        "fee-bump-max-attempts",
+		ConfigKey:   &config.FeeBumpMaxAttempts,
+		OptType:     types.Uint,
+		FlagDefault: uint(3),
+		Usage:       "the maximum number of times a transaction of the fee-bump-seeds accounts is resubmitted with a higher fee",
+	},
+	&support.ConfigOption{
+		Name:        "fee-bump-max-base-fee",
+		ConfigKey:   &config.FeeBumpMaxBaseFee,
+		OptType:     types.Uint,
+		FlagDefault: uint(0),
+		Usage:       "the maximum fee per operation, in stroops, of resubmitted transactions (0 means no maximum)",
+	},
+	&support.ConfigOption{
+		Name:           "fee-bump-backoff",
+		ConfigKey:      &config.FeeBumpBackoff,
+		OptType:        types.Int,
+		FlagDefault:    1,
+		CustomSetValue: support.SetDuration,
+		Usage:          "seconds to wait before resubmitting a transaction with a higher fee, doubled before each following resubmission",
+	},
 	&support.ConfigOption{
 		Name:      "redis-url",
 		ConfigKey: &config.RedisURL,
 		OptType:   types.String,
-		Usage:     "redis to connect with, for rate limiting",
+		Usage:     "redis to connect with, for rate limiting and sharing open transaction submissions",
 	},
 	&support.ConfigOption{
 		Name:           "friendbot-url",
@@ -197,6 +294,13 @@ var configOpts = []*support.ConfigOption{
 		FlagDefault: uint(4),
 		Usage:       "the maximum number of assets on the path in `/paths` endpoint",
 	},
+	&support.ConfigOption{
+		Name:        "path-finder",
+		ConfigKey:   &config.PathFinder,
+		OptType:     types.String,
+		FlagDefault: "simple",
+		Usage:       "path finding implementation used by `/paths` endpoint: simple (breadth first search against the core db) or graph (cheapest paths over an in-memory order book graph reloaded every ledger)",
+	},
 	&support.ConfigOption{
 		Name:      "network-passphrase",
 		ConfigKey: &config.NetworkPassphrase,
@@ -264,6 +368,20 @@ var configOpts = []*support.ConfigOption{
 		FlagDefault: uint(0),
 		Usage:       "the maximum number of ledgers the history db is allowed to be out of date from the connected stellar-core db before horizon considers history stale",
 	},
+	&support.ConfigOption{
+		Name:        "fee-stats-ledgers",
+		ConfigKey:   &config.FeeStatsLedgers,
+		OptType:     types.Uint,
+		FlagDefault: uint(5),
+		Usage:       "the number of recent ledgers the operation fee stats are computed over",
+	},
+	&support.ConfigOption{
+		Name:        "account-state-max-ledgers",
+		ConfigKey:   &config.AccountStateMaxLedgers,
+		OptType:     types.Uint,
+		FlagDefault: uint(17280),
+		Usage:       "the number of ledgers in the past the state of an account may be requested at with `/accounts/{id}?ledger=`",
+	},
 	&support.ConfigOption{
 		Name:        "skip-cursor-update",
 		ConfigKey:   &config.SkipCursorUpdate,
@@ -278,6 +396,34 @@ var configOpts = []*support.ConfigOption{
 		FlagDefault: false,
 		Usage:       "enables asset stats during the ingestion and expose `/assets` endpoint, Enabling it has a negative impact on CPU",
 	},
+	&support.ConfigOption{
+		Name:        "enable-webhooks",
+		ConfigKey:   &config.EnableWebhooks,
+		OptType:     types.Bool,
+		FlagDefault: false,
+		Usage:       "enables webhook notifications of ingested effects and the `/admin/webhooks` endpoints managing their subscriptions",
+	},
+	&support.ConfigOption{
+		Name:        "webhook-admin-token",
+		ConfigKey:   &config.WebhookAdminToken,
+		OptType:     types.String,
+		FlagDefault: "",
+		Usage:       "bearer token required by the `/admin/webhooks` endpoints",
+	},
+	&support.ConfigOption{
+		Name:        "enable-graphql",
+		ConfigKey:   &config.EnableGraphQL,
+		OptType:     types.Bool,
+		FlagDefault: false,
+		Usage:       "enables the `/graphql` endpoint querying the history database",
+	},
+	&support.ConfigOption{
+		Name:        "graphql-max-cost",
+		ConfigKey:   &config.GraphQLMaxCost,
+		OptType:     types.Uint,
+		FlagDefault: uint(100),
+		Usage:       "the number of pages of records a GraphQL query may load, each counted as a request by the rate limiter",
+	},
 }
 
 func init() {
@@ -0,0 +1,235 @@
+// Package accountstate reconstructs the state of accounts at past ledgers.
+// Starting from the current state stored by stellar-core, it reverts every
+// entry of the account changed after the requested ledger to its state prior
+// to the first change, as found in the ledger entry changes of the
+// transactions stored in history.
+package accountstate
+
+import (
+	"database/sql"
+
+	"github.com/kinecosystem/go/meta"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// State is the state of an account and of its entries, as stored by
+// stellar-core.
+type State struct {
+	Account    core.Account
+	Data       []core.AccountData
+	Signers    []core.Signer
+	Trustlines []core.Trustline
+}
+
+// Load returns the state of account `address` at the close of ledger `seq`.
+// The state of stellar-core loaded by `cq` must be the one at the close of
+// ledger `last`, and the transactions of the ledgers following `seq` up to
+// `last` must be stored in history with their meta.  sql.ErrNoRows is
+// returned when the account did not exist at ledger `seq`.
+func Load(
+	cq *core.Q,
+	hq *history.Q,
+	address string,
+	seq int32,
+	last int32,
+	protocolVersion int32,
+) (State, error) {
+	var (
+		state  State
+		exists = true
+	)
+
+	err := cq.AccountByAddress(&state.Account, address, protocolVersion)
+	if cq.NoRows(err) {
+		exists = false
+	} else if err != nil {
+		return state, errors.Wrap(err, "failed to load account")
+	}
+
+	if exists {
+		err = cq.AllDataByAddress(&state.Data, address)
+		if err != nil {
+			return state, errors.Wrap(err, "failed to load data")
+		}
+
+		err = cq.SignersByAddress(&state.Signers, address)
+		if err != nil {
+			return state, errors.Wrap(err, "failed to load signers")
+		}
+
+		err = cq.TrustlinesByAddress(&state.Trustlines, address, protocolVersion)
+		if err != nil {
+			return state, errors.Wrap(err, "failed to load trustlines")
+		}
+	}
+
+	var txs []history.Transaction
+	err = hq.TransactionsChangingAccount(&txs, address, seq, last)
+	if err != nil && !hq.NoRows(err) {
+		return state, errors.Wrap(err, "failed to load transactions")
+	}
+
+	initial, err := initialStates(txs, address)
+	if err != nil {
+		return state, err
+	}
+
+	for _, s := range initial {
+		switch s.Key.Type {
+		case xdr.LedgerEntryTypeAccount:
+			exists = s.Entry != nil
+			if exists {
+				entry := s.Entry.Data.MustAccount()
+				state.Account = core.NewAccount(entry)
+				state.Signers = core.NewSigners(entry)
+			}
+		case xdr.LedgerEntryTypeTrustline:
+			err = state.revertTrustline(s)
+		case xdr.LedgerEntryTypeData:
+			state.revertData(s)
+		}
+
+		if err != nil {
+			return state, err
+		}
+	}
+
+	if !exists {
+		return state, sql.ErrNoRows
+	}
+
+	return state, nil
+}
+
+// initialStates returns the state of the entries of account `address` prior
+// to their first change by `txs`.
+func initialStates(txs []history.Transaction, address string) ([]meta.EntryState, error) {
+	var (
+		ret     []meta.EntryState
+		bundles []meta.Bundle
+		seen    = map[string]bool{}
+	)
+
+	flush := func() error {
+		states, err := meta.InitialStates(bundles)
+		if err != nil {
+			return errors.Wrap(err, "failed to replay changes")
+		}
+		bundles = nil
+
+		for _, s := range states {
+			if owner(s.Key) != address {
+				continue
+			}
+
+			id, err := xdr.MarshalBase64(s.Key)
+			if err != nil {
+				return err
+			}
+
+			if !seen[id] {
+				seen[id] = true
+				ret = append(ret, s)
+			}
+		}
+		return nil
+	}
+
+	for i, tx := range txs {
+		if !tx.TxMeta.Valid || !tx.TxFeeMeta.Valid {
+			return nil, errors.Errorf("transaction %s has no meta", tx.TransactionHash)
+		}
+
+		var b meta.Bundle
+		err := xdr.SafeUnmarshalBase64(tx.TxFeeMeta.String, &b.FeeMeta)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid fee meta of transaction %s", tx.TransactionHash)
+		}
+
+		err = xdr.SafeUnmarshalBase64(tx.TxMeta.String, &b.TransactionMeta)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid meta of transaction %s", tx.TransactionHash)
+		}
+
+		// the fees of the transactions of a ledger are charged before any of
+		// them is applied, so that their changes are replayed by ledger
+		if i > 0 && txs[i-1].LedgerSequence != tx.LedgerSequence {
+			err = flush()
+			if err != nil {
+				return nil, err
+			}
+		}
+		bundles = append(bundles, b)
+	}
+
+	err := flush()
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// owner returns the address of the account owning the entry of `key`.
+func owner(key xdr.LedgerKey) string {
+	var id xdr.AccountId
+	switch key.Type {
+	case xdr.LedgerEntryTypeAccount:
+		id = key.MustAccount().AccountId
+	case xdr.LedgerEntryTypeTrustline:
+		id = key.MustTrustLine().AccountId
+	case xdr.LedgerEntryTypeData:
+		id = key.MustData().AccountId
+	default:
+		return ""
+	}
+	return id.Address()
+}
+
+func (state *State) revertTrustline(s meta.EntryState) error {
+	key := s.Key.MustTrustLine()
+	var typ xdr.AssetType
+	var code, issuer string
+	err := key.Asset.Extract(&typ, &code, &issuer)
+	if err != nil {
+		return err
+	}
+
+	kept := state.Trustlines[:0]
+	for _, tl := range state.Trustlines {
+		if tl.Assettype != typ || tl.Assetcode != code || tl.Issuer != issuer {
+			kept = append(kept, tl)
+		}
+	}
+	state.Trustlines = kept
+
+	if s.Entry == nil {
+		return nil
+	}
+
+	tl, err := core.NewTrustline(s.Entry.Data.MustTrustLine())
+	if err != nil {
+		return err
+	}
+	state.Trustlines = append(state.Trustlines, tl)
+	return nil
+}
+
+func (state *State) revertData(s meta.EntryState) {
+	name := string(s.Key.MustData().DataName)
+
+	kept := state.Data[:0]
+	for _, d := range state.Data {
+		if d.Key != name {
+			kept = append(kept, d)
+		}
+	}
+	state.Data = kept
+
+	if s.Entry != nil {
+		state.Data = append(state.Data, core.NewAccountData(s.Entry.Data.MustData()))
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/kinecosystem/go/services/horizon/internal/ledger"
 	"github.com/kinecosystem/go/services/horizon/internal/render/problem"
 	"github.com/kinecosystem/go/services/horizon/internal/toid"
+	"github.com/kinecosystem/go/services/horizon/internal/webhooks"
 	"github.com/kinecosystem/go/support/errors"
 	"github.com/kinecosystem/go/support/log"
 )
@@ -50,6 +51,12 @@ func (action *Action) HistoryQ() *history.Q {
 	return action.hq
 }
 
+// WebhooksQ provides access to queries that access the webhook tables of
+// horizon's database.
+func (action *Action) WebhooksQ() *webhooks.Q {
+	return &webhooks.Q{Session: action.App.HorizonSession(action.R.Context())}
+}
+
 // Prepare sets the action's App field based upon the context
 func (action *Action) Prepare(w http.ResponseWriter, r *http.Request) {
 	base := &action.Base
@@ -59,20 +59,23 @@ func (base *Base) Execute(action interface{}) {
 	case render.MimeEventStream:
 		var notification chan interface{}
 
+		var topic string
 		switch ac := action.(type) {
 		case EventStreamer:
-			// Subscribe this handler to the topic if the SSE request is related to a specific topic (tx_id, account_id, etc.).
-			// This causes action.SSE to only be triggered by this topic. Unsubscribe when done.
-			topic := ac.GetTopic()
-			if topic != "" {
-				notification = sse.Subscribe(topic)
-				defer sse.Unsubscribe(notification, topic)
-			}
+			topic = ac.GetTopic()
 		case SingleObjectStreamer:
+			topic = ac.GetTopic()
 		default:
 			goto NotAcceptable
 		}
 
+		// Subscribe this handler to the topic if the SSE request is related to a specific topic (tx_id, account_id, etc.).
+		// This causes the action to only be triggered by this topic. Unsubscribe when done.
+		if topic != "" {
+			notification = sse.Subscribe(topic)
+			defer sse.Unsubscribe(notification, topic)
+		}
+
 		stream := sse.NewStream(ctx, base.W)
 
 		var oldHash [32]byte
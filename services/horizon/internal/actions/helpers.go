@@ -1,6 +1,7 @@
 package actions
 
 import (
+	"encoding/base64"
 	"fmt"
 	"mime"
 	"net/url"
@@ -147,6 +148,28 @@ func (base *Base) GetInt32(name string) int32 {
 	return int32(asI64)
 }
 
+// GetBool retrieves a bool from the action parameter of the given name.
+// Populates err if the value is not a valid bool.  Defaults to false if the
+// parameter is a blank string.
+func (base *Base) GetBool(name string) bool {
+	if base.Err != nil {
+		return false
+	}
+
+	asStr := base.GetString(name)
+	if asStr == "" {
+		return false
+	}
+
+	b, err := strconv.ParseBool(asStr)
+	if err != nil {
+		base.SetInvalidField(name, errors.New("unparseable value"))
+		return false
+	}
+
+	return b
+}
+
 // GetLimit retrieves a uint64 limit from the action parameter of the given
 // name. Populates err if the value is not a valid limit.  Uses the provided
 // default value if the limit parameter is a blank string.
@@ -401,6 +424,78 @@ func (base *Base) GetTimeMillis(name string) (timeMillis time.Millis) {
 	return
 }
 
+// GetTimeRange retrieves the `start_time` and `end_time` parameters bounding
+// a range of ledger close times.  Populates err if the end time doesn't follow
+// the start time.
+func (base *Base) GetTimeRange() (start time.Millis, end time.Millis) {
+	start = base.GetTimeMillis("start_time")
+	end = base.GetTimeMillis("end_time")
+	if base.Err != nil {
+		return 0, 0
+	}
+
+	if !start.IsNil() && !end.IsNil() && end <= start {
+		base.SetInvalidField("end_time", errors.New("end time must be greater than the start time"))
+		return 0, 0
+	}
+
+	return start, end
+}
+
+// GetMemo retrieves the `memo_type` and `memo` parameters filtering records by
+// the memo of their transaction.  Populates err if the memo type is invalid, if
+// the memo is provided without a memo type that has a value, or if the memo is
+// empty or isn't a valid value of its type.
+func (base *Base) GetMemo() (memoType string, memo string) {
+	memoType = base.GetString("memo_type")
+	memo = base.GetString("memo")
+	if base.Err != nil {
+		return "", ""
+	}
+
+	_, hasMemo := base.R.URL.Query()["memo"]
+	if hasMemo && memo == "" {
+		base.SetInvalidField("memo", errors.New("memo must not be empty"))
+		return "", ""
+	}
+
+	switch memoType {
+	case "":
+		if memo != "" {
+			base.SetInvalidField("memo_type", errors.New("memo_type is required to filter by memo"))
+			return "", ""
+		}
+	case "none":
+		if memo != "" {
+			base.SetInvalidField("memo", errors.New("memos of type none have no value"))
+			return "", ""
+		}
+	case "text":
+	case "id":
+		if memo == "" {
+			break
+		}
+		if _, err := strconv.ParseUint(memo, 10, 64); err != nil {
+			base.SetInvalidField("memo", errors.New("memos of type id must be an unsigned 64-bit integer"))
+			return "", ""
+		}
+	case "hash", "return":
+		if memo == "" {
+			break
+		}
+		hash, err := base64.StdEncoding.DecodeString(memo)
+		if err != nil || len(hash) != 32 {
+			base.SetInvalidField("memo", errors.New("memos of type hash and return must be 32 bytes encoded in base64"))
+			return "", ""
+		}
+	default:
+		base.SetInvalidField("memo_type", errors.New("invalid memo type"))
+		return "", ""
+	}
+
+	return memoType, memo
+}
+
 // GetURLParam returns the corresponding URL parameter value from the request
 // routing context and an additional boolean reflecting whether or not the
 // param was found. This is ported from Chi since the Chi version returns ""
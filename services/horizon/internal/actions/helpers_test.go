@@ -179,6 +179,94 @@ func TestGetInt64(t *testing.T) {
 	tt.Assert.Equal(int64(math.MinInt64), result)
 }
 
+func TestGetBool(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+	action := makeTestAction()
+
+	tt.Assert.False(action.GetBool("blank"))
+	tt.Assert.NoError(action.Err)
+
+	tt.Assert.True(action.GetBool("true"))
+	tt.Assert.NoError(action.Err)
+
+	tt.Assert.False(action.GetBool("false"))
+	tt.Assert.NoError(action.Err)
+
+	_ = action.GetBool("two")
+	if tt.Assert.IsType(&problem.P{}, action.Err) {
+		p := action.Err.(*problem.P)
+		tt.Assert.Equal("bad_request", p.Type)
+		tt.Assert.Equal("two", p.Extras["invalid_field"])
+	}
+}
+
+func TestGetTimeRange(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	action := makeAction("/?start_time=1000&end_time=2000", map[string]string{})
+	start, end := action.GetTimeRange()
+	tt.Assert.NoError(action.Err)
+	tt.Assert.Equal(int64(1000), start.ToInt64())
+	tt.Assert.Equal(int64(2000), end.ToInt64())
+
+	action = makeAction("/?start_time=1000", map[string]string{})
+	start, end = action.GetTimeRange()
+	tt.Assert.NoError(action.Err)
+	tt.Assert.Equal(int64(1000), start.ToInt64())
+	tt.Assert.True(end.IsNil())
+
+	action = makeAction("/?start_time=2000&end_time=1000", map[string]string{})
+	_, _ = action.GetTimeRange()
+	if tt.Assert.IsType(&problem.P{}, action.Err) {
+		p := action.Err.(*problem.P)
+		tt.Assert.Equal("bad_request", p.Type)
+		tt.Assert.Equal("end_time", p.Extras["invalid_field"])
+	}
+}
+
+func TestGetMemo(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	action := makeAction("/?memo_type=id&memo=123", map[string]string{})
+	memoType, memo := action.GetMemo()
+	tt.Assert.NoError(action.Err)
+	tt.Assert.Equal("id", memoType)
+	tt.Assert.Equal("123", memo)
+
+	action = makeAction("/?memo_type=none", map[string]string{})
+	memoType, memo = action.GetMemo()
+	tt.Assert.NoError(action.Err)
+	tt.Assert.Equal("none", memoType)
+	tt.Assert.Equal("", memo)
+
+	hash := "8w4GmLJ4Ns5sTgKGKTp42L4WTMEnTF9E3/o5uUTu3yI="
+	action = makeAction("/?memo_type=hash&memo="+url.QueryEscape(hash), map[string]string{})
+	memoType, memo = action.GetMemo()
+	tt.Assert.NoError(action.Err)
+	tt.Assert.Equal("hash", memoType)
+	tt.Assert.Equal(hash, memo)
+
+	for _, query := range []string{
+		"memo=123",
+		"memo_type=none&memo=123",
+		"memo_type=foo",
+		"memo_type=text&memo=",
+		"memo_type=id&memo=abc",
+		"memo_type=hash&memo=abc",
+		"memo_type=return&memo=" + url.QueryEscape("AAAA"),
+	} {
+		action = makeAction("/?"+query, map[string]string{})
+		_, _ = action.GetMemo()
+		if tt.Assert.IsType(&problem.P{}, action.Err, query) {
+			p := action.Err.(*problem.P)
+			tt.Assert.Equal("bad_request", p.Type)
+		}
+	}
+}
+
 func TestAmount(t *testing.T) {
 	tt := test.Start(t)
 	defer tt.Finish()
@@ -358,6 +446,8 @@ func makeAction(path string, body map[string]string) *Base {
 func testURLParams() map[string]string {
 	return map[string]string{
 		"blank":                "",
+		"true":                 "true",
+		"false":                "false",
 		"minus_one":            "-1",
 		"zero":                 "0",
 		"two":                  "2",
@@ -23,7 +23,8 @@ type EventStreamer interface {
 
 // SingleObjectStreamer implementors can respond to a request whose response
 // type was negotiated to be MimeEventStream. A SingleObjectStreamer loads an
-// object whenever a ledger is closed.
+// object whenever its topic is published, and sends it only if it changed.
 type SingleObjectStreamer interface {
 	LoadEvent() (sse.Event, error)
+	GetTopic() string
 }
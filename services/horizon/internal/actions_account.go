@@ -1,10 +1,17 @@
 package horizon
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+
 	"github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/services/horizon/internal/accountstate"
 	"github.com/kinecosystem/go/services/horizon/internal/actions"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/ledger"
+	"github.com/kinecosystem/go/services/horizon/internal/render/problem"
 	"github.com/kinecosystem/go/services/horizon/internal/render/sse"
 	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
 	"github.com/kinecosystem/go/support/render/hal"
@@ -18,10 +25,13 @@ import (
 var _ actions.JSONer = (*AccountShowAction)(nil)
 var _ actions.SingleObjectStreamer = (*AccountShowAction)(nil)
 
-// AccountShowAction renders a account summary found by its address.
+// AccountShowAction renders a account summary found by its address.  When the
+// `ledger` parameter is provided, the account is rendered as it was at the
+// close of that ledger.
 type AccountShowAction struct {
 	Action
 	Address        string
+	Ledger         int32
 	HistoryRecord  history.Account
 	CoreData       []core.AccountData
 	CoreRecord     core.Account
@@ -61,18 +71,43 @@ func (action *AccountShowAction) LoadEvent() (sse.Event, error) {
 }
 
 // GetTopic is a method for actions.SSE
+//
+// The account's address is published whenever ingestion commits a ledger that
+// changed its account entry, trust lines or data entries.
 func (action *AccountShowAction) GetTopic() string {
-	return action.GetString("id")
+	return action.GetString("account_id")
 }
 
 func (action *AccountShowAction) loadParams() {
 	action.Address = action.GetAddress("account_id", actions.RequiredParam)
+	action.Ledger = action.GetInt32("ledger")
+	if action.Err == nil && action.Ledger < 0 {
+		action.SetInvalidField("ledger", errors.New("must be positive"))
+	}
 }
 
 func (action *AccountShowAction) loadRecord() {
 	app := AppFromContext(action.R.Context())
 	protocolVersion := app.coreSupportedProtocolVersion
 
+	if action.Ledger != 0 {
+		action.loadHistoricalState(protocolVersion)
+	} else {
+		action.loadCoreState(protocolVersion)
+	}
+	if action.Err != nil {
+		return
+	}
+
+	action.Err = action.HistoryQ().AccountByAddress(&action.HistoryRecord, action.Address)
+	// Do not fail when we cannot find the history record... it probably just
+	// means that the account was created outside of our known history range.
+	if action.HistoryQ().NoRows(action.Err) {
+		action.Err = nil
+	}
+}
+
+func (action *AccountShowAction) loadCoreState(protocolVersion int32) {
 	action.Err = action.CoreQ().AccountByAddress(&action.CoreRecord, action.Address, protocolVersion)
 	if action.Err != nil {
 		return
@@ -89,16 +124,104 @@ func (action *AccountShowAction) loadRecord() {
 	}
 
 	action.Err = action.CoreQ().TrustlinesByAddress(&action.CoreTrustlines, action.Address, protocolVersion)
+}
+
+// defaultAccountStateMaxLedgers is the number of ledgers in the past the state
+// of an account may be requested at when not configured, about a day.
+const defaultAccountStateMaxLedgers = 17280
+
+// loadHistoricalState reverts the current state of the account to its state
+// at the close of `action.Ledger`, which requires the changes of every
+// following ledger to be found in history.  The state of stellar-core and the
+// ledger it was reached at are read in a single repeatable read transaction,
+// so that history can be checked to hold exactly the changes to revert.
+func (action *AccountShowAction) loadHistoricalState(protocolVersion int32) {
+	ls := ledger.CurrentState()
+
+	if action.Ledger > ls.HistoryLatest {
+		action.SetInvalidField("ledger", errors.New("is not yet ingested"))
+		return
+	}
+
+	if action.Ledger+1 < ls.HistoryElder {
+		action.Err = &problem.BeforeHistory
+		return
+	}
+
+	ctx := action.R.Context()
+	opts := &sql.TxOptions{Isolation: sql.LevelRepeatableRead}
+
+	cq := &core.Q{Session: action.App.CoreSession(ctx)}
+	action.Err = cq.BeginTx(opts)
 	if action.Err != nil {
 		return
 	}
+	defer cq.Rollback()
 
-	action.Err = action.HistoryQ().AccountByAddress(&action.HistoryRecord, action.Address)
-	// Do not fail when we cannot find the history record... it probably just
-	// means that the account was created outside of our known history range.
-	if action.HistoryQ().NoRows(action.Err) {
-		action.Err = nil
+	hq := &history.Q{Session: action.App.HorizonSession(ctx)}
+	action.Err = hq.BeginTx(opts)
+	if action.Err != nil {
+		return
+	}
+	defer hq.Rollback()
+
+	var coreLatest, historyLatest int32
+	action.Err = cq.LatestLedger(&coreLatest)
+	if action.Err != nil {
+		return
+	}
+
+	maxLedgers := int32(action.App.config.AccountStateMaxLedgers)
+	if maxLedgers == 0 {
+		maxLedgers = defaultAccountStateMaxLedgers
+	}
+	if coreLatest-action.Ledger > maxLedgers {
+		action.SetInvalidField("ledger", fmt.Errorf("must be at most %d ledgers in the past", maxLedgers))
+		return
+	}
+
+	action.Err = hq.LatestLedger(&historyLatest)
+	if action.Err != nil {
+		return
+	}
+
+	if coreLatest > historyLatest {
+		err := problem.StaleHistory
+		err.Extras = map[string]interface{}{
+			"history_latest_ledger": historyLatest,
+			"core_latest_ledger":    coreLatest,
+		}
+		action.Err = &err
+		return
+	}
+
+	var fromArchive bool
+	action.Err = hq.LedgersFromArchive(&fromArchive, action.Ledger+1, coreLatest)
+	if action.Err != nil {
+		return
 	}
+	if fromArchive {
+		action.SetInvalidField("ledger", errors.New("is followed by ledgers loaded from a history archive, whose changes are unknown"))
+		return
+	}
+
+	state, err := accountstate.Load(
+		cq,
+		hq,
+		action.Address,
+		action.Ledger,
+		coreLatest,
+		protocolVersion,
+	)
+	if err != nil {
+		action.Err = err
+		return
+	}
+
+	action.CoreRecord = state.Account
+	action.CoreData = state.Data
+	action.CoreSigners = state.Signers
+	action.CoreTrustlines = state.Trustlines
 }
 
 func (action *AccountShowAction) loadResource() {
@@ -1,10 +1,12 @@
 package horizon
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
 	"github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/services/horizon/internal/test"
 )
 
 func TestAccountActions_Show(t *testing.T) {
@@ -28,6 +30,67 @@ func TestAccountActions_Show(t *testing.T) {
 	ht.Assert.Equal(404, w.Code)
 }
 
+func TestAccountActions_ShowAtLedger(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	cases := []struct {
+		path     string
+		sequence string
+		balance  string
+	}{
+		{"/accounts/GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H?ledger=1", "0", "10000000000000.00000"},
+		{"/accounts/GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H?ledger=3", "3", "9999999969999.99700"},
+		{"/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=2", "8589934592", "10000.00000"},
+		{"/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=3", "8589934593", "9499.99900"},
+	}
+
+	for _, kase := range cases {
+		w := ht.Get(kase.path)
+		if ht.Assert.Equal(200, w.Code, kase.path) {
+			var result horizon.Account
+			ht.Require.NoError(json.Unmarshal(w.Body.Bytes(), &result))
+			ht.Assert.Equal(kase.sequence, result.Sequence, kase.path)
+			if ht.Assert.Len(result.Balances, 1, kase.path) {
+				ht.Assert.Equal(kase.balance, result.Balances[0].Balance, kase.path)
+			}
+		}
+	}
+
+	// account created after the ledger
+	w := ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=1")
+	ht.Assert.Equal(404, w.Code)
+
+	// ledgers not yet ingested
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=4")
+	ht.Assert.Equal(400, w.Code)
+
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=-1")
+	ht.Assert.Equal(400, w.Code)
+
+	// ledgers too far in the past
+	ht.App.config.AccountStateMaxLedgers = 1
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=1")
+	ht.Assert.Equal(400, w.Code)
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=2")
+	ht.Assert.Equal(200, w.Code)
+	ht.App.config.AccountStateMaxLedgers = 0
+
+	// ledgers followed by ledgers loaded from a history archive
+	_, err := ht.HorizonDB.Exec("UPDATE history_ledgers SET from_archive = true WHERE sequence = 3")
+	ht.Require.NoError(err)
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=2")
+	ht.Assert.Equal(400, w.Code)
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=3")
+	ht.Assert.Equal(200, w.Code)
+
+	// history behind the state of stellar-core
+	_, err = ht.HorizonDB.Exec("DELETE FROM history_ledgers WHERE sequence = 3")
+	ht.Require.NoError(err)
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU?ledger=2")
+	ht.Assert.Equal(503, w.Code)
+}
+
 func TestAccountActions_ShowRegressions(t *testing.T) {
 	ht := StartHTTPTest(t, "base")
 	defer ht.Finish()
@@ -67,3 +130,11 @@ func TestAccountActions_InvalidID(t *testing.T) {
 	)
 	ht.Assert.Equal(400, w.Code)
 }
+
+func TestAccountActions_Topic(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	action := AccountShowAction{Action: *NewTestAction(context.Background(), "/foo?account_id=GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H")}
+	tt.Assert.Equal("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", action.GetTopic())
+}
@@ -0,0 +1,210 @@
+package horizon
+
+import (
+	"strconv"
+	gTime "time"
+
+	"github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/services/horizon/internal/actions"
+	"github.com/kinecosystem/go/services/horizon/internal/db2"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/support/render/hal"
+	"github.com/kinecosystem/go/support/time"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// This file contains the actions:
+//
+// BalanceHistoryIndexAction: the balance of an account in an asset over time
+
+// Interface verification
+var _ actions.JSONer = (*BalanceHistoryIndexAction)(nil)
+
+// BalanceHistoryIndexAction renders the balance of an account in an asset at
+// the end of each time bucket its balance changed in.
+type BalanceHistoryIndexAction struct {
+	Action
+	Address          string
+	AssetFilter      xdr.Asset
+	StartTimeFilter  time.Millis
+	EndTimeFilter    time.Millis
+	OffsetFilter     int64
+	ResolutionFilter int64
+	PagingParams     db2.PageQuery
+	Balance          int64
+	Records          []history.BalanceHistory
+	Page             hal.Page
+}
+
+// JSON is a method for actions.JSON
+func (action *BalanceHistoryIndexAction) JSON() error {
+	action.Do(
+		action.EnsureHistoryFreshness,
+		action.loadParams,
+		action.loadBalance,
+		action.loadRecords,
+		action.loadPage,
+		func() { hal.Render(action.W, action.Page) },
+	)
+	return action.Err
+}
+
+func (action *BalanceHistoryIndexAction) loadParams() {
+	action.Address = action.GetAddress("account_id", actions.RequiredParam)
+	action.PagingParams = action.GetPageQuery()
+	action.AssetFilter = action.GetAsset("")
+	action.OffsetFilter = action.GetInt64("offset")
+	action.StartTimeFilter = action.GetTimeMillis("start_time")
+	action.EndTimeFilter = action.GetTimeMillis("end_time")
+	action.ResolutionFilter = action.GetInt64("resolution")
+	if action.Err != nil {
+		return
+	}
+
+	//check if resolution is legal
+	resolutionDuration := gTime.Duration(action.ResolutionFilter) * gTime.Millisecond
+	if history.StrictResolutionFiltering {
+		if _, ok := history.AllowedResolutions[resolutionDuration]; !ok {
+			action.SetInvalidField("resolution", errors.New("illegal or missing resolution. "+
+				"allowed resolutions are: 1 minute (60000), 5 minutes (300000), 15 minutes (900000), 1 hour (3600000), "+
+				"1 day (86400000) and 1 week (604800000)"))
+			return
+		}
+	}
+	// check if offset is legal
+	offsetDuration := gTime.Duration(action.OffsetFilter) * gTime.Millisecond
+	if offsetDuration%gTime.Hour != 0 || offsetDuration >= gTime.Hour*24 || offsetDuration > resolutionDuration {
+		action.SetInvalidField("offset", errors.New("illegal or missing offset. offset must be a multiple of an"+
+			" hour, less than or equal to the resolution, and less than 24 hours"))
+	}
+}
+
+// loadBalance populates action.Balance with the current balance of the
+// account, which is zero when the account or its trust line doesn't exist.
+func (action *BalanceHistoryIndexAction) loadBalance() {
+	app := AppFromContext(action.R.Context())
+	protocolVersion := app.coreSupportedProtocolVersion
+
+	if action.AssetFilter.Type == xdr.AssetTypeAssetTypeNative {
+		var account core.Account
+		err := action.CoreQ().AccountByAddress(&account, action.Address, protocolVersion)
+		if err != nil && !action.CoreQ().NoRows(err) {
+			action.Err = err
+			return
+		}
+		action.Balance = int64(account.Balance)
+		return
+	}
+
+	var trustlines []core.Trustline
+	action.Err = action.CoreQ().TrustlinesByAddress(&trustlines, action.Address, protocolVersion)
+	if action.Err != nil {
+		return
+	}
+
+	var typ xdr.AssetType
+	var code, issuer string
+	action.Err = action.AssetFilter.Extract(&typ, &code, &issuer)
+	if action.Err != nil {
+		return
+	}
+
+	for _, tl := range trustlines {
+		if tl.Assettype == typ && tl.Assetcode == code && tl.Issuer == issuer {
+			action.Balance = int64(tl.Balance)
+		}
+	}
+}
+
+// loadRecords populates action.Records
+func (action *BalanceHistoryIndexAction) loadRecords() {
+	historyQ := action.HistoryQ()
+
+	var account history.Account
+	action.Err = historyQ.AccountByAddress(&account, action.Address)
+	if action.Err != nil {
+		return
+	}
+
+	//initialize the query builder with required params
+	balanceHistoryQ, err := historyQ.GetBalanceHistoryQ(
+		account, action.AssetFilter, action.Balance, action.ResolutionFilter, action.OffsetFilter, action.PagingParams)
+	if err != nil {
+		action.Err = err
+		return
+	}
+
+	//set time range if supplied
+	if !action.StartTimeFilter.IsNil() {
+		balanceHistoryQ, err = balanceHistoryQ.WithStartTime(action.StartTimeFilter)
+		if err != nil {
+			action.SetInvalidField("start_time", errors.New("illegal start time. adjusted start time must "+
+				"be less than the provided end time if the end time is greater than 0"))
+			return
+		}
+	}
+	if !action.EndTimeFilter.IsNil() {
+		balanceHistoryQ, err = balanceHistoryQ.WithEndTime(action.EndTimeFilter)
+		if err != nil {
+			action.SetInvalidField("end_time", errors.New("illegal end time. adjusted end time "+
+				"must be greater than the offset and greater than the provided start time"))
+			return
+		}
+	}
+
+	sql, err := balanceHistoryQ.GetSql()
+	if err != nil {
+		action.Err = err
+		return
+	}
+
+	action.Err = historyQ.Select(&action.Records, sql)
+}
+
+func (action *BalanceHistoryIndexAction) loadPage() {
+	action.Page.Init()
+	for _, record := range action.Records {
+		var res horizon.BalanceHistory
+
+		action.Err = resourceadapter.PopulateBalanceHistory(action.R.Context(), &res, record)
+		if action.Err != nil {
+			return
+		}
+
+		action.Page.Add(res)
+	}
+
+	action.Page.Limit = action.PagingParams.Limit
+	action.Page.Order = action.PagingParams.Order
+
+	newUrl := action.FullURL() // preserve scheme and host for the new url links
+	q := newUrl.Query()
+
+	action.Page.Links.Self = hal.NewLink(newUrl.String())
+
+	//adjust time range for next page
+	if len(action.Records) == 0 {
+		action.Page.Links.Next = action.Page.Links.Self
+		return
+	}
+
+	last := action.Records[len(action.Records)-1]
+	if action.PagingParams.Order == "asc" {
+		newStartTime := last.Timestamp + action.ResolutionFilter
+		if !action.EndTimeFilter.IsNil() && newStartTime >= action.EndTimeFilter.ToInt64() {
+			newStartTime = action.EndTimeFilter.ToInt64()
+		}
+		q.Set("start_time", strconv.FormatInt(newStartTime, 10))
+	} else {
+		newEndTime := last.Timestamp
+		if newEndTime <= action.StartTimeFilter.ToInt64() {
+			newEndTime = action.StartTimeFilter.ToInt64()
+		}
+		q.Set("end_time", strconv.FormatInt(newEndTime, 10))
+	}
+	newUrl.RawQuery = q.Encode()
+	action.Page.Links.Next = hal.NewLink(newUrl.String())
+}
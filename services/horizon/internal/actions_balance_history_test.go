@@ -0,0 +1,118 @@
+package horizon
+
+import (
+	"testing"
+
+	"github.com/kinecosystem/go/protocols/horizon"
+)
+
+func TestBalanceHistoryActions_Index(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	var records []horizon.BalanceHistory
+
+	// fees, debits and the starting balance are summed in a single bucket
+	w := ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/balance_history?asset_type=native&resolution=60000")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal(int64(1548955620000), records[0].Timestamp)
+		ht.Assert.Equal(int64(3), records[0].ChangeCount)
+		ht.Assert.Equal("100.00000", records[0].Credited)
+		ht.Assert.Equal("5.00100", records[0].Debited)
+		ht.Assert.Equal("9499.99900", records[0].Balance)
+	}
+
+	w = ht.Get("/accounts/GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H/balance_history?asset_type=native&resolution=86400000&order=desc")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal(int64(6), records[0].ChangeCount)
+		ht.Assert.Equal("0.00000", records[0].Credited)
+		ht.Assert.Equal("300.00300", records[0].Debited)
+		ht.Assert.Equal("9999999969999.99700", records[0].Balance)
+	}
+
+	// no changes before the start time
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/balance_history?asset_type=native&resolution=60000&start_time=1548955680000")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(0, w.Body)
+	}
+
+	// no changes in assets without trust lines
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/balance_history?asset_type=credit_alphanum4&asset_code=USD&asset_issuer=GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H&resolution=60000")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(0, w.Body)
+	}
+
+	// missing resolution
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/balance_history?asset_type=native")
+	ht.Assert.Equal(400, w.Code)
+
+	// unknown account
+	w = ht.Get("/accounts/GDBAPLDCAEJV6LSEDFEAUDAVFYSNFRUYZ4X75YYJJMMX5KFVUOHX46SQ/balance_history?asset_type=native&resolution=60000")
+	ht.Assert.Equal(404, w.Code)
+}
+
+func TestBalanceHistoryActions_Trades(t *testing.T) {
+	const usd = "asset_type=credit_alphanum4&asset_code=USD&asset_issuer=GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4"
+
+	t.Run("path payments", func(t *testing.T) {
+		ht := StartHTTPTest(t, "pathed_payment")
+		defer ht.Finish()
+
+		var records []horizon.BalanceHistory
+
+		// the sender's trades are not counted on top of its debit
+		w := ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/balance_history?" + usd + "&resolution=86400000")
+		if ht.Assert.Equal(200, w.Code) {
+			ht.Assert.PageOf(1, w.Body)
+			ht.UnmarshalPage(w.Body, &records)
+			ht.Assert.Equal(int64(2), records[0].ChangeCount)
+			ht.Assert.Equal("100.00000", records[0].Credited)
+			ht.Assert.Equal("10.00000", records[0].Debited)
+			ht.Assert.Equal("9000.00000", records[0].Balance)
+		}
+
+		// the owner of the offer crossed by the payment traded
+		w = ht.Get("/accounts/GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON/balance_history?" + usd + "&resolution=86400000")
+		if ht.Assert.Equal(200, w.Code) {
+			ht.Assert.PageOf(1, w.Body)
+			ht.UnmarshalPage(w.Body, &records)
+			ht.Assert.Equal(int64(2), records[0].ChangeCount)
+			ht.Assert.Equal("30.00000", records[0].Credited)
+			ht.Assert.Equal("0.00000", records[0].Debited)
+			ht.Assert.Equal("3000.00000", records[0].Balance)
+		}
+	})
+
+	t.Run("offers", func(t *testing.T) {
+		ht := StartHTTPTest(t, "trades")
+		defer ht.Finish()
+
+		var records []horizon.BalanceHistory
+
+		// the trades of the offers crossing existing ones
+		w := ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/balance_history?" + usd + "&resolution=86400000")
+		if ht.Assert.Equal(200, w.Code) {
+			ht.Assert.PageOf(1, w.Body)
+			ht.UnmarshalPage(w.Body, &records)
+			ht.Assert.Equal(int64(3), records[0].ChangeCount)
+			ht.Assert.Equal("500.00000", records[0].Credited)
+			ht.Assert.Equal("70.00000", records[0].Debited)
+			ht.Assert.Equal("43000.00000", records[0].Balance)
+		}
+
+		// the trades of the offers crossed
+		w = ht.Get("/accounts/GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2/balance_history?" + usd + "&resolution=86400000")
+		if ht.Assert.Equal(200, w.Code) {
+			ht.Assert.PageOf(1, w.Body)
+			ht.UnmarshalPage(w.Body, &records)
+			ht.Assert.Equal(int64(2), records[0].ChangeCount)
+			ht.Assert.Equal("70.00000", records[0].Credited)
+			ht.Assert.Equal("0.00000", records[0].Debited)
+			ht.Assert.Equal("7000.00000", records[0].Balance)
+		}
+	})
+}
@@ -7,10 +7,13 @@ import (
 	"github.com/kinecosystem/go/services/horizon/internal/actions"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/ingest"
 	"github.com/kinecosystem/go/services/horizon/internal/render/sse"
 	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
 	"github.com/kinecosystem/go/support/errors"
 	"github.com/kinecosystem/go/support/render/hal"
+	"github.com/kinecosystem/go/support/time"
+	"github.com/kinecosystem/go/xdr"
 )
 
 // This file contains the actions:
@@ -30,6 +33,10 @@ type EffectIndexAction struct {
 	LedgerFilter      int32
 	TransactionFilter string
 	OperationFilter   int64
+	AssetFilter       xdr.Asset
+	HasAssetFilter    bool
+	StartTimeFilter   time.Millis
+	EndTimeFilter     time.Millis
 
 	PagingParams db2.PageQuery
 	Records      []history.Effect
@@ -104,6 +111,9 @@ func (action *EffectIndexAction) GetTopic() string {
 	if res := action.GetString("op_id"); res != "" {
 		return res
 	}
+	if asset, ok := action.MaybeGetAsset(""); ok {
+		return ingest.AssetTopic(asset)
+	}
 	return ""
 }
 
@@ -125,6 +135,8 @@ func (action *EffectIndexAction) loadParams() {
 	action.LedgerFilter = action.GetInt32("ledger_id")
 	action.TransactionFilter = action.GetString("tx_id")
 	action.OperationFilter = action.GetInt64("op_id")
+	action.AssetFilter, action.HasAssetFilter = action.MaybeGetAsset("")
+	action.StartTimeFilter, action.EndTimeFilter = action.GetTimeRange()
 }
 
 // loadRecords populates action.Records
@@ -142,6 +154,14 @@ func (action *EffectIndexAction) loadRecords() {
 		effects.ForTransaction(action.TransactionFilter)
 	}
 
+	if action.HasAssetFilter {
+		effects.ForAsset(action.AssetFilter)
+	}
+
+	if !action.StartTimeFilter.IsNil() || !action.EndTimeFilter.IsNil() {
+		effects.ForTimeRange(action.StartTimeFilter, action.EndTimeFilter)
+	}
+
 	action.Err = effects.Page(action.PagingParams).Select(&action.Records)
 }
 
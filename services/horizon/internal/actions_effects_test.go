@@ -6,6 +6,7 @@ import (
 	"github.com/kinecosystem/go/protocols/horizon/effects"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
 	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/kinecosystem/go/xdr"
 )
 
 func TestEffectActions_Index(t *testing.T) {
@@ -40,6 +41,17 @@ func TestEffectActions_Index(t *testing.T) {
 			ht.Assert.PageOf(2, w.Body)
 		}
 
+		// filtered by ledger close time
+		w = ht.Get("/effects?start_time=1548955673500")
+		if ht.Assert.Equal(200, w.Code) {
+			ht.Assert.PageOf(2, w.Body)
+		}
+
+		w = ht.Get("/effects?end_time=1548955674000&limit=20")
+		if ht.Assert.Equal(200, w.Code) {
+			ht.Assert.PageOf(9, w.Body)
+		}
+
 		// filtered by account
 		w = ht.Get("/accounts/GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H/effects")
 		if ht.Assert.Equal(200, w.Code) {
@@ -75,6 +87,25 @@ func TestEffectActions_Index(t *testing.T) {
 		ht.Logger.Error(w.Body.String())
 	})
 
+	t.Run("asset filter", func(t *testing.T) {
+		ht := StartHTTPTest(t, "base")
+		defer ht.Finish()
+		insertOperationAssets(ht, xdr.MustNewNativeAsset(), 8589938689, 12884905985)
+
+		w := ht.Get("/effects?asset_type=native")
+		if ht.Assert.Equal(200, w.Code) {
+			ht.Assert.PageOf(5, w.Body)
+		}
+
+		w = ht.Get("/operations/8589938689/effects?asset_type=native")
+		if ht.Assert.Equal(200, w.Code) {
+			ht.Assert.PageOf(3, w.Body)
+		}
+
+		w = ht.Get("/effects?asset_type=credit_alphanum4&asset_code=USD&asset_issuer=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON")
+		ht.Assert.Equal(404, w.Code)
+	})
+
 	t.Run("Effect resource props", func(t *testing.T) {
 		ht := StartHTTPTest(t, "base")
 		defer ht.Finish()
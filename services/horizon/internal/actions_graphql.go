@@ -0,0 +1,116 @@
+package horizon
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/kinecosystem/go/services/horizon/internal/actions"
+	"github.com/kinecosystem/go/services/horizon/internal/graphql"
+	hProblem "github.com/kinecosystem/go/services/horizon/internal/render/problem"
+	"github.com/kinecosystem/go/support/errors"
+)
+
+// This file contains the actions:
+//
+// GraphQLAction: executes GraphQL queries over the history database
+
+// Interface verifications
+var _ actions.JSONer = (*GraphQLAction)(nil)
+
+// GraphQLAction executes a GraphQL query, sent either in the query string of
+// a GET request or in the JSON body of a POST request.  Queries loading more
+// pages of records than allowed are rejected, and each page is counted as a
+// request by the rate limiter.
+type GraphQLAction struct {
+	Action
+	Request graphql.Request
+	Cost    int
+	Result  *gql.Result
+}
+
+// JSON is a method for actions.JSON
+func (action *GraphQLAction) JSON() error {
+	action.Do(
+		action.loadParams,
+		action.loadCost,
+		action.rateLimit,
+		action.loadResult,
+		func() {
+			action.W.Header().Set("Content-Type", "application/json; charset=utf-8")
+			action.Err = json.NewEncoder(action.W).Encode(action.Result)
+		},
+	)
+	return action.Err
+}
+
+func (action *GraphQLAction) loadParams() {
+	mt, _, _ := mime.ParseMediaType(action.R.Header.Get("Content-Type"))
+	if action.R.Method == http.MethodPost && mt == "application/json" {
+		err := json.NewDecoder(action.R.Body).Decode(&action.Request)
+		if err != nil {
+			action.SetInvalidField("body", err)
+			return
+		}
+	} else {
+		action.Request.Query = action.GetString("query")
+		action.Request.OperationName = action.GetString("operationName")
+		if variables := action.GetString("variables"); variables != "" {
+			err := json.Unmarshal([]byte(variables), &action.Request.Variables)
+			if err != nil {
+				action.SetInvalidField("variables", err)
+				return
+			}
+		}
+	}
+
+	if action.Request.Query == "" {
+		action.SetInvalidField("query", errors.New("query is required"))
+	}
+}
+
+func (action *GraphQLAction) loadCost() {
+	max := int(action.App.config.GraphQLMaxCost)
+	if max == 0 {
+		max = graphql.DefaultMaxCost
+	}
+
+	cost, err := graphql.Cost(action.Request, max)
+	switch {
+	case err == graphql.ErrCostExceeded:
+		action.SetInvalidField("query", fmt.Errorf("the query loads more than %d pages of records", max))
+	case err != nil:
+		action.SetInvalidField("query", err)
+	default:
+		action.Cost = cost
+	}
+}
+
+// rateLimit counts the pages of records loaded by the query as requests.  The
+// request itself was already counted by the rate limiting middleware.
+func (action *GraphQLAction) rateLimit() {
+	rateLimiter := action.App.GetRateLimiter()
+	if rateLimiter == nil || action.Cost <= 1 {
+		return
+	}
+
+	limited, _, err := rateLimiter.RateLimiter.RateLimit(rateLimiter.VaryBy.Key(action.R), action.Cost-1)
+	if err != nil {
+		action.Err = errors.Wrap(err, "RateLimiter error")
+		return
+	}
+
+	if limited {
+		action.Err = &hProblem.RateLimitExceeded
+	}
+}
+
+func (action *GraphQLAction) loadResult() {
+	action.Result = graphql.Execute(action.R.Context(), graphql.Queries{
+		History:             action.HistoryQ(),
+		Core:                action.CoreQ(),
+		CoreProtocolVersion: action.App.coreSupportedProtocolVersion,
+	}, action.Request)
+}
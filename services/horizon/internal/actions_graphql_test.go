@@ -0,0 +1,141 @@
+package horizon
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type graphQLOperations struct {
+	Edges []struct {
+		Cursor string
+		Node   struct {
+			ID      string
+			Type    string
+			Effects struct {
+				Edges []struct {
+					Node struct {
+						Type string
+					}
+				}
+			}
+		}
+	}
+	PageInfo struct {
+		EndCursor   string
+		HasNextPage bool
+	}
+}
+
+type graphQLResult struct {
+	Data struct {
+		Account *struct {
+			ID         string
+			Sequence   string
+			Operations graphQLOperations
+		}
+	}
+	Errors []interface{}
+}
+
+func TestGraphQLAction(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	c := NewTestConfig()
+	c.EnableGraphQL = true
+	app := NewApp(c)
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	accountQuery := `query Account($id: String!, $after: String) {
+		account(id: $id) {
+			id
+			sequence
+			operations(first: 2, after: $after) {
+				edges { cursor node { id type effects { edges { node { type } } } } }
+				pageInfo { endCursor hasNextPage }
+			}
+		}
+	}`
+
+	// queries sent in a JSON body
+	body := `{"query": ` + mustMarshal(t, accountQuery) + `, "variables": {"id": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"}}`
+	w := rh.Post("/graphql", nil, func(r *http.Request) {
+		r.Header.Set("Content-Type", "application/json")
+		r.Body = ioutil.NopCloser(strings.NewReader(body))
+	})
+	ht.Require.Equal(200, w.Code)
+
+	var result graphQLResult
+	ht.Require.NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	ht.Require.Empty(result.Errors)
+	ht.Require.NotNil(result.Data.Account)
+	ht.Assert.Equal("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", result.Data.Account.ID)
+	ht.Assert.Equal("3", result.Data.Account.Sequence)
+
+	ops := result.Data.Account.Operations
+	if ht.Assert.Len(ops.Edges, 2) {
+		ht.Assert.True(ops.PageInfo.HasNextPage)
+		ht.Assert.Equal(ops.Edges[1].Cursor, ops.PageInfo.EndCursor)
+		ht.Assert.Equal(ops.Edges[0].Cursor, ops.Edges[0].Node.ID)
+		ht.Assert.NotEmpty(ops.Edges[0].Node.Effects.Edges)
+	}
+
+	// queries sent in the query string, paged with the end cursor
+	variables := `{"id": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", "after": "` + ops.PageInfo.EndCursor + `"}`
+	w = rh.Get("/graphql?" + url.Values{
+		"query":     {accountQuery},
+		"variables": {variables},
+	}.Encode())
+	ht.Require.Equal(200, w.Code)
+
+	result = graphQLResult{}
+	ht.Require.NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	ht.Require.Empty(result.Errors)
+	ht.Require.NotNil(result.Data.Account)
+	ht.Assert.Len(result.Data.Account.Operations.Edges, 1)
+	ht.Assert.False(result.Data.Account.Operations.PageInfo.HasNextPage)
+
+	// missing accounts are null
+	w = rh.Post("/graphql", url.Values{
+		"query": {`{ account(id: "GDBAPLDCAEJV6LSEDFEAUDAVFYSNFRUYZ4X75YYJJMMX5KFVUOHX46SQ") { id } }`},
+	})
+	ht.Require.Equal(200, w.Code)
+	result = graphQLResult{}
+	ht.Require.NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	ht.Assert.Empty(result.Errors)
+	ht.Assert.Nil(result.Data.Account)
+
+	// invalid queries
+	w = rh.Get("/graphql")
+	ht.Assert.Equal(400, w.Code)
+	w = rh.Get("/graphql?" + url.Values{"query": {"{ account("}}.Encode())
+	ht.Assert.Equal(400, w.Code)
+
+	// queries loading too many pages of records
+	w = rh.Get("/graphql?" + url.Values{
+		"query": {`{ ledgers(first: 200) { edges { node { transactions { edges { cursor } } } } } }`},
+	}.Encode())
+	ht.Assert.Equal(400, w.Code)
+
+	// each page of records is counted by the rate limiter
+	costly := "/graphql?" + url.Values{
+		"query": {`{ ledgers(first: 50) { edges { node { transactions(first: 1) { edges { cursor } } } } } }`},
+	}.Encode()
+	w = rh.Get(costly)
+	ht.Assert.Equal(200, w.Code)
+	w = rh.Get(costly)
+	ht.Assert.Equal(429, w.Code)
+}
+
+func mustMarshal(t *testing.T, v interface{}) string {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(raw)
+}
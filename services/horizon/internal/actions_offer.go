@@ -124,3 +124,183 @@ func (action *OffersByAccountAction) loadPage() {
 	action.Page.Order = action.PageQuery.Order
 	action.Page.PopulateLinks()
 }
+
+// Interface verifications
+var _ actions.JSONer = (*OfferIndexAction)(nil)
+var _ actions.EventStreamer = (*OfferIndexAction)(nil)
+
+// OfferIndexAction renders a page of offer resources, optionally filtered by
+// seller and by the assets being sold and bought.  These offers are present in
+// the ledger as of the latest validated ledger.
+type OfferIndexAction struct {
+	Action
+	Filter    core.OffersFilter
+	PageQuery db2.PageQuery
+	Records   []core.Offer
+	Ledgers   *history.LedgerCache
+	Page      hal.Page
+}
+
+// JSON is a method for actions.JSON
+func (action *OfferIndexAction) JSON() error {
+	action.Do(
+		action.loadParams,
+		action.loadRecords,
+		action.loadLedgers,
+		action.loadPage,
+		func() { hal.Render(action.W, action.Page) },
+	)
+	return action.Err
+}
+
+// SSE is a method for actions.SSE
+func (action *OfferIndexAction) SSE(stream *sse.Stream) error {
+	// Load the page query params the first time SSE() is called. We update
+	// the pagination cursor below before sending each event to the stream.
+	if action.PageQuery.Cursor == "" {
+		action.loadParams()
+		if action.Err != nil {
+			return action.Err
+		}
+	}
+
+	action.Do(
+		action.loadRecords,
+		action.loadLedgers,
+		func() {
+			stream.SetLimit(int(action.PageQuery.Limit))
+			for _, record := range action.Records {
+				ledger, found := action.Ledgers.Records[record.Lastmodified]
+				ledgerPtr := &ledger
+				if !found {
+					ledgerPtr = nil
+				}
+				var res horizon.Offer
+				resourceadapter.PopulateOffer(action.R.Context(), &res, record, ledgerPtr)
+				action.PageQuery.Cursor = res.PagingToken()
+				stream.Send(sse.Event{ID: res.PagingToken(), Data: res})
+			}
+		},
+	)
+
+	return action.Err
+}
+
+// GetTopic is a method for actions.SSE
+func (action *OfferIndexAction) GetTopic() string {
+	return "offers"
+}
+
+func (action *OfferIndexAction) loadParams() {
+	action.PageQuery = action.GetPageQuery()
+	action.Filter.Seller = action.GetAddress("seller")
+
+	if selling, ok := action.MaybeGetAsset("selling_"); ok {
+		action.Filter.Selling = &selling
+	}
+
+	if buying, ok := action.MaybeGetAsset("buying_"); ok {
+		action.Filter.Buying = &buying
+	}
+}
+
+// loadLedgers populates the ledger cache for this action
+func (action *OfferIndexAction) loadLedgers() {
+	action.Ledgers = &history.LedgerCache{}
+
+	for _, offer := range action.Records {
+		action.Ledgers.Queue(offer.Lastmodified)
+	}
+	action.Err = action.Ledgers.Load(action.HistoryQ())
+}
+
+func (action *OfferIndexAction) loadRecords() {
+	action.Err = action.CoreQ().Offers(
+		&action.Records,
+		action.Filter,
+		action.PageQuery,
+	)
+}
+
+func (action *OfferIndexAction) loadPage() {
+	for _, record := range action.Records {
+		ledger, found := action.Ledgers.Records[record.Lastmodified]
+		ledgerPtr := &ledger
+		if !found {
+			ledgerPtr = nil
+		}
+
+		var res horizon.Offer
+		resourceadapter.PopulateOffer(action.R.Context(), &res, record, ledgerPtr)
+		action.Page.Add(res)
+	}
+
+	action.Page.FullURL = action.FullURL()
+	action.Page.Limit = action.PageQuery.Limit
+	action.Page.Cursor = action.PageQuery.Cursor
+	action.Page.Order = action.PageQuery.Order
+	action.Page.PopulateLinks()
+}
+
+// Interface verifications
+var _ actions.JSONer = (*OfferShowAction)(nil)
+var _ actions.SingleObjectStreamer = (*OfferShowAction)(nil)
+
+// OfferShowAction renders a single offer, found by its id.
+type OfferShowAction struct {
+	Action
+	ID       int64
+	Record   core.Offer
+	Ledgers  *history.LedgerCache
+	Resource horizon.Offer
+}
+
+// JSON is a method for actions.JSON
+func (action *OfferShowAction) JSON() error {
+	action.Do(
+		action.loadParams,
+		action.loadRecord,
+		action.loadLedgers,
+		action.loadResource,
+		func() { hal.Render(action.W, action.Resource) },
+	)
+	return action.Err
+}
+
+func (action *OfferShowAction) LoadEvent() (sse.Event, error) {
+	action.Do(action.loadParams, action.loadRecord, action.loadLedgers, action.loadResource)
+	return sse.Event{Data: action.Resource}, action.Err
+}
+
+// GetTopic is a method for actions.SSE
+//
+// Offers are not published individually, so registration topic is any change
+// to the offers.
+func (action *OfferShowAction) GetTopic() string {
+	return "offers"
+}
+
+func (action *OfferShowAction) loadParams() {
+	action.ID = action.GetInt64("id")
+}
+
+func (action *OfferShowAction) loadRecord() {
+	action.Err = action.CoreQ().OfferByID(&action.Record, action.ID)
+}
+
+// loadLedgers populates the ledger cache for this action
+func (action *OfferShowAction) loadLedgers() {
+	action.Ledgers = &history.LedgerCache{}
+	action.Ledgers.Queue(action.Record.Lastmodified)
+	action.Err = action.Ledgers.Load(action.HistoryQ())
+}
+
+func (action *OfferShowAction) loadResource() {
+	ledger, found := action.Ledgers.Records[action.Record.Lastmodified]
+	ledgerPtr := &ledger
+	if !found {
+		ledgerPtr = nil
+	}
+
+	resourceadapter.PopulateOffer(action.R.Context(), &action.Resource, action.Record, ledgerPtr)
+}
@@ -2,6 +2,7 @@ package horizon
 
 import (
 	"context"
+	"encoding/json"
 	"net/http/httptest"
 	"testing"
 	"time"
@@ -56,6 +57,69 @@ func TestOfferActions_IndexNoLedgerData(t *testing.T) {
 	}
 }
 
+func TestOfferActions_AllIndex(t *testing.T) {
+	ht := StartHTTPTest(t, "trades")
+	defer ht.Finish()
+
+	w := ht.Get("/offers")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(4, w.Body)
+	}
+
+	// filters by seller
+	w = ht.Get("/offers?seller=GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	// filters by assets
+	w = ht.Get("/offers?buying_asset_type=native")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/offers?selling_asset_type=credit_alphanum4&selling_asset_code=EUR&selling_asset_issuer=GCQPYGH4K57XBDENKKX55KDTWOTK5WDWRQOH2LHEDX3EKVIQRLMESGBG")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	// pages by offer id
+	w = ht.Get("/offers?cursor=2&limit=1")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+
+		var records []map[string]interface{}
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.EqualValues(3, records[0]["id"])
+	}
+
+	// invalid seller
+	w = ht.Get("/offers?seller=foo")
+	ht.Assert.Equal(400, w.Code)
+}
+
+func TestOfferActions_Show(t *testing.T) {
+	ht := StartHTTPTest(t, "trades")
+	defer ht.Finish()
+
+	w := ht.Get("/offers/4")
+	if ht.Assert.Equal(200, w.Code) {
+		var result map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.EqualValues(4, result["id"])
+		ht.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", result["seller"])
+	}
+
+	// missing offer
+	w = ht.Get("/offers/100")
+	ht.Assert.Equal(404, w.Code)
+
+	// bad id
+	w = ht.Get("/offers/foo")
+	ht.Assert.Equal(400, w.Code)
+}
+
 func TestOfferActions_SSE(t *testing.T) {
 	tt := test.Start(t).Scenario("trades")
 	defer tt.Finish()
@@ -7,12 +7,15 @@ import (
 	"github.com/kinecosystem/go/services/horizon/internal/actions"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/ingest"
 	"github.com/kinecosystem/go/services/horizon/internal/ledger"
 	"github.com/kinecosystem/go/services/horizon/internal/render/problem"
 	"github.com/kinecosystem/go/services/horizon/internal/render/sse"
 	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
 	"github.com/kinecosystem/go/services/horizon/internal/toid"
 	"github.com/kinecosystem/go/support/render/hal"
+	"github.com/kinecosystem/go/support/time"
+	"github.com/kinecosystem/go/xdr"
 )
 
 // This file contains the actions:
@@ -32,6 +35,11 @@ type OperationIndexAction struct {
 	LedgerFilter      int32
 	AccountFilter     string
 	TransactionFilter string
+	AssetFilter       xdr.Asset
+	HasAssetFilter    bool
+	IncludeFailed     bool
+	StartTimeFilter   time.Millis
+	EndTimeFilter     time.Millis
 	PagingParams      db2.PageQuery
 	Records           []history.Operation
 	Ledgers           *history.LedgerCache
@@ -100,6 +108,9 @@ func (action *OperationIndexAction) GetTopic() string {
 	if res := action.GetString("tx_id"); res != "" {
 		return res
 	}
+	if asset, ok := action.MaybeGetAsset(""); ok {
+		return ingest.AssetTopic(asset)
+	}
 	return ""
 }
 
@@ -108,6 +119,9 @@ func (action *OperationIndexAction) loadParams() {
 	action.AccountFilter = action.GetAddress("account_id")
 	action.LedgerFilter = action.GetInt32("ledger_id")
 	action.TransactionFilter = action.GetString("tx_id")
+	action.AssetFilter, action.HasAssetFilter = action.MaybeGetAsset("")
+	action.IncludeFailed = action.GetBool("include_failed")
+	action.StartTimeFilter, action.EndTimeFilter = action.GetTimeRange()
 	action.PagingParams = action.GetPageQuery()
 }
 
@@ -124,6 +138,18 @@ func (action *OperationIndexAction) loadRecords() {
 		ops.ForTransaction(action.TransactionFilter)
 	}
 
+	if action.HasAssetFilter {
+		ops.ForAsset(action.AssetFilter)
+	}
+
+	if !action.StartTimeFilter.IsNil() || !action.EndTimeFilter.IsNil() {
+		ops.ForTimeRange(action.StartTimeFilter, action.EndTimeFilter)
+	}
+
+	if action.IncludeFailed {
+		ops.IncludeFailed()
+	}
+
 	action.Err = ops.Page(action.PagingParams).Select(&action.Records)
 }
 
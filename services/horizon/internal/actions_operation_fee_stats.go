@@ -2,6 +2,7 @@ package horizon
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/kinecosystem/go/services/horizon/internal/actions"
 	"github.com/kinecosystem/go/services/horizon/internal/operationfeestats"
@@ -18,10 +19,7 @@ var _ actions.JSONer = (*OperationFeeStatsAction)(nil)
 // current state of operation fees on the network.
 type OperationFeeStatsAction struct {
 	Action
-	Min         int64
-	Mode        int64
-	LastBaseFee int64
-	LastLedger  int64
+	operationfeestats.State
 }
 
 // JSON is a method for actions.JSON
@@ -29,11 +27,33 @@ func (action *OperationFeeStatsAction) JSON() error {
 	action.Do(
 		action.loadRecords,
 		func() {
-			hal.Render(action.W, map[string]string{
-				"min_accepted_fee":     fmt.Sprint(action.Min),
-				"mode_accepted_fee":    fmt.Sprint(action.Mode),
-				"last_ledger_base_fee": fmt.Sprint(action.LastBaseFee),
-				"last_ledger":          fmt.Sprint(action.LastLedger),
+			ledgerMaxFees := make([]map[string]string, 0, len(action.LedgerMaxFees))
+			for _, fee := range action.LedgerMaxFees {
+				ledgerMaxFees = append(ledgerMaxFees, map[string]string{
+					"ledger":  fmt.Sprint(fee.Ledger),
+					"max_fee": fmt.Sprint(fee.MaxFee),
+				})
+			}
+
+			hal.Render(action.W, map[string]interface{}{
+				"min_accepted_fee":      fmt.Sprint(action.Min),
+				"mode_accepted_fee":     fmt.Sprint(action.Mode),
+				"max_accepted_fee":      fmt.Sprint(action.Max),
+				"p10_accepted_fee":      fmt.Sprint(action.P10),
+				"p20_accepted_fee":      fmt.Sprint(action.P20),
+				"p30_accepted_fee":      fmt.Sprint(action.P30),
+				"p40_accepted_fee":      fmt.Sprint(action.P40),
+				"p50_accepted_fee":      fmt.Sprint(action.P50),
+				"p60_accepted_fee":      fmt.Sprint(action.P60),
+				"p70_accepted_fee":      fmt.Sprint(action.P70),
+				"p80_accepted_fee":      fmt.Sprint(action.P80),
+				"p90_accepted_fee":      fmt.Sprint(action.P90),
+				"p95_accepted_fee":      fmt.Sprint(action.P95),
+				"p99_accepted_fee":      fmt.Sprint(action.P99),
+				"ledger_capacity_usage": strconv.FormatFloat(action.LedgerCapacityUsage, 'f', 2, 64),
+				"last_ledger_base_fee":  fmt.Sprint(action.LastBaseFee),
+				"last_ledger":           fmt.Sprint(action.LastLedger),
+				"ledger_max_fees":       ledgerMaxFees,
 			})
 		},
 	)
@@ -41,9 +61,5 @@ func (action *OperationFeeStatsAction) JSON() error {
 }
 
 func (action *OperationFeeStatsAction) loadRecords() {
-	cur := operationfeestats.CurrentState()
-	action.Min = cur.Min
-	action.Mode = cur.Mode
-	action.LastBaseFee = cur.LastBaseFee
-	action.LastLedger = cur.LastLedger
+	action.State = operationfeestats.CurrentState()
 }
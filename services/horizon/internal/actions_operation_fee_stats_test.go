@@ -12,6 +12,12 @@ func TestOperationFeeTestsActions_Show(t *testing.T) {
 		min         string
 		mode        string
 		lastbasefee string
+		max         string
+		p10         string
+		p50         string
+		p99         string
+		capacity    string
+		maxFees     []string
 	}{
 		// happy path
 		{
@@ -19,6 +25,12 @@ func TestOperationFeeTestsActions_Show(t *testing.T) {
 			"100",
 			"100",
 			"100",
+			"100",
+			"100",
+			"100",
+			"100",
+			"0.22",
+			[]string{"100", "100", "100", "100", "100"},
 		},
 		// no transactions in last 5 ledgers
 		{
@@ -26,6 +38,12 @@ func TestOperationFeeTestsActions_Show(t *testing.T) {
 			"100",
 			"100",
 			"100",
+			"100",
+			"100",
+			"100",
+			"100",
+			"0.00",
+			[]string{"100", "100", "100", "100", "100"},
 		},
 		// transactions with varying fees
 		{
@@ -33,6 +51,12 @@ func TestOperationFeeTestsActions_Show(t *testing.T) {
 			"200",
 			"400",
 			"100",
+			"400",
+			"200",
+			"400",
+			"400",
+			"0.14",
+			[]string{"400", "300", "400", "400", "200"},
 		},
 	}
 
@@ -44,12 +68,37 @@ func TestOperationFeeTestsActions_Show(t *testing.T) {
 			w := ht.Get("/operation_fee_stats")
 
 			if ht.Assert.Equal(200, w.Code) {
-				var result map[string]string
+				var result map[string]interface{}
 				err := json.Unmarshal(w.Body.Bytes(), &result)
 				ht.Require.NoError(err)
 				ht.Assert.Equal(kase.min, result["min_accepted_fee"])
 				ht.Assert.Equal(kase.mode, result["mode_accepted_fee"])
 				ht.Assert.Equal(kase.lastbasefee, result["last_ledger_base_fee"])
+				ht.Assert.Equal(kase.max, result["max_accepted_fee"])
+				ht.Assert.Equal(kase.p10, result["p10_accepted_fee"])
+				ht.Assert.Equal(kase.p50, result["p50_accepted_fee"])
+				ht.Assert.Equal(kase.p99, result["p99_accepted_fee"])
+				ht.Assert.Equal(kase.capacity, result["ledger_capacity_usage"])
+
+				var body struct {
+					LastLedger    string `json:"last_ledger"`
+					LedgerMaxFees []struct {
+						Ledger string `json:"ledger"`
+						MaxFee string `json:"max_fee"`
+					} `json:"ledger_max_fees"`
+				}
+				err = json.Unmarshal(w.Body.Bytes(), &body)
+				ht.Require.NoError(err)
+
+				// one entry per ledger of the window, the latest first
+				var maxFees []string
+				for i, fee := range body.LedgerMaxFees {
+					if i == 0 {
+						ht.Assert.Equal(body.LastLedger, fee.Ledger)
+					}
+					maxFees = append(maxFees, fee.MaxFee)
+				}
+				ht.Assert.Equal(kase.maxFees, maxFees)
 			}
 		})
 	}
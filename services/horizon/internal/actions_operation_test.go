@@ -8,6 +8,7 @@ import (
 	"github.com/kinecosystem/go/protocols/horizon/operations"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
 	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/kinecosystem/go/xdr"
 )
 
 func TestOperationActions_Index(t *testing.T) {
@@ -72,6 +73,70 @@ func TestOperationActions_Index(t *testing.T) {
 	// missing ledger
 	w = ht.Get("/ledgers/100/operations")
 	ht.Assert.Equal(404, w.Code)
+
+	// filtered by ledger close time
+	w = ht.Get("/operations?start_time=1548955673500")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/accounts/GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H/operations?end_time=1548955674000")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	// operations of failed transactions
+	_, err := ht.HorizonDB.Exec(`
+		UPDATE history_transactions SET successful = false
+		WHERE transaction_hash = '2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d'
+	`)
+	ht.Require.NoError(err, "failed to update history_transactions")
+
+	w = ht.Get("/operations")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	var records []operations.Base
+	w = ht.Get("/transactions/2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d/operations?include_failed=true")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.False(records[0].TransactionSuccessful)
+	}
+
+	w = ht.Get("/operations?include_failed=true")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(4, w.Body)
+	}
+}
+
+func TestOperationActions_AssetFilter(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+	insertOperationAssets(ht, xdr.MustNewNativeAsset(), 8589938689, 12884905985)
+
+	var records []operations.Base
+	w := ht.Get("/operations?asset_type=native")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(2, w.Body)
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal("8589938689", records[0].PT)
+		ht.Assert.Equal("12884905985", records[1].PT)
+	}
+
+	w = ht.Get("/ledgers/3/operations?asset_type=native")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	// assets never involved in an operation
+	w = ht.Get("/operations?asset_type=credit_alphanum4&asset_code=USD&asset_issuer=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON")
+	ht.Assert.Equal(404, w.Code)
+
+	// invalid asset
+	w = ht.Get("/operations?asset_type=credit_alphanum4&asset_code=USD")
+	ht.Assert.Equal(400, w.Code)
 }
 
 func TestOperationActions_Show(t *testing.T) {
@@ -150,3 +215,19 @@ func TestOperation_BumpSequence(t *testing.T) {
 		ht.Assert.Equal("300000000003", result.BumpTo)
 	}
 }
+
+// insertOperationAssets records `asset` as participating in each of the
+// operations identified by `opids`.
+func insertOperationAssets(ht *HTTPT, asset xdr.Asset, opids ...int64) {
+	q := history.Q{Session: ht.HorizonSession()}
+	assetID, err := q.GetCreateAssetID(asset)
+	ht.Require.NoError(err, "failed to create asset")
+
+	for _, opid := range opids {
+		_, err = ht.HorizonDB.Exec(
+			`INSERT INTO history_operation_assets (history_operation_id, history_asset_id) VALUES ($1, $2)`,
+			opid, assetID,
+		)
+		ht.Require.NoError(err, "failed to insert into history_operation_assets")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kinecosystem/go/protocols/horizon"
 	"github.com/kinecosystem/go/services/horizon/internal/actions"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/ingest"
 	"github.com/kinecosystem/go/services/horizon/internal/render/sse"
 	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
 	"github.com/kinecosystem/go/support/render/hal"
@@ -99,8 +100,14 @@ func (action *OrderBookShowAction) LoadEvent() (sse.Event, error) {
 
 // GetTopic is a method for actions.SSE
 //
-// There is no value in this action for specific order, so registration topic is a general
-// change in the ledger.
+// The registration topic is published whenever ingestion commits changes to
+// the offers trading between the selling and buying assets, in either
+// direction.
 func (action *OrderBookShowAction) GetTopic() string {
-	return "order_book"
+	action.LoadQuery()
+	if action.Err != nil {
+		return ""
+	}
+
+	return ingest.OrderBookTopic(action.Selling, action.Buying)
 }
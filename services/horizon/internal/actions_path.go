@@ -64,3 +64,61 @@ func (action *PathIndexAction) loadPage() {
 		action.Page.Add(res)
 	}
 }
+
+// Interface verification
+var _ actions.JSONer = (*StrictSendPathIndexAction)(nil)
+
+// StrictSendPathIndexAction provides strict send path finding: given a source
+// asset and amount, it finds the paths to the assets the destination account
+// can hold and the amounts that would be received through them.
+type StrictSendPathIndexAction struct {
+	Action
+	Query   paths.StrictSendQuery
+	Records []paths.Path
+	Page    hal.BasePage
+}
+
+// JSON implements actions.JSON
+func (action *StrictSendPathIndexAction) JSON() error {
+	action.Do(
+		action.loadQuery,
+		action.loadDestinationAssets,
+		action.loadRecords,
+		action.loadPage,
+		func() { hal.Render(action.W, action.Page) },
+	)
+	return action.Err
+}
+
+func (action *StrictSendPathIndexAction) loadQuery() {
+	action.Query.SourceAmount = action.GetPositiveAmount("source_amount")
+	action.Query.SourceAsset = action.GetAsset("source_")
+}
+
+func (action *StrictSendPathIndexAction) loadDestinationAssets() {
+	app := AppFromContext(action.R.Context())
+	protocolVersion := app.coreSupportedProtocolVersion
+
+	action.Err = action.CoreQ().AssetsForAddress(
+		&action.Query.DestinationAssets,
+		action.GetAddress("destination_account", actions.RequiredParam),
+		protocolVersion,
+	)
+}
+
+func (action *StrictSendPathIndexAction) loadRecords() {
+	action.Records, action.Err = action.App.paths.FindStrictSend(action.Query, action.App.config.MaxPathLength)
+}
+
+func (action *StrictSendPathIndexAction) loadPage() {
+	action.Page.Init()
+	for _, p := range action.Records {
+		var res horizon.Path
+		action.Err = resourceadapter.PopulateStrictSendPath(action.R.Context(), &res, p)
+
+		if action.Err != nil {
+			return
+		}
+		action.Page.Add(res)
+	}
+}
@@ -3,6 +3,8 @@ package horizon
 import (
 	"net/url"
 	"testing"
+
+	"github.com/kinecosystem/go/protocols/horizon"
 )
 
 func TestPathActions_Index(t *testing.T) {
@@ -37,3 +39,58 @@ func TestPathActions_Index(t *testing.T) {
 	ht.Assert.PageOf(3, w.Body)
 
 }
+
+func TestPathActions_StrictSend(t *testing.T) {
+	ht := StartHTTPTest(t, "paths")
+	defer ht.Finish()
+
+	// no query args
+	w := ht.Get("/paths/strict-send")
+	ht.Assert.Equal(400, w.Code)
+
+	// happy path
+	var q = make(url.Values)
+
+	q.Add(
+		"destination_account",
+		"GAEDTJ4PPEFVW5XV2S7LUXBEHNQMX5Q2GM562RJGOQG7GVCE5H3HIB4V",
+	)
+	q.Add(
+		"source_asset_issuer",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN",
+	)
+	q.Add("source_asset_type", "credit_alphanum4")
+	q.Add("source_asset_code", "USD")
+	q.Add("source_amount", "10")
+
+	w = ht.Get("/paths/strict-send?" + q.Encode())
+	if ht.Assert.Equal(200, w.Code) {
+		var records []horizon.Path
+		ht.UnmarshalPage(w.Body, &records)
+
+		// destination amounts by destination asset and path
+		received := map[string]string{}
+		for _, r := range records {
+			ht.Assert.Equal("10.00000", r.SourceAmount)
+			ht.Assert.Equal("credit_alphanum4", r.SourceAssetType)
+			ht.Assert.Equal("USD", r.SourceAssetCode)
+
+			key := r.DestinationAssetType + ":" + r.DestinationAssetCode
+			for _, a := range r.Path {
+				key += "/" + a.Code
+			}
+			received[key] = r.DestinationAmount
+		}
+
+		// 10 USD buy 20 EUR from the cheapest offers, priced at 0.5
+		ht.Assert.Equal("20.00000", received["credit_alphanum4:EUR"])
+		// through asset 1, both offers priced at 1.0
+		ht.Assert.Equal("10.00000", received["credit_alphanum4:EUR/1"])
+		// the native offer is priced at 0.1
+		ht.Assert.Equal("100.00000", received["native:"])
+		// CCC, trusted by the destination, can't be bought with USD
+		for key := range received {
+			ht.Assert.NotContains(key, "CCC")
+		}
+	}
+}
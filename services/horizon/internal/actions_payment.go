@@ -7,9 +7,12 @@ import (
 	"github.com/kinecosystem/go/services/horizon/internal/actions"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/ingest"
 	"github.com/kinecosystem/go/services/horizon/internal/render/sse"
 	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
 	"github.com/kinecosystem/go/support/render/hal"
+	"github.com/kinecosystem/go/support/time"
+	"github.com/kinecosystem/go/xdr"
 )
 
 // Interface verifications
@@ -23,6 +26,12 @@ type PaymentsIndexAction struct {
 	LedgerFilter      int32
 	AccountFilter     string
 	TransactionFilter string
+	AssetFilter       xdr.Asset
+	HasAssetFilter    bool
+	StartTimeFilter   time.Millis
+	EndTimeFilter     time.Millis
+	MemoTypeFilter    string
+	MemoFilter        string
 	PagingParams      db2.PageQuery
 	Records           []history.Operation
 	Ledgers           *history.LedgerCache
@@ -92,6 +101,9 @@ func (action *PaymentsIndexAction) GetTopic() string {
 	if res := action.GetString("tx_id"); res != "" {
 		return res
 	}
+	if asset, ok := action.MaybeGetAsset(""); ok {
+		return ingest.AssetTopic(asset)
+	}
 	return ""
 }
 
@@ -100,6 +112,9 @@ func (action *PaymentsIndexAction) loadParams() {
 	action.AccountFilter = action.GetAddress("account_id")
 	action.LedgerFilter = action.GetInt32("ledger_id")
 	action.TransactionFilter = action.GetString("tx_id")
+	action.AssetFilter, action.HasAssetFilter = action.MaybeGetAsset("")
+	action.StartTimeFilter, action.EndTimeFilter = action.GetTimeRange()
+	action.MemoTypeFilter, action.MemoFilter = action.GetMemo()
 	action.PagingParams = action.GetPageQuery()
 }
 
@@ -116,6 +131,18 @@ func (action *PaymentsIndexAction) loadRecords() {
 		ops.ForTransaction(action.TransactionFilter)
 	}
 
+	if action.HasAssetFilter {
+		ops.ForAsset(action.AssetFilter)
+	}
+
+	if !action.StartTimeFilter.IsNil() || !action.EndTimeFilter.IsNil() {
+		ops.ForTimeRange(action.StartTimeFilter, action.EndTimeFilter)
+	}
+
+	if action.MemoTypeFilter != "" {
+		ops.ForMemo(action.MemoTypeFilter, action.MemoFilter)
+	}
+
 	action.Err = ops.Page(action.PagingParams).Select(&action.Records)
 }
 
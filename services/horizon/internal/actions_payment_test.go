@@ -6,6 +6,7 @@ import (
 
 	"github.com/kinecosystem/go/protocols/horizon/operations"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/xdr"
 )
 
 func TestPaymentActions(t *testing.T) {
@@ -34,6 +35,41 @@ func TestPaymentActions(t *testing.T) {
 		ht.Assert.PageOf(1, w.Body)
 	}
 
+	// filtered by ledger close time
+	w = ht.Get("/payments?start_time=1548955673500")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	// filtered by memo
+	_, err := ht.HorizonDB.Exec(`
+		UPDATE history_transactions SET memo_type = 'id', memo = '123'
+		WHERE transaction_hash = 'cebb875a00ff6e1383aef0fd251a76f22c1f9ab2a2dffcb077855736ade2659a'
+	`)
+	ht.Require.NoError(err, "failed to update history_transactions")
+
+	var records []operations.Payment
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/payments?memo_type=id&memo=123")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal("id", records[0].TransactionMemoType)
+		ht.Assert.Equal("123", records[0].TransactionMemo)
+	}
+
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/payments?memo_type=id&memo=124")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(0, w.Body)
+	}
+
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/payments?memo_type=none")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/payments?memo=123")
+	ht.Assert.Equal(400, w.Code)
+
 	// switch scenarios
 	ht.T.Scenario("pathed_payment")
 
@@ -58,6 +94,25 @@ func TestPaymentActions(t *testing.T) {
 	ht.Assert.Equal(400, w.Code)
 }
 
+func TestPaymentActions_AssetFilter(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+	insertOperationAssets(ht, xdr.MustNewNativeAsset(), 8589938689, 12884905985)
+
+	w := ht.Get("/payments?asset_type=native")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(2, w.Body)
+	}
+
+	w = ht.Get("/accounts/GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON/payments?asset_type=native&start_time=1548955673500")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/payments?asset_type=credit_alphanum4&asset_code=USD&asset_issuer=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON")
+	ht.Assert.Equal(404, w.Code)
+}
+
 func TestPayment_CreatedAt(t *testing.T) {
 	ht := StartHTTPTest(t, "base")
 	defer ht.Finish()
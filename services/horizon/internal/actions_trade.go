@@ -8,6 +8,7 @@ import (
 	"github.com/kinecosystem/go/services/horizon/internal/actions"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/ingest"
 	"github.com/kinecosystem/go/services/horizon/internal/render/sse"
 	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
 	"github.com/kinecosystem/go/support/errors"
@@ -26,6 +27,8 @@ type TradeIndexAction struct {
 	HasBaseAssetFilter    bool
 	CounterAssetFilter    xdr.Asset
 	HasCounterAssetFilter bool
+	AssetFilter           xdr.Asset
+	HasAssetFilter        bool
 	OfferFilter           int64
 	AccountFilter         string
 	PagingParams          db2.PageQuery
@@ -79,6 +82,9 @@ func (action *TradeIndexAction) GetTopic() string {
 	if res := action.GetString("account_id"); res != "" {
 		return res
 	}
+	if asset, ok := action.MaybeGetAsset(""); ok {
+		return ingest.AssetTopic(asset)
+	}
 	return ""
 }
 
@@ -87,6 +93,7 @@ func (action *TradeIndexAction) loadParams() {
 	action.PagingParams = action.GetPageQuery()
 	action.BaseAssetFilter, action.HasBaseAssetFilter = action.MaybeGetAsset("base_")
 	action.CounterAssetFilter, action.HasCounterAssetFilter = action.MaybeGetAsset("counter_")
+	action.AssetFilter, action.HasAssetFilter = action.MaybeGetAsset("")
 	action.OfferFilter = action.GetInt64("offer_id")
 	action.AccountFilter = action.GetAddress("account_id")
 
@@ -130,6 +137,10 @@ func (action *TradeIndexAction) loadRecords() {
 		trades = trades.ForOffer(action.OfferFilter)
 	}
 
+	if action.HasAssetFilter {
+		trades = trades.ForAsset(action.AssetFilter)
+	}
+
 	action.Err = trades.Page(action.PagingParams).Select(&action.Records)
 }
 
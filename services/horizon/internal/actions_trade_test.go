@@ -87,6 +87,22 @@ func TestTradeActions_Index(t *testing.T) {
 		ht.Assert.Contains(records[0], "counter_amount")
 	}
 
+	// for a single asset, on either side of the trade
+	q = make(url.Values)
+	q.Add("asset_type", "credit_alphanum4")
+	q.Add("asset_code", "EUR")
+	q.Add("asset_issuer", "GCQPYGH4K57XBDENKKX55KDTWOTK5WDWRQOH2LHEDX3EKVIQRLMESGBG")
+
+	w = ht.GetWithParams("/trades", q)
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(2, w.Body)
+	}
+
+	w = ht.GetWithParams("/accounts/GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2/trades", q)
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(2, w.Body)
+	}
+
 	// For offer
 	w = ht.Get("/offers/1/trades")
 	if ht.Assert.Equal(200, w.Code) {
@@ -374,6 +390,22 @@ func TestTradeActions_AggregationOrdering(t *testing.T) {
 		ht.Assert.Equal("1.0000000", records[0].Open)
 		ht.Assert.Equal("3.0000000", records[0].Close)
 	}
+
+	// trades without price, like the ones ingested from a history archive,
+	// are left out
+	_, err := ht.HorizonDB.Exec(
+		"UPDATE history_trades SET price_n = NULL, price_d = NULL WHERE history_operation_id = 3",
+	)
+	ht.Require.NoError(err)
+
+	w = ht.GetWithParams("/trade_aggregations", q)
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal(int64(2), records[0].TradeCount)
+		ht.Assert.Equal("1.0000000", records[0].Open)
+		ht.Assert.Equal("2.0000000", records[0].Close)
+	}
 }
 
 func assertOfferType(ht *HTTPT, offerId string, idType OfferIDType) {
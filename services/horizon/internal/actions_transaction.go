@@ -13,12 +13,15 @@ import (
 	"github.com/kinecosystem/go/services/horizon/internal/txsub"
 	"github.com/kinecosystem/go/support/render/hal"
 	"github.com/kinecosystem/go/support/render/problem"
+	"github.com/kinecosystem/go/support/time"
 )
 
 // This file contains the actions:
 //
 // TransactionIndexAction: pages of transactions
 // TransactionShowAction: single transaction by sequence, by hash or id
+// TransactionAsyncCreateAction: submits a transaction without waiting for it
+// TransactionAsyncShowAction: status of an asynchronously submitted transaction
 
 // Interface verifications
 var _ actions.JSONer = (*TransactionIndexAction)(nil)
@@ -28,11 +31,16 @@ var _ actions.EventStreamer = (*TransactionIndexAction)(nil)
 // a normal page query.
 type TransactionIndexAction struct {
 	Action
-	LedgerFilter  int32
-	AccountFilter string
-	PagingParams  db2.PageQuery
-	Records       []history.Transaction
-	Page          hal.Page
+	LedgerFilter    int32
+	AccountFilter   string
+	IncludeFailed   bool
+	StartTimeFilter time.Millis
+	EndTimeFilter   time.Millis
+	MemoTypeFilter  string
+	MemoFilter      string
+	PagingParams    db2.PageQuery
+	Records         []history.Transaction
+	Page            hal.Page
 }
 
 // JSON is a method for actions.JSON
@@ -87,6 +95,9 @@ func (action *TransactionIndexAction) loadParams() {
 	action.ValidateCursorAsDefault()
 	action.AccountFilter = action.GetAddress("account_id")
 	action.LedgerFilter = action.GetInt32("ledger_id")
+	action.IncludeFailed = action.GetBool("include_failed")
+	action.StartTimeFilter, action.EndTimeFilter = action.GetTimeRange()
+	action.MemoTypeFilter, action.MemoFilter = action.GetMemo()
 	action.PagingParams = action.GetPageQuery()
 }
 
@@ -101,6 +112,18 @@ func (action *TransactionIndexAction) loadRecords() {
 		txs.ForLedger(action.LedgerFilter)
 	}
 
+	if !action.StartTimeFilter.IsNil() || !action.EndTimeFilter.IsNil() {
+		txs.ForTimeRange(action.StartTimeFilter, action.EndTimeFilter)
+	}
+
+	if action.MemoTypeFilter != "" {
+		txs.ForMemo(action.MemoTypeFilter, action.MemoFilter)
+	}
+
+	if action.IncludeFailed {
+		txs.IncludeFailed()
+	}
+
 	action.Err = txs.Page(action.PagingParams).Select(&action.Records)
 }
 
@@ -213,7 +236,7 @@ func (action *TransactionCreateAction) loadResource() {
 		rcr := horizon.TransactionResultCodes{}
 		resourceadapter.PopulateTransactionResultCodes(action.R.Context(), &rcr, err)
 
-		action.Err = &problem.P{
+		p := &problem.P{
 			Type:   "transaction_failed",
 			Title:  "Transaction Failed",
 			Status: http.StatusBadRequest,
@@ -227,21 +250,147 @@ func (action *TransactionCreateAction) loadResource() {
 				"result_codes": rcr,
 			},
 		}
-	case *txsub.MalformedTransactionError:
-		action.Err = &problem.P{
-			Type:   "transaction_malformed",
-			Title:  "Transaction Malformed",
-			Status: http.StatusBadRequest,
-			Detail: "Horizon could not decode the transaction envelope in this " +
-				"request. A transaction should be an XDR TransactionEnvelope struct " +
-				"encoded using base64.  The envelope read from this request is " +
-				"echoed in the `extras.envelope_xdr` field of this response for your " +
-				"convenience.",
-			Extras: map[string]interface{}{
-				"envelope_xdr": err.EnvelopeXDR,
-			},
+		if len(action.Result.Attempts) > 0 {
+			p.Extras["attempts"] = resourceadapter.PopulateSubmissionAttempts(action.R.Context(), action.Result.Attempts)
 		}
+		action.Err = p
+	case *txsub.MalformedTransactionError:
+		action.Err = transactionMalformedProblem(err)
+	default:
+		action.Err = err
+	}
+}
+
+// transactionMalformedProblem returns the problem rendered when the submitted
+// transaction envelope could not be decoded.
+func transactionMalformedProblem(err *txsub.MalformedTransactionError) *problem.P {
+	return &problem.P{
+		Type:   "transaction_malformed",
+		Title:  "Transaction Malformed",
+		Status: http.StatusBadRequest,
+		Detail: "Horizon could not decode the transaction envelope in this " +
+			"request. A transaction should be an XDR TransactionEnvelope struct " +
+			"encoded using base64.  The envelope read from this request is " +
+			"echoed in the `extras.envelope_xdr` field of this response for your " +
+			"convenience.",
+		Extras: map[string]interface{}{
+			"envelope_xdr": err.EnvelopeXDR,
+		},
+	}
+}
+
+// Interface verification
+var _ actions.JSONer = (*TransactionAsyncCreateAction)(nil)
+
+// TransactionAsyncCreateAction submits a transaction to the stellar-core
+// network on behalf of the requesting client, responding with the status
+// stellar-core returned as soon as it is known rather than waiting for the
+// transaction to be applied.
+type TransactionAsyncCreateAction struct {
+	Action
+	TX       string
+	Hash     string
+	Result   txsub.SubmissionResult
+	Resource horizon.AsyncTransactionSubmission
+}
+
+// JSON format action handler
+func (action *TransactionAsyncCreateAction) JSON() error {
+	action.Do(
+		action.loadTX,
+		action.loadResult,
+		action.loadResource,
+		func() { hal.Render(action.W, action.Resource) },
+	)
+	return action.Err
+}
+
+func (action *TransactionAsyncCreateAction) loadTX() {
+	action.ValidateBodyType()
+	action.TX = action.GetString("tx")
+}
+
+func (action *TransactionAsyncCreateAction) loadResult() {
+	action.Hash, action.Result = action.App.submitter.SubmitAsync(action.R.Context(), action.TX)
+}
+
+func (action *TransactionAsyncCreateAction) loadResource() {
+	switch err := action.Result.Err.(type) {
+	case nil, *txsub.FailedTransactionError:
+		resourceadapter.PopulateAsyncTransactionSubmission(
+			action.R.Context(),
+			&action.Resource,
+			action.Hash,
+			action.Result,
+		)
+	case *txsub.MalformedTransactionError:
+		action.Err = transactionMalformedProblem(err)
 	default:
 		action.Err = err
 	}
 }
+
+// Interface verification
+var _ actions.JSONer = (*TransactionAsyncShowAction)(nil)
+var _ actions.SingleObjectStreamer = (*TransactionAsyncShowAction)(nil)
+
+// TransactionAsyncShowAction renders the status of a transaction submitted
+// through TransactionAsyncCreateAction, found by its hash.
+type TransactionAsyncShowAction struct {
+	Action
+	Hash     string
+	Result   txsub.Result
+	Resource horizon.TransactionStatus
+}
+
+// JSON is a method for actions.JSON
+func (action *TransactionAsyncShowAction) JSON() error {
+	action.Do(
+		action.loadParams,
+		action.loadResult,
+		action.loadResource,
+		func() { hal.Render(action.W, action.Resource) },
+	)
+	return action.Err
+}
+
+func (action *TransactionAsyncShowAction) LoadEvent() (sse.Event, error) {
+	action.Do(action.loadParams, action.loadResult, action.loadResource)
+	return sse.Event{Data: action.Resource}, action.Err
+}
+
+// GetTopic is a method for actions.SSE
+//
+// Failed transactions are not published, so the status is checked again each
+// time a ledger is ingested.
+func (action *TransactionAsyncShowAction) GetTopic() string {
+	return "ledger"
+}
+
+func (action *TransactionAsyncShowAction) loadParams() {
+	action.Hash = action.GetString("tx_id")
+}
+
+func (action *TransactionAsyncShowAction) loadResult() {
+	action.Result = action.App.submitter.Status(action.R.Context(), action.Hash)
+}
+
+func (action *TransactionAsyncShowAction) loadResource() {
+	_, failed := action.Result.Err.(*txsub.FailedTransactionError)
+
+	switch {
+	case action.Result.Err == txsub.ErrNoResults:
+		action.Err = &problem.NotFound
+		return
+	case action.Result.Err != nil && action.Result.Err != txsub.ErrPending && !failed:
+		action.Err = action.Result.Err
+		return
+	}
+
+	resourceadapter.PopulateTransactionStatus(
+		action.R.Context(),
+		&action.Resource,
+		action.Hash,
+		action.Result,
+	)
+}
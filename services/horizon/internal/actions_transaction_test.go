@@ -85,6 +85,80 @@ func TestTransactionActions_Index(t *testing.T) {
 	w = ht.Get("/transactions?limit=0")
 	ht.Assert.Equal(400, w.Code)
 
+	// filtered by ledger close time, ledger 3 closed at 1548955674000
+	w = ht.Get("/transactions?start_time=1548955673500")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/transactions?end_time=1548955674000")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/transactions?start_time=1548955673500&end_time=1548955675000")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/transactions?start_time=1548955674000&end_time=1548955673000")
+	ht.Assert.Equal(400, w.Code)
+
+	// filtered by memo
+	_, err := ht.HorizonDB.Exec(`
+		UPDATE history_transactions SET memo_type = 'text', memo = 'deposit'
+		WHERE transaction_hash = 'cebb875a00ff6e1383aef0fd251a76f22c1f9ab2a2dffcb077855736ade2659a'
+	`)
+	ht.Require.NoError(err, "failed to update history_transactions")
+
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/transactions?memo_type=text&memo=deposit")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/accounts/GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU/transactions?memo_type=text")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/transactions?memo_type=none")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	w = ht.Get("/transactions?memo_type=foo")
+	ht.Assert.Equal(400, w.Code)
+
+	// failed transactions
+	_, err = ht.HorizonDB.Exec(`
+		UPDATE history_transactions SET successful = false
+		WHERE transaction_hash = '2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d'
+	`)
+	ht.Require.NoError(err, "failed to update history_transactions")
+
+	w = ht.Get("/transactions")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	var records []horizon.Transaction
+	w = ht.Get("/transactions?include_failed=true")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(4, w.Body)
+		ht.UnmarshalPage(w.Body, &records)
+		for _, record := range records {
+			failed := record.Hash == "2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d"
+			ht.Assert.Equal(!failed, record.Successful)
+		}
+	}
+
+	w = ht.Get("/accounts/GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H/transactions?include_failed=true")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	w = ht.Get("/transactions?include_failed=maybe")
+	ht.Assert.Equal(400, w.Code)
 }
 
 func TestTransactionActions_Post(t *testing.T) {
@@ -106,3 +180,41 @@ func TestTransactionActions_Post(t *testing.T) {
 	w = ht.Post("/transactions", form)
 	ht.Assert.Equal(503, w.Code)
 }
+
+func TestTransactionActions_PostAsync(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	ht.App.submitter.Submitter = &txsub.MockSubmitter{
+		R: txsub.SubmissionResult{Status: "PENDING"},
+	}
+
+	form := url.Values{"tx": []string{"AAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3AAAAZAAAAAAAAAABAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAArqN6LeOagjxMaUP96Bzfs9e0corNZXzBWJkFoK7kvkwAAAAAO5rKAAAAAAAAAAABVvwF9wAAAECDzqvkQBQoNAJifPRXDoLhvtycT3lFPCQ51gkdsFHaBNWw05S/VhW0Xgkr0CBPE4NaFV2Kmcs3ZwLmib4TRrML"}}
+
+	w := ht.Post("/transactions_async", form)
+	if ht.Assert.Equal(200, w.Code) {
+		var result horizon.AsyncTransactionSubmission
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal("PENDING", result.TxStatus)
+		ht.Assert.Equal("2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d", result.Hash)
+	}
+
+	// the transaction is already in the history database
+	w = ht.Get("/transactions_async/2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d")
+	if ht.Assert.Equal(200, w.Code) {
+		var result horizon.TransactionStatus
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal("success", result.Status)
+		ht.Assert.Equal(int32(2), result.Ledger)
+	}
+
+	// unknown transaction
+	w = ht.Get("/transactions_async/0000000000000000000000000000000000000000000000000000000000000000")
+	ht.Assert.Equal(404, w.Code)
+
+	// malformed envelope
+	w = ht.Post("/transactions_async", url.Values{"tx": []string{"foo"}})
+	ht.Assert.Equal(400, w.Code)
+}
@@ -0,0 +1,187 @@
+package horizon
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+
+	"github.com/guregu/null"
+	"github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/services/horizon/internal/actions"
+	"github.com/kinecosystem/go/services/horizon/internal/db2"
+	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
+	"github.com/kinecosystem/go/services/horizon/internal/webhooks"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/support/render/hal"
+)
+
+// This file contains the actions:
+//
+// WebhookIndexAction: pages of webhook subscriptions
+// WebhookCreateAction: registers a webhook subscription
+// WebhookDeleteAction: removes a webhook subscription
+
+// Interface verifications
+var _ actions.JSONer = (*WebhookIndexAction)(nil)
+var _ actions.JSONer = (*WebhookCreateAction)(nil)
+var _ actions.JSONer = (*WebhookDeleteAction)(nil)
+
+// WebhookIndexAction renders a page of webhook subscriptions.
+type WebhookIndexAction struct {
+	Action
+	PagingParams db2.PageQuery
+	Records      []webhooks.Subscription
+	Page         hal.Page
+}
+
+// JSON is a method for actions.JSON
+func (action *WebhookIndexAction) JSON() error {
+	action.Do(
+		action.loadParams,
+		action.loadRecords,
+		action.loadPage,
+		func() { hal.Render(action.W, action.Page) },
+	)
+	return action.Err
+}
+
+func (action *WebhookIndexAction) loadParams() {
+	action.ValidateCursorAsDefault()
+	action.PagingParams = action.GetPageQuery()
+}
+
+func (action *WebhookIndexAction) loadRecords() {
+	action.Err = action.WebhooksQ().Subscriptions(&action.Records, action.PagingParams)
+}
+
+func (action *WebhookIndexAction) loadPage() {
+	for _, record := range action.Records {
+		var res horizon.WebhookSubscription
+		resourceadapter.PopulateWebhookSubscription(action.R.Context(), &res, record)
+		action.Page.Add(res)
+	}
+
+	action.Page.FullURL = action.FullURL()
+	action.Page.Limit = action.PagingParams.Limit
+	action.Page.Cursor = action.PagingParams.Cursor
+	action.Page.Order = action.PagingParams.Order
+	action.Page.PopulateLinks()
+}
+
+// WebhookCreateAction registers a subscription to the notifications of the
+// ingested effects matching the optional account, asset and effect type
+// parameters.  The response holds the secret the notifications are signed
+// with.
+type WebhookCreateAction struct {
+	Action
+	Record   webhooks.Subscription
+	Resource horizon.WebhookSubscription
+}
+
+// JSON is a method for actions.JSON
+func (action *WebhookCreateAction) JSON() error {
+	action.Do(
+		action.ValidateBodyType,
+		action.loadParams,
+		action.loadRecord,
+		action.loadResource,
+		func() { hal.Render(action.W, action.Resource) },
+	)
+	return action.Err
+}
+
+func (action *WebhookCreateAction) loadParams() {
+	rawURL := action.GetString("url")
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		action.SetInvalidField("url", errors.New("must be an absolute https URL"))
+		return
+	}
+	action.Record.URL = rawURL
+
+	if account := action.GetAddress("account"); account != "" {
+		action.Record.Account = null.StringFrom(account)
+	}
+
+	if asset, ok := action.MaybeGetAsset(""); ok {
+		var typ, code, issuer string
+		err = asset.Extract(&typ, &code, &issuer)
+		if err != nil {
+			action.Err = err
+			return
+		}
+
+		action.Record.AssetType = null.StringFrom(typ)
+		if typ != "native" {
+			action.Record.AssetCode = null.StringFrom(code)
+			action.Record.AssetIssuer = null.StringFrom(issuer)
+		}
+	}
+
+	if name := action.GetString("effect_type"); name != "" {
+		found := false
+		for typ, typName := range resourceadapter.EffectTypeNames {
+			if typName == name {
+				action.Record.EffectType = null.IntFrom(int64(typ))
+				found = true
+			}
+		}
+
+		if !found {
+			action.SetInvalidField("effect_type", errors.New("unknown effect type"))
+			return
+		}
+	}
+
+	secret := make([]byte, 32)
+	_, err = rand.Read(secret)
+	if err != nil {
+		action.Err = errors.Wrap(err, "failed to generate secret")
+		return
+	}
+	action.Record.Secret = hex.EncodeToString(secret)
+}
+
+func (action *WebhookCreateAction) loadRecord() {
+	action.Err = action.WebhooksQ().CreateSubscription(&action.Record)
+}
+
+func (action *WebhookCreateAction) loadResource() {
+	resourceadapter.PopulateWebhookSubscription(action.R.Context(), &action.Resource, action.Record)
+	action.Resource.Secret = action.Record.Secret
+}
+
+// WebhookDeleteAction removes a webhook subscription and its pending
+// notifications.  It renders the removed subscription.
+type WebhookDeleteAction struct {
+	Action
+	ID       int64
+	Record   webhooks.Subscription
+	Resource horizon.WebhookSubscription
+}
+
+// JSON is a method for actions.JSON
+func (action *WebhookDeleteAction) JSON() error {
+	action.Do(
+		action.loadParams,
+		action.loadRecord,
+		action.deleteRecord,
+		func() {
+			resourceadapter.PopulateWebhookSubscription(action.R.Context(), &action.Resource, action.Record)
+			hal.Render(action.W, action.Resource)
+		},
+	)
+	return action.Err
+}
+
+func (action *WebhookDeleteAction) loadParams() {
+	action.ID = action.GetInt64("id")
+}
+
+func (action *WebhookDeleteAction) loadRecord() {
+	action.Err = action.WebhooksQ().SubscriptionByID(&action.Record, action.ID)
+}
+
+func (action *WebhookDeleteAction) deleteRecord() {
+	_, action.Err = action.WebhooksQ().DeleteSubscription(action.ID)
+}
@@ -0,0 +1,81 @@
+package horizon
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/kinecosystem/go/protocols/horizon"
+)
+
+func TestWebhookActions(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	c := NewTestConfig()
+	c.EnableWebhooks = true
+	c.WebhookAdminToken = "admin-token"
+	app := NewApp(c)
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	authorized := func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer admin-token")
+	}
+
+	// requires the admin token
+	w := rh.Get("/admin/webhooks")
+	ht.Assert.Equal(401, w.Code)
+	w = rh.Get("/admin/webhooks", func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer wrong-token")
+	})
+	ht.Assert.Equal(401, w.Code)
+
+	// requires an https URL
+	w = rh.Post("/admin/webhooks", url.Values{"url": {"http://example.com/hook"}}, authorized)
+	ht.Assert.Equal(400, w.Code)
+
+	// rejects unknown effect types
+	w = rh.Post("/admin/webhooks", url.Values{
+		"url":         {"https://example.com/hook"},
+		"effect_type": {"unknown"},
+	}, authorized)
+	ht.Assert.Equal(400, w.Code)
+
+	w = rh.Post("/admin/webhooks", url.Values{
+		"url":         {"https://example.com/hook"},
+		"account":     {"GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"},
+		"asset_type":  {"native"},
+		"effect_type": {"account_credited"},
+	}, authorized)
+	ht.Require.Equal(200, w.Code)
+
+	var created horizon.WebhookSubscription
+	ht.Require.NoError(json.Unmarshal(w.Body.Bytes(), &created))
+	ht.Assert.Equal("https://example.com/hook", created.URL)
+	ht.Assert.Equal("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", created.Account)
+	ht.Assert.Equal("native", created.AssetType)
+	ht.Assert.Equal("account_credited", created.EffectType)
+	ht.Assert.Len(created.Secret, 64)
+
+	// lists subscriptions without their secret
+	w = rh.Get("/admin/webhooks", authorized)
+	if ht.Assert.Equal(200, w.Code) {
+		var records []horizon.WebhookSubscription
+		ht.UnmarshalPage(w.Body, &records)
+		if ht.Assert.Len(records, 1) {
+			ht.Assert.Equal(created.ID, records[0].ID)
+			ht.Assert.Empty(records[0].Secret)
+		}
+	}
+
+	w = rh.Delete("/admin/webhooks/"+created.ID, authorized)
+	ht.Assert.Equal(200, w.Code)
+	w = rh.Delete("/admin/webhooks/"+created.ID, authorized)
+	ht.Assert.Equal(404, w.Code)
+
+	w = rh.Get("/admin/webhooks", authorized)
+	ht.Assert.Equal(200, w.Code)
+	ht.Assert.PageOf(0, w.Body)
+}
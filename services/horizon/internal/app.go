@@ -22,6 +22,7 @@ import (
 	"github.com/kinecosystem/go/services/horizon/internal/paths"
 	"github.com/kinecosystem/go/services/horizon/internal/reap"
 	"github.com/kinecosystem/go/services/horizon/internal/txsub"
+	"github.com/kinecosystem/go/services/horizon/internal/webhooks"
 	"github.com/kinecosystem/go/support/app"
 	"github.com/kinecosystem/go/support/db"
 	"github.com/kinecosystem/go/support/log"
@@ -47,6 +48,7 @@ type App struct {
 	paths                        paths.Finder
 	ingester                     *ingest.System
 	reaper                       *reap.System
+	webhooks                     *webhooks.System
 	ticks                        *time.Ticker
 
 	// metrics
@@ -194,16 +196,41 @@ func (a *App) UpdateLedgerState() {
 	ledger.SetState(next)
 }
 
-// UpdateOperationFeeStatsState triggers a refresh of several operation fee metrics
+// AfterIngest refreshes the state derived from the history database once the
+// ingestion system ingested new ledgers.
+func (a *App) AfterIngest() {
+	a.UpdateOperationFeeStatsState()
+
+	if a.webhooks != nil {
+		err := a.webhooks.Notify(a.ctx)
+		if err != nil {
+			log.WithField("err", err.Error()).Error("failed to queue webhook notifications")
+		}
+	}
+}
+
+// defaultFeeStatsLedgers is the number of recent ledgers the operation fee
+// stats are computed over when not configured.
+const defaultFeeStatsLedgers = 5
+
+// UpdateOperationFeeStatsState triggers a refresh of several operation fee
+// metrics.  It is called on every tick and by the ingestion system once it
+// ingested new ledgers.
 func (a *App) UpdateOperationFeeStatsState() {
 	var err error
 	var next operationfeestats.State
 
 	var latest history.LatestLedger
 	var feeStats history.FeeStats
+	var maxFees []history.LedgerMaxFee
 
 	cur := operationfeestats.CurrentState()
 
+	ledgers := int32(a.config.FeeStatsLedgers)
+	if ledgers == 0 {
+		ledgers = defaultFeeStatsLedgers
+	}
+
 	err = a.HistoryQ().LatestLedgerBaseFeeAndSequence(&latest)
 	if err != nil {
 		goto Failed
@@ -217,19 +244,60 @@ func (a *App) UpdateOperationFeeStatsState() {
 	next.LastBaseFee = int64(latest.BaseFee)
 	next.LastLedger = int64(latest.Sequence)
 
-	err = a.HistoryQ().TransactionsForLastXLedgers(latest.Sequence, &feeStats)
+	err = a.HistoryQ().TransactionsForLastXLedgers(latest.Sequence, ledgers, &feeStats)
+	if err != nil {
+		goto Failed
+	}
+
+	err = a.HistoryQ().LedgerCapacityUsage(latest.Sequence, ledgers, &next.LedgerCapacityUsage)
+	if err != nil {
+		goto Failed
+	}
+
+	err = a.HistoryQ().MaxFeesForLastXLedgers(latest.Sequence, ledgers, &maxFees)
 	if err != nil {
 		goto Failed
 	}
 
+	for _, row := range maxFees {
+		next.LedgerMaxFees = append(next.LedgerMaxFees, operationfeestats.LedgerMaxFee{
+			Ledger: int64(row.Sequence),
+			MaxFee: row.MaxFee,
+		})
+	}
+
 	// if no transactions in last X ledgers, return
 	// latest ledger's base fee for all
 	if !feeStats.Mode.Valid && !feeStats.Min.Valid {
 		next.Min = next.LastBaseFee
 		next.Mode = next.LastBaseFee
+		next.Max = next.LastBaseFee
+		next.P10 = next.LastBaseFee
+		next.P20 = next.LastBaseFee
+		next.P30 = next.LastBaseFee
+		next.P40 = next.LastBaseFee
+		next.P50 = next.LastBaseFee
+		next.P60 = next.LastBaseFee
+		next.P70 = next.LastBaseFee
+		next.P80 = next.LastBaseFee
+		next.P90 = next.LastBaseFee
+		next.P95 = next.LastBaseFee
+		next.P99 = next.LastBaseFee
 	} else {
 		next.Min = feeStats.Min.Int64
 		next.Mode = feeStats.Mode.Int64
+		next.Max = feeStats.Max.Int64
+		next.P10 = feeStats.P10.Int64
+		next.P20 = feeStats.P20.Int64
+		next.P30 = feeStats.P30.Int64
+		next.P40 = feeStats.P40.Int64
+		next.P50 = feeStats.P50.Int64
+		next.P60 = feeStats.P60.Int64
+		next.P70 = feeStats.P70.Int64
+		next.P80 = feeStats.P80.Int64
+		next.P90 = feeStats.P90.Int64
+		next.P95 = feeStats.P95.Int64
+		next.P99 = feeStats.P99.Int64
 	}
 
 	operationfeestats.SetState(next)
@@ -316,6 +384,10 @@ func (a *App) Tick() {
 		go a.ingester.Tick()
 	}
 
+	if a.webhooks != nil {
+		go a.webhooks.Tick(a.ctx)
+	}
+
 	wg.Add(2)
 	go func() { a.reaper.Tick(); wg.Done() }()
 	go func() { a.submitter.Tick(a.ctx); wg.Done() }()
@@ -1,6 +1,7 @@
 package horizon
 
 import (
+	"net"
 	"net/url"
 	"time"
 
@@ -18,12 +19,36 @@ type Config struct {
 	ConnectionTimeout      time.Duration
 	RateLimit              *throttled.RateQuota
 	RateLimitRedisKey      string
-	RedisURL               string
-	FriendbotURL           *url.URL
-	LogLevel               logrus.Level
-	LogFile                string
+	// RateLimitTiers grants the clients identified by an API key or an IP
+	// network their own rate limits, see RateLimitTier.
+	RateLimitTiers []RateLimitTier
+	// TrustedProxies are the networks of the proxies whose X-Forwarded-For
+	// header is trusted to hold the address of the client.
+	TrustedProxies []*net.IPNet
+	RedisURL       string
+	// TxSubRedisKey, when set along with RedisURL, is the redis key under
+	// which open transaction submissions are shared with the other horizon
+	// instances of a cluster.
+	TxSubRedisKey string
+	// FeeBumpSeeds are the seeds of the server-owned accounts whose
+	// transactions rejected with txINSUFFICIENT_FEE are resubmitted with a
+	// higher fee, see txsub.FeeBumpPolicy.
+	FeeBumpSeeds []string
+	// FeeBumpMaxAttempts bounds the number of resubmissions of a transaction.
+	FeeBumpMaxAttempts uint
+	// FeeBumpMaxBaseFee caps the fee per operation of resubmissions, in
+	// stroops.  No cap is applied when 0.
+	FeeBumpMaxBaseFee uint
+	// FeeBumpBackoff is the time to wait before the first resubmission.
+	FeeBumpBackoff time.Duration
+	FriendbotURL   *url.URL
+	LogLevel       logrus.Level
+	LogFile        string
 	// MaxPathLength is the maximum length of the path returned by `/paths` endpoint.
-	MaxPathLength     uint
+	MaxPathLength uint
+	// PathFinder selects the path finding implementation used by `/paths`
+	// endpoint: "simple" (default) or "graph".
+	PathFinder        string
 	NetworkPassphrase string
 	SentryDSN         string
 	LogglyToken       string
@@ -48,6 +73,12 @@ type Config struct {
 	// out-of-date by before horizon begins to respond with an error to history
 	// requests.
 	StaleThreshold uint
+	// FeeStatsLedgers is the number of recent ledgers the operation fee stats
+	// are computed over.
+	FeeStatsLedgers uint
+	// AccountStateMaxLedgers is the number of ledgers in the past the state
+	// of an account may be requested at.
+	AccountStateMaxLedgers uint
 	// SkipCursorUpdate causes the ingestor to skip reporting the "last imported
 	// ledger" state to stellar-core.
 	SkipCursorUpdate bool
@@ -61,4 +92,15 @@ type Config struct {
 	// Enabling it has a negative impact on CPU when ingesting ledgers full of
 	// many different assets related operations.
 	EnableAssetStats bool
+	// EnableWebhooks toggles the webhook notifications of ingested effects and
+	// the `/admin/webhooks` endpoints managing their subscriptions.
+	EnableWebhooks bool
+	// WebhookAdminToken is the bearer token required by the `/admin/webhooks`
+	// endpoints.
+	WebhookAdminToken string
+	// EnableGraphQL toggles the `/graphql` endpoint.
+	EnableGraphQL bool
+	// GraphQLMaxCost is the number of pages of records a GraphQL query may load
+	// at most.  Each query is charged that many requests by the rate limiter.
+	GraphQLMaxCost uint
 }
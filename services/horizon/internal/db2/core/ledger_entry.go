@@ -0,0 +1,81 @@
+package core
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/guregu/null"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// NewAccount returns the row of the `accounts` table stellar-core stores
+// `entry` in.
+func NewAccount(entry xdr.AccountEntry) Account {
+	ret := Account{
+		Accountid:     entry.AccountId.Address(),
+		Balance:       entry.Balance,
+		Seqnum:        fmt.Sprintf("%d", entry.SeqNum),
+		Numsubentries: int32(entry.NumSubEntries),
+		HomeDomain:    null.StringFrom(string(entry.HomeDomain)),
+		Thresholds:    entry.Thresholds,
+		Flags:         xdr.AccountFlags(entry.Flags),
+	}
+
+	if entry.InflationDest != nil {
+		ret.Inflationdest = null.StringFrom(entry.InflationDest.Address())
+	}
+
+	if v1, ok := entry.Ext.GetV1(); ok {
+		ret.BuyingLiabilities = v1.Liabilities.Buying
+		ret.SellingLiabilities = v1.Liabilities.Selling
+	}
+
+	return ret
+}
+
+// NewSigners returns the rows of the `signers` table stellar-core stores the
+// signers of `entry` in.
+func NewSigners(entry xdr.AccountEntry) []Signer {
+	ret := make([]Signer, 0, len(entry.Signers))
+	for _, signer := range entry.Signers {
+		ret = append(ret, Signer{
+			Accountid: entry.AccountId.Address(),
+			Publickey: signer.Key.Address(),
+			Weight:    int32(signer.Weight),
+		})
+	}
+	return ret
+}
+
+// NewTrustline returns the row of the `trustlines` table stellar-core stores
+// `entry` in.
+func NewTrustline(entry xdr.TrustLineEntry) (Trustline, error) {
+	ret := Trustline{
+		Accountid: entry.AccountId.Address(),
+		Tlimit:    entry.Limit,
+		Balance:   entry.Balance,
+		Flags:     int32(entry.Flags),
+	}
+
+	err := entry.Asset.Extract(&ret.Assettype, &ret.Assetcode, &ret.Issuer)
+	if err != nil {
+		return ret, err
+	}
+
+	if v1, ok := entry.Ext.GetV1(); ok {
+		ret.BuyingLiabilities = v1.Liabilities.Buying
+		ret.SellingLiabilities = v1.Liabilities.Selling
+	}
+
+	return ret, nil
+}
+
+// NewAccountData returns the row of the `accountdata` table stellar-core
+// stores `entry` in.
+func NewAccountData(entry xdr.DataEntry) AccountData {
+	return AccountData{
+		Accountid: entry.AccountId.Address(),
+		Key:       string(entry.DataName),
+		Value:     base64.StdEncoding.EncodeToString(entry.DataValue),
+	}
+}
@@ -61,6 +61,14 @@ type Offer struct {
 	Lastmodified int32     `db:"lastmodified"`
 }
 
+// OffersFilter narrows down the offers loaded by Q.Offers. Empty fields are
+// ignored.
+type OffersFilter struct {
+	Seller  string
+	Selling *xdr.Asset
+	Buying  *xdr.Asset
+}
+
 // OrderBookSummaryPriceLevel is a collapsed view of multiple offers at the same price that
 // contains the summed amount from all the member offers. Used by OrderBookSummary
 type OrderBookSummaryPriceLevel struct {
@@ -15,6 +15,16 @@ func (r Offer) PagingToken() string {
 	return fmt.Sprintf("%d", r.OfferID)
 }
 
+// SellingAsset returns the asset the offer is selling.
+func (r Offer) SellingAsset() (xdr.Asset, error) {
+	return AssetFromDB(r.SellingAssetType, r.SellingAssetCode.String, r.SellingIssuer.String)
+}
+
+// BuyingAsset returns the asset the offer is buying.
+func (r Offer) BuyingAsset() (xdr.Asset, error) {
+	return AssetFromDB(r.BuyingAssetType, r.BuyingAssetCode.String, r.BuyingIssuer.String)
+}
+
 // PriceAsString return the price fraction as a floating point approximate.
 func (r Offer) PriceAsString() string {
 	return big.NewRat(int64(r.Pricen), int64(r.Priced)).FloatString(7)
@@ -24,6 +34,19 @@ func (r Offer) PriceAsString() string {
 // finding.  Given the input asset type, a list of xdr.Assets is returned that
 // each have some available trades for the input asset.
 func (q *Q) ConnectedAssets(dest interface{}, selling xdr.Asset) error {
+	return q.connectedAssets(dest, selling, "selling", "buying")
+}
+
+// ConnectedSellingAssets loads xdr.Asset records for the purposes of strict
+// send path finding.  Given the input asset type, a list of xdr.Assets is
+// returned that are sold by offers buying the input asset.
+func (q *Q) ConnectedSellingAssets(dest interface{}, buying xdr.Asset) error {
+	return q.connectedAssets(dest, buying, "buying", "selling")
+}
+
+// connectedAssets loads the distinct `result` side assets of the offers whose
+// `input` side asset is `asset`.
+func (q *Q) connectedAssets(dest interface{}, asset xdr.Asset, input, result string) error {
 
 	assets, ok := dest.(*[]xdr.Asset)
 	if !ok {
@@ -36,21 +59,21 @@ func (q *Q) ConnectedAssets(dest interface{}, selling xdr.Asset) error {
 		i string
 	)
 
-	err := selling.Extract(&t, &c, &i)
+	err := asset.Extract(&t, &c, &i)
 	if err != nil {
 		return err
 	}
 
 	sql := sq.Select(
-		"buyingassettype AS type",
-		"coalesce(buyingassetcode, '') AS code",
-		"coalesce(buyingissuer, '') AS issuer").
+		result+"assettype AS type",
+		"coalesce("+result+"assetcode, '') AS code",
+		"coalesce("+result+"issuer, '') AS issuer").
 		From("offers").
-		Where(sq.Eq{"sellingassettype": t}).
-		GroupBy("buyingassettype", "buyingassetcode", "buyingissuer")
+		Where(sq.Eq{input + "assettype": t}).
+		GroupBy(result+"assettype", result+"assetcode", result+"issuer")
 
 	if t != xdr.AssetTypeAssetTypeNative {
-		sql = sql.Where(sq.Eq{"sellingassetcode": c, "sellingissuer": i})
+		sql = sql.Where(sq.Eq{input + "assetcode": c, input + "issuer": i})
 	}
 
 	var rows []struct {
@@ -78,14 +101,48 @@ func (q *Q) ConnectedAssets(dest interface{}, selling xdr.Asset) error {
 	return nil
 }
 
+// OfferByID loads a row from `offers`, by offer id.
+func (q *Q) OfferByID(dest interface{}, id int64) error {
+	sql := sq.Select("co.*").
+		From("offers co").
+		Where("co.offerid = ?", id).
+		Limit(1)
+
+	return q.Get(dest, sql)
+}
+
 // OffersByAddress loads a page of active offers for the given
 // address.
 func (q *Q) OffersByAddress(dest interface{}, addy string, pq db2.PageQuery) error {
+	return q.Offers(dest, OffersFilter{Seller: addy}, pq)
+}
+
+// Offers loads a page of active offers matching the provided filter.
+func (q *Q) Offers(dest interface{}, filter OffersFilter, pq db2.PageQuery) error {
 	sql := sq.Select("co.*").
 		From("offers co").
-		Where("co.sellerid = ?", addy).
 		Limit(uint64(pq.Limit))
 
+	if filter.Seller != "" {
+		sql = sql.Where("co.sellerid = ?", filter.Seller)
+	}
+
+	if filter.Selling != nil {
+		eq, err := offerAssetEq("selling", *filter.Selling)
+		if err != nil {
+			return err
+		}
+		sql = sql.Where(eq)
+	}
+
+	if filter.Buying != nil {
+		eq, err := offerAssetEq("buying", *filter.Buying)
+		if err != nil {
+			return err
+		}
+		sql = sql.Where(eq)
+	}
+
 	cursor, err := pq.CursorInt64()
 	if err != nil {
 		return err
@@ -100,3 +157,38 @@ func (q *Q) OffersByAddress(dest interface{}, addy string, pq db2.PageQuery) err
 
 	return q.Select(dest, sql)
 }
+
+// AllOffers loads every active offer, ordered by price, for the purposes of
+// building an in-memory view of the order books.
+func (q *Q) AllOffers(dest interface{}) error {
+	sql := sq.Select("co.*").
+		From("offers co").
+		OrderBy("co.price asc", "co.offerid asc")
+
+	return q.Select(dest, sql)
+}
+
+// offerAssetEq returns the condition matching the offers whose `side`
+// ("selling" or "buying") asset is `asset`.
+func offerAssetEq(side string, asset xdr.Asset) (sq.Eq, error) {
+	var (
+		t xdr.AssetType
+		c string
+		i string
+	)
+
+	err := asset.Extract(&t, &c, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	if t == xdr.AssetTypeAssetTypeNative {
+		return sq.Eq{"co." + side + "assettype": t}, nil
+	}
+
+	return sq.Eq{
+		"co." + side + "assettype": t,
+		"co." + side + "assetcode": c,
+		"co." + side + "issuer":    i,
+	}, nil
+}
@@ -1,10 +1,12 @@
 package core
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/kinecosystem/go/xdr"
 )
 
 func TestOffersByAddress(t *testing.T) {
@@ -69,3 +71,86 @@ func TestOffersByAddress(t *testing.T) {
 		tt.Assert.Equal(int64(2), offers[0].OfferID)
 	}
 }
+
+func TestOfferByID(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	var offer Offer
+	err := q.OfferByID(&offer, 4)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(int64(4), offer.OfferID)
+		tt.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", offer.SellerID)
+	}
+
+	err = q.OfferByID(&offer, 100)
+	tt.Assert.Equal(sql.ErrNoRows, err)
+}
+
+func TestOffers(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	eur, err := AssetFromDB(xdr.AssetTypeAssetTypeCreditAlphanum4, "EUR", "GCQPYGH4K57XBDENKKX55KDTWOTK5WDWRQOH2LHEDX3EKVIQRLMESGBG")
+	tt.Require.NoError(err)
+	usd, err := AssetFromDB(xdr.AssetTypeAssetTypeCreditAlphanum4, "USD", "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	tt.Require.NoError(err)
+	native, err := AssetFromDB(xdr.AssetTypeAssetTypeNative, "", "")
+	tt.Require.NoError(err)
+
+	var offers []Offer
+
+	load := func(filter OffersFilter) bool {
+		offers = []Offer{}
+		pq, err := db2.NewPageQuery("", true, "asc", db2.DefaultPageSize)
+		if !tt.Assert.NoError(err) {
+			return false
+		}
+
+		err = q.Offers(&offers, filter, pq)
+		if !tt.Assert.NoError(err) {
+			return false
+		}
+		return true
+	}
+
+	// no filter returns every offer
+	if load(OffersFilter{}) {
+		tt.Assert.Len(offers, 4)
+	}
+
+	if load(OffersFilter{Selling: &eur}) {
+		tt.Assert.Len(offers, 3)
+	}
+
+	// works for native assets
+	if load(OffersFilter{Buying: &native}) {
+		tt.Assert.Len(offers, 1)
+		tt.Assert.Equal(int64(4), offers[0].OfferID)
+	}
+
+	if load(OffersFilter{Selling: &usd, Buying: &eur}) {
+		tt.Assert.Len(offers, 0)
+	}
+
+	if load(OffersFilter{Seller: "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2", Buying: &usd}) {
+		tt.Assert.Len(offers, 3)
+	}
+}
+
+func TestAllOffers(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	var offers []Offer
+	err := q.AllOffers(&offers)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(offers, 4)
+		for i := 1; i < len(offers); i++ {
+			tt.Assert.True(offers[i-1].Price <= offers[i].Price, "Results are not in order")
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package history
 
 import (
+	"sort"
+
 	sq "github.com/Masterminds/squirrel"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/xdr"
@@ -56,13 +58,19 @@ func (q *Q) AccountsByAddresses(dest interface{}, addresses []string) error {
 }
 
 // CreateAccounts creates rows for addresses in history_accounts table and
-// put
+// puts them into dest. Addresses inserted concurrently by another session
+// (e.g. a parallel reingestion chunk) are skipped and not put into dest.
+// Addresses are inserted in sorted order so that concurrent sessions lock
+// them in the same order and can't deadlock.
 func (q *Q) CreateAccounts(dest interface{}, addresses []string) error {
+	sorted := append([]string(nil), addresses...)
+	sort.Strings(sorted)
+
 	sql := sq.Insert("history_accounts").Columns("address")
-	for _, address := range addresses {
+	for _, address := range sorted {
 		sql = sql.Values(address)
 	}
-	sql = sql.Suffix("RETURNING *")
+	sql = sql.Suffix("ON CONFLICT (address) DO NOTHING RETURNING *")
 
 	return q.Select(dest, sql)
 }
@@ -90,10 +98,17 @@ func (q *Q) GetCreateAccountID(
 	//insert account and return id
 	err = q.GetRaw(
 		&result,
-		`INSERT INTO history_accounts (address) VALUES (?) RETURNING id`,
+		`INSERT INTO history_accounts (address) VALUES (?)
+		ON CONFLICT (address) DO NOTHING RETURNING id`,
 		aid.Address(),
 	)
 
+	// account was inserted concurrently by another session, load its id
+	if q.NoRows(err) {
+		err = q.AccountByAddress(&existing, aid.Address())
+		result = existing.ID
+	}
+
 	return
 }
 
@@ -79,8 +79,14 @@ func (q *Q) GetCreateAssetID(
 	}
 
 	err = q.GetRaw(&result,
-		`INSERT INTO history_assets (asset_type, asset_code, asset_issuer) VALUES (?,?,?) RETURNING id`,
+		`INSERT INTO history_assets (asset_type, asset_code, asset_issuer) VALUES (?,?,?)
+		ON CONFLICT (asset_type, asset_code, asset_issuer) DO NOTHING RETURNING id`,
 		assetType, assetCode, assetIssuer)
 
+	// asset was inserted concurrently by another session, load its id
+	if q.NoRows(err) {
+		result, err = q.GetAssetID(asset)
+	}
+
 	return
 }
@@ -0,0 +1,219 @@
+package history
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kinecosystem/go/amount"
+	"github.com/kinecosystem/go/services/horizon/internal/db2"
+	"github.com/kinecosystem/go/support/errors"
+	strtime "github.com/kinecosystem/go/support/time"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// BalanceHistory represents the balance of an account in an asset at the end
+// of a time bucket, and the changes of that balance during the bucket.
+type BalanceHistory struct {
+	Timestamp   int64 `db:"timestamp"`
+	ChangeCount int64 `db:"count"`
+	Credited    int64 `db:"credited"`
+	Debited     int64 `db:"debited"`
+	Balance     int64 `db:"balance"`
+}
+
+// BalanceHistoryQ is a helper struct to aid in configuring queries to bucket
+// the changes of the balance of an account, as recorded by its effects and the
+// fees it paid.
+type BalanceHistoryQ struct {
+	accountID    int64
+	address      string
+	asset        xdr.Asset
+	balance      int64
+	resolution   int64
+	offset       int64
+	startTime    strtime.Millis
+	endTime      strtime.Millis
+	pagingParams db2.PageQuery
+}
+
+// GetBalanceHistoryQ initializes a BalanceHistoryQ query builder based on the
+// required parameters.  `balance` is the current balance of the account, from
+// which the balances of the previous buckets are derived.
+func (q Q) GetBalanceHistoryQ(account Account, asset xdr.Asset, balance int64,
+	resolution int64, offset int64, pagingParams db2.PageQuery) (*BalanceHistoryQ, error) {
+
+	err := validateBuckets(resolution, offset)
+	if err != nil {
+		return &BalanceHistoryQ{}, err
+	}
+
+	return &BalanceHistoryQ{
+		accountID:    account.ID,
+		address:      account.Address,
+		asset:        asset,
+		balance:      balance,
+		resolution:   resolution,
+		offset:       offset,
+		pagingParams: pagingParams,
+	}, nil
+}
+
+// WithStartTime adds an optional lower time boundary filter to the buckets.
+func (q *BalanceHistoryQ) WithStartTime(startTime strtime.Millis) (*BalanceHistoryQ, error) {
+	adjustedStartTime := adjustStartTime(startTime, q.resolution, q.offset)
+	if !q.endTime.IsNil() && adjustedStartTime > q.endTime {
+		return &BalanceHistoryQ{}, errors.New("start time is not allowed")
+	}
+	q.startTime = adjustedStartTime
+	return q, nil
+}
+
+// WithEndTime adds an optional upper time boundary filter to the buckets.
+func (q *BalanceHistoryQ) WithEndTime(endTime strtime.Millis) (*BalanceHistoryQ, error) {
+	adjustedEndTime, err := adjustEndTime(endTime, q.resolution, q.offset)
+	if err != nil {
+		return &BalanceHistoryQ{}, err
+	}
+	if adjustedEndTime < q.startTime {
+		return &BalanceHistoryQ{}, errors.New("end time is not allowed")
+	}
+	q.endTime = adjustedEndTime
+	return q, nil
+}
+
+// GetSql generates a sql statement to bucket the balance changes based on
+// given parameters.  The balance at the end of each bucket is the current
+// balance, minus the changes of the following buckets.
+func (q *BalanceHistoryQ) GetSql() (sq.SelectBuilder, error) {
+	changes, err := q.changes()
+	if err != nil {
+		return sq.SelectBuilder{}, err
+	}
+
+	bucketSQL := sq.Select(
+		formatBucketTimestampSelect(q.resolution, q.offset),
+		"amount",
+	).
+		FromSelect(changes, "changes").
+		Where(sq.GtOrEq{"ledger_closed_at": q.startTime.ToTime()})
+
+	balanceSQL := sq.Select(
+		"timestamp",
+		"count(*) as count",
+		"sum(greatest(amount, 0))::bigint as credited",
+		"(-sum(least(amount, 0)))::bigint as debited",
+		fmt.Sprintf("(%d - coalesce(sum(sum(amount)) OVER ("+
+			"ORDER BY timestamp DESC ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING"+
+			"), 0))::bigint as balance", q.balance),
+	).
+		FromSelect(bucketSQL, "buckets").
+		GroupBy("timestamp")
+
+	sql := sq.Select("timestamp", "count", "credited", "debited", "balance").
+		FromSelect(balanceSQL, "balances")
+	if !q.endTime.IsNil() {
+		sql = sql.Where(sq.Lt{"timestamp": q.endTime.ToInt64()})
+	}
+
+	return sql.
+		Limit(q.pagingParams.Limit).
+		OrderBy("timestamp " + q.pagingParams.Order), nil
+}
+
+// changes generates a sql statement selecting every change of the balance,
+// signed, along with the close time of its ledger.
+func (q *BalanceHistoryQ) changes() (sq.SelectBuilder, error) {
+	asset, err := effectAssetFilter("", q.asset)
+	if err != nil {
+		return sq.SelectBuilder{}, err
+	}
+	bought, err := effectAssetFilter("bought_", q.asset)
+	if err != nil {
+		return sq.SelectBuilder{}, err
+	}
+	sold, err := effectAssetFilter("sold_", q.asset)
+	if err != nil {
+		return sq.SelectBuilder{}, err
+	}
+
+	parts := []sq.SelectBuilder{
+		q.effectChanges("amount", sq.And{sq.Eq{"heff.type": EffectAccountCredited}, asset}),
+		q.effectChanges("-amount", sq.And{sq.Eq{"heff.type": EffectAccountDebited}, asset}),
+		q.tradeChanges("bought_amount", bought),
+		q.tradeChanges("-sold_amount", sold),
+	}
+
+	if q.asset.Type == xdr.AssetTypeAssetTypeNative {
+		parts = append(parts,
+			q.effectChanges("starting_balance", sq.Eq{"heff.type": EffectAccountCreated}),
+			sq.Select("hl.closed_at as ledger_closed_at", "-ht.fee_paid as amount").
+				From("history_transactions ht").
+				Join("history_ledgers hl ON hl.sequence = ht.ledger_sequence").
+				Where(sq.Eq{"ht.account": q.address}),
+		)
+	}
+
+	// the changes are the union of all the parts
+	sql := parts[0]
+	for _, part := range parts[1:] {
+		partSQL, args, err := part.ToSql()
+		if err != nil {
+			return sq.SelectBuilder{}, err
+		}
+		sql = sql.Suffix("UNION ALL "+partSQL, args...)
+	}
+	return sql, nil
+}
+
+// effectChanges generates a sql statement selecting the changes recorded by
+// the effects of the account matching `where`.  `field` names the amount of
+// the change in the details of the effects, negated when prefixed with "-".
+func (q *BalanceHistoryQ) effectChanges(field string, where sq.Sqlizer) sq.SelectBuilder {
+	sign := ""
+	if field[0] == '-' {
+		sign, field = "-", field[1:]
+	}
+
+	return sq.Select(
+		"hl.closed_at as ledger_closed_at",
+		fmt.Sprintf("%sround((heff.details->>'%s')::numeric * %d) as amount", sign, field, amount.One),
+	).
+		From("history_effects heff").
+		Join("history_ledgers hl ON hl.sequence = heff.history_operation_id >> 32").
+		Where(sq.Eq{"heff.history_account_id": q.accountID}).
+		Where(where)
+}
+
+// tradeChanges generates a sql statement selecting the changes recorded by the
+// trade effects of the account matching `where`.  The trades of the path
+// payments sent by the account are left out: the account_debited and
+// account_credited effects of the payment already record them.
+func (q *BalanceHistoryQ) tradeChanges(field string, where sq.Sqlizer) sq.SelectBuilder {
+	return q.effectChanges(field, sq.And{sq.Eq{"heff.type": EffectTrade}, where}).
+		Join("history_operations hop ON hop.id = heff.history_operation_id").
+		Where(sq.Or{
+			sq.NotEq{"hop.type": xdr.OperationTypePathPayment},
+			sq.NotEq{"hop.source_account": q.address},
+		})
+}
+
+// effectAssetFilter returns a filter matching the effects whose details
+// describe `asset` in the fields prefixed by `prefix`.
+func effectAssetFilter(prefix string, asset xdr.Asset) (sq.Eq, error) {
+	var typ, code, issuer string
+	err := asset.Extract(&typ, &code, &issuer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to extract asset")
+	}
+
+	field := func(name string) string {
+		return fmt.Sprintf("heff.details->>'%s%s'", prefix, name)
+	}
+
+	filter := sq.Eq{field("asset_type"): typ}
+	if asset.Type != xdr.AssetTypeAssetTypeNative {
+		filter[field("asset_code")] = code
+		filter[field("asset_issuer")] = issuer
+	}
+	return filter, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/services/horizon/internal/toid"
 	"github.com/kinecosystem/go/support/errors"
+	strtime "github.com/kinecosystem/go/support/time"
 	"github.com/kinecosystem/go/xdr"
 )
 
@@ -60,6 +61,23 @@ func (q *EffectsQ) ForAccount(aid string) *EffectsQ {
 	return q
 }
 
+// ForAsset filters the query to only effects of operations involving the
+// provided asset.
+func (q *EffectsQ) ForAsset(asset xdr.Asset) *EffectsQ {
+	var assetID int64
+	assetID, q.Err = q.parent.GetAssetID(asset)
+	if q.Err != nil {
+		return q
+	}
+
+	q.sql = q.sql.Join(
+		"history_operation_assets hoa ON "+
+			"hoa.history_operation_id = heff.history_operation_id",
+	).Where("hoa.history_asset_id = ?", assetID)
+
+	return q
+}
+
 // ForLedger filters the query to only effects in a specific ledger,
 // specified by its sequence.
 func (q *EffectsQ) ForLedger(seq int32) *EffectsQ {
@@ -131,6 +149,27 @@ func (q *EffectsQ) ForTransaction(hash string) *EffectsQ {
 	return q
 }
 
+// ForTimeRange filters the query to only effects in the ledgers closed within
+// [start, end).  Unset bounds are ignored.
+func (q *EffectsQ) ForTimeRange(start, end strtime.Millis) *EffectsQ {
+	if q.Err != nil {
+		return q
+	}
+
+	from, to, err := q.parent.TimeRange(start, end)
+	if err != nil {
+		q.Err = err
+		return q
+	}
+
+	q.sql = q.sql.Where(
+		"heff.history_operation_id >= ? AND heff.history_operation_id < ?",
+		from,
+		to,
+	)
+	return q
+}
+
 // OfType filters the query to only effects of the given type.
 func (q *EffectsQ) OfType(typ EffectType) *EffectsQ {
 	q.sql = q.sql.Where("heff.type = ?", typ)
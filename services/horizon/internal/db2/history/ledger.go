@@ -2,10 +2,13 @@ package history
 
 import (
 	"fmt"
+	"math"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
+	"github.com/kinecosystem/go/services/horizon/internal/toid"
 	"github.com/kinecosystem/go/support/errors"
+	strtime "github.com/kinecosystem/go/support/time"
 )
 
 // LedgerBySequence loads the single ledger at `seq` into `dest`
@@ -17,6 +20,41 @@ func (q *Q) LedgerBySequence(dest interface{}, seq int32) error {
 	return q.Get(dest, sql)
 }
 
+// TimeRange returns the range [from, to) of the ids of the history records
+// of the ledgers closed within [start, end).  An unset bound leaves the range
+// open on its side.
+func (q *Q) TimeRange(start, end strtime.Millis) (from int64, to int64, err error) {
+	from, to = 0, math.MaxInt64
+
+	if !start.IsNil() {
+		var seq int32
+		err = q.GetRaw(&seq, `
+			SELECT COALESCE(MIN(sequence), (SELECT COALESCE(MAX(sequence), 0) + 1 FROM history_ledgers))
+			FROM history_ledgers
+			WHERE closed_at >= $1
+		`, start.ToTime())
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "failed to load first ledger of time range")
+		}
+		from = toid.New(seq, 0, 0).ToInt64()
+	}
+
+	if !end.IsNil() {
+		var seq int32
+		err = q.GetRaw(&seq, `
+			SELECT COALESCE(MAX(sequence), 0)
+			FROM history_ledgers
+			WHERE closed_at < $1
+		`, end.ToTime())
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "failed to load last ledger of time range")
+		}
+		to = toid.New(seq+1, 0, 0).ToInt64()
+	}
+
+	return from, to, nil
+}
+
 // Ledgers provides a helper to filter rows from the `history_ledgers` table
 // with pre-defined filters.  See `LedgersQ` methods for the available filters.
 func (q *Q) Ledgers() *LedgersQ {
@@ -26,6 +64,17 @@ func (q *Q) Ledgers() *LedgersQ {
 	}
 }
 
+// LedgersFromArchive loads into `dest` whether any of the ledgers from `first`
+// to `last`, inclusive, was loaded from a history archive, without meta.
+func (q *Q) LedgersFromArchive(dest *bool, first int32, last int32) error {
+	return q.GetRaw(dest, `
+		SELECT EXISTS (
+			SELECT 1 FROM history_ledgers
+			WHERE sequence BETWEEN ? AND ? AND from_archive
+		)
+	`, first, last)
+}
+
 // LedgersBySequence loads the a set of ledgers identified by the sequences
 // `seqs` into `dest`.
 func (q *Q) LedgersBySequence(dest interface{}, seqs ...int32) error {
@@ -84,4 +133,5 @@ var selectLedger = sq.Select(
 	"hl.max_tx_set_size",
 	"hl.protocol_version",
 	"hl.ledger_header",
+	"hl.from_archive",
 ).From("history_ledgers hl")
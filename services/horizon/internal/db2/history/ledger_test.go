@@ -2,9 +2,12 @@ package history
 
 import (
 	"database/sql"
+	"math"
 	"testing"
 
 	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/kinecosystem/go/services/horizon/internal/toid"
+	strtime "github.com/kinecosystem/go/support/time"
 )
 
 func TestLedgerQueries(t *testing.T) {
@@ -44,3 +47,28 @@ func TestLedgerQueries(t *testing.T) {
 		tt.Assert.Contains(foundSeqs, int32(3))
 	}
 }
+
+func TestTimeRange(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+	q := &Q{tt.HorizonSession()}
+
+	// ledger 2 closed at 1548955673000, ledger 3 a second later
+	from, to, err := q.TimeRange(strtime.MillisFromInt64(1548955673500), strtime.Millis(0))
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(toid.New(3, 0, 0).ToInt64(), from)
+		tt.Assert.Equal(int64(math.MaxInt64), to)
+	}
+
+	from, to, err = q.TimeRange(strtime.MillisFromInt64(1548955673000), strtime.MillisFromInt64(1548955674000))
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(toid.New(2, 0, 0).ToInt64(), from)
+		tt.Assert.Equal(toid.New(3, 0, 0).ToInt64(), to)
+	}
+
+	// no ledger closed after the start time
+	from, _, err = q.TimeRange(strtime.MillisFromInt64(1548955675000), strtime.Millis(0))
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(toid.New(4, 0, 0).ToInt64(), from)
+	}
+}
@@ -159,11 +159,29 @@ type EffectsQ struct {
 // `history_effects` table.
 type EffectType int
 
-// FeeStats is a row of data from the min, mode aggregate functions over the
-// `history_ledgers` table.
+// FeeStats is a row of data from the min, mode, max and percentile aggregate
+// functions over the `history_transactions` table.
 type FeeStats struct {
 	Min  null.Int `db:"min"`
 	Mode null.Int `db:"mode"`
+	Max  null.Int `db:"max"`
+	P10  null.Int `db:"p10"`
+	P20  null.Int `db:"p20"`
+	P30  null.Int `db:"p30"`
+	P40  null.Int `db:"p40"`
+	P50  null.Int `db:"p50"`
+	P60  null.Int `db:"p60"`
+	P70  null.Int `db:"p70"`
+	P80  null.Int `db:"p80"`
+	P90  null.Int `db:"p90"`
+	P95  null.Int `db:"p95"`
+	P99  null.Int `db:"p99"`
+}
+
+// LedgerMaxFee is a row of data from the raw MaxFeesForLastXLedgers query.
+type LedgerMaxFee struct {
+	Sequence int32 `db:"sequence"`
+	MaxFee   int64 `db:"max_fee"`
 }
 
 // LatestLedger represents a response from the raw LatestLedgerBaseFeeAndSequence
@@ -194,6 +212,9 @@ type Ledger struct {
 	MaxTxSetSize               int32       `db:"max_tx_set_size"`
 	ProtocolVersion            int32       `db:"protocol_version"`
 	LedgerHeaderXDR            null.String `db:"ledger_header"`
+	// FromArchive is true for the ledgers loaded from a history archive,
+	// whose transactions have no meta.
+	FromArchive bool `db:"from_archive"`
 }
 
 // LedgerCache is a helper struct to load ledger data related to a batch of
@@ -222,6 +243,13 @@ type Operation struct {
 	Type             xdr.OperationType `db:"type"`
 	DetailsString    null.String       `db:"details"`
 	SourceAccount    string            `db:"source_account"`
+	// TransactionSuccessful is nil for the operations ingested before the
+	// operations of failed transactions were.
+	TransactionSuccessful *bool `db:"transaction_successful"`
+	// TransactionMemoType and TransactionMemo are the memo of the transaction
+	// of the operation.
+	TransactionMemoType string      `db:"transaction_memo_type"`
+	TransactionMemo     null.String `db:"transaction_memo"`
 }
 
 // OperationsQ is a helper struct to aid in configuring queries that loads
@@ -231,6 +259,8 @@ type OperationsQ struct {
 	parent  *Q
 	sql     sq.SelectBuilder
 	opIdCol string
+
+	includeFailed bool
 }
 
 // Q is a helper struct on which to hang common_trades queries against a history
@@ -290,8 +320,8 @@ type Transaction struct {
 	OperationCount   int32       `db:"operation_count"`
 	TxEnvelope       string      `db:"tx_envelope"`
 	TxResult         string      `db:"tx_result"`
-	TxMeta           string      `db:"tx_meta"`
-	TxFeeMeta        string      `db:"tx_fee_meta"`
+	TxMeta           null.String `db:"tx_meta"`
+	TxFeeMeta        null.String `db:"tx_fee_meta"`
 	SignatureString  string      `db:"signatures"`
 	MemoType         string      `db:"memo_type"`
 	Memo             null.String `db:"memo"`
@@ -299,6 +329,9 @@ type Transaction struct {
 	ValidBefore      null.Int    `db:"valid_before"`
 	CreatedAt        time.Time   `db:"created_at"`
 	UpdatedAt        time.Time   `db:"updated_at"`
+	// Successful is nil for the transactions ingested before failed
+	// transactions were, which are all successful.
+	Successful *bool `db:"successful"`
 }
 
 // TransactionsQ is a helper struct to aid in configuring queries that loads
@@ -307,6 +340,8 @@ type TransactionsQ struct {
 	Err    error
 	parent *Q
 	sql    sq.SelectBuilder
+
+	includeFailed bool
 }
 
 // ElderLedger loads the oldest ledger known to the history database
@@ -319,6 +354,20 @@ func (q *Q) LatestLedger(dest interface{}) error {
 	return q.GetRaw(dest, `SELECT COALESCE(MAX(sequence), 0) FROM history_ledgers`)
 }
 
+// LedgerCapacityUsage loads the share of the maximum transaction set size used
+// by the last `ledgers` ledgers, up to and including `currentSeq`, rounded to
+// two decimals.  The transaction sets include the failed transactions, which
+// `transaction_count` leaves out.
+func (q *Q) LedgerCapacityUsage(currentSeq int32, ledgers int32, dest interface{}) error {
+	return q.GetRaw(dest, `
+		SELECT ROUND(COALESCE(
+			SUM(transaction_count + COALESCE(failed_transaction_count, 0))::numeric / NULLIF(SUM(max_tx_set_size), 0),
+			0), 2)
+		FROM history_ledgers
+		WHERE sequence > $1 AND sequence <= $2
+	`, currentSeq-ledgers, currentSeq)
+}
+
 // LatestLedgerBaseFeeAndSequence loads the latest known ledger's base fee and
 // sequence number.
 func (q *Q) LatestLedgerBaseFeeAndSequence(dest interface{}) error {
@@ -339,3 +388,14 @@ func (q *Q) OldestOutdatedLedgers(dest interface{}, currentVersion int) error {
 		ORDER BY sequence ASC
 		LIMIT 1000000`, currentVersion)
 }
+
+// IngestedLedgerCount populates `dest` with the number of ledgers from `first`
+// to `last`, inclusive, that were ingested with the `currentVersion` of the
+// ingestion algorithm.
+func (q *Q) IngestedLedgerCount(dest interface{}, first, last int32, currentVersion int) error {
+	return q.GetRaw(dest, `
+		SELECT COUNT(*)
+		FROM history_ledgers
+		WHERE sequence BETWEEN $1 AND $2
+		AND importer_version = $3`, first, last, currentVersion)
+}
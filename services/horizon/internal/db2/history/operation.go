@@ -7,6 +7,7 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/services/horizon/internal/toid"
+	strtime "github.com/kinecosystem/go/support/time"
 	"github.com/kinecosystem/go/xdr"
 )
 
@@ -16,6 +17,12 @@ func (r *Operation) LedgerSequence() int32 {
 	return id.LedgerSequence
 }
 
+// IsTransactionSuccessful returns true when the transaction of the operation
+// was successfully applied.
+func (r *Operation) IsTransactionSuccessful() bool {
+	return r.TransactionSuccessful == nil || *r.TransactionSuccessful
+}
+
 // UnmarshalDetails unmarshals the details of this operation into `dest`
 func (r *Operation) UnmarshalDetails(dest interface{}) error {
 	if !r.DetailsString.Valid {
@@ -68,6 +75,25 @@ func (q *OperationsQ) ForAccount(aid string) *OperationsQ {
 	return q
 }
 
+// ForAsset filters the operations collection to a specific asset
+func (q *OperationsQ) ForAsset(asset xdr.Asset) *OperationsQ {
+	var assetID int64
+	assetID, q.Err = q.parent.GetAssetID(asset)
+	if q.Err != nil {
+		return q
+	}
+
+	q.sql = q.sql.Join(
+		"history_operation_assets hoa ON "+
+			"hoa.history_operation_id = hop.id",
+	).Where("hoa.history_asset_id = ?", assetID)
+
+	// in order to use history_operation_assets.hoa_by_asset index
+	q.opIdCol = "hoa.history_operation_id"
+
+	return q
+}
+
 // ForLedger filters the query to a only operations in a specific ledger,
 // specified by its sequence.
 func (q *OperationsQ) ForLedger(seq int32) *OperationsQ {
@@ -109,6 +135,37 @@ func (q *OperationsQ) ForTransaction(hash string) *OperationsQ {
 	return q
 }
 
+// ForTimeRange filters the query to only operations in the ledgers closed
+// within [start, end).  Unset bounds are ignored.
+func (q *OperationsQ) ForTimeRange(start, end strtime.Millis) *OperationsQ {
+	if q.Err != nil {
+		return q
+	}
+
+	from, to, err := q.parent.TimeRange(start, end)
+	if err != nil {
+		q.Err = err
+		return q
+	}
+
+	q.sql = q.sql.Where(q.opIdCol+" >= ? AND "+q.opIdCol+" < ?", from, to)
+	return q
+}
+
+// ForMemo filters the query to only operations of transactions with the memo
+// of type `memoType`, and of value `memo` when it isn't empty.
+func (q *OperationsQ) ForMemo(memoType string, memo string) *OperationsQ {
+	if q.Err != nil {
+		return q
+	}
+
+	q.sql = q.sql.Where("ht.memo_type = ?", memoType)
+	if memo != "" {
+		q.sql = q.sql.Where("ht.memo = ?", memo)
+	}
+	return q
+}
+
 // OnlyPayments filters the query being built to only include operations that
 // are in the "payment" class of operations:  CreateAccountOps, Payments, and
 // PathPayments.
@@ -122,6 +179,13 @@ func (q *OperationsQ) OnlyPayments() *OperationsQ {
 	return q
 }
 
+// IncludeFailed includes the operations of failed transactions in the query
+// being built, which are excluded by default.
+func (q *OperationsQ) IncludeFailed() *OperationsQ {
+	q.includeFailed = true
+	return q
+}
+
 // Page specifies the paging constraints for the query being built by `q`.
 func (q *OperationsQ) Page(page db2.PageQuery) *OperationsQ {
 	if q.Err != nil {
@@ -138,6 +202,10 @@ func (q *OperationsQ) Select(dest interface{}) error {
 		return q.Err
 	}
 
+	if !q.includeFailed {
+		q.sql = q.sql.Where(successfulTransactions)
+	}
+
 	q.Err = q.parent.Select(dest, q.sql)
 	return q.Err
 }
@@ -149,6 +217,9 @@ var selectOperation = sq.Select(
 		"hop.type, " +
 		"hop.details, " +
 		"hop.source_account, " +
-		"ht.transaction_hash").
+		"ht.transaction_hash, " +
+		"ht.successful AS transaction_successful, " +
+		"ht.memo_type AS transaction_memo_type, " +
+		"ht.memo AS transaction_memo").
 	From("history_operations hop").
 	LeftJoin("history_transactions ht ON ht.id = hop.transaction_id")
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/kinecosystem/go/xdr"
 )
 
 func TestOperationQueries(t *testing.T) {
@@ -60,6 +61,48 @@ func TestOperationQueries(t *testing.T) {
 	}
 }
 
+func TestOperationsForAsset(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+	q := &Q{tt.HorizonSession()}
+
+	native, err := q.GetCreateAssetID(xdr.MustNewNativeAsset())
+	tt.Require.NoError(err)
+	_, err = q.ExecRaw(
+		`INSERT INTO history_operation_assets (history_operation_id, history_asset_id) VALUES (?, ?), (?, ?)`,
+		8589938689, native, 12884905985, native,
+	)
+	tt.Require.NoError(err)
+
+	var ops []Operation
+	err = q.Operations().
+		ForAsset(xdr.MustNewNativeAsset()).
+		Page(db2.MustPageQuery("", false, "asc", 10)).
+		Select(&ops)
+	if tt.Assert.NoError(err) && tt.Assert.Len(ops, 2) {
+		tt.Assert.Equal(int64(8589938689), ops[0].ID)
+		tt.Assert.Equal(int64(12884905985), ops[1].ID)
+	}
+
+	var effects []Effect
+	err = q.Effects().
+		ForAsset(xdr.MustNewNativeAsset()).
+		Page(db2.MustPageQuery("", false, "asc", 20)).
+		Select(&effects)
+	if tt.Assert.NoError(err) && tt.Assert.NotEmpty(effects) {
+		for _, effect := range effects {
+			opid := effect.HistoryOperationID
+			tt.Assert.True(opid == 8589938689 || opid == 12884905985)
+		}
+	}
+
+	// assets that were never seen aren't found
+	err = q.Operations().
+		ForAsset(xdr.MustNewCreditAsset("USD", "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON")).
+		Select(&ops)
+	tt.Assert.True(q.NoRows(err))
+}
+
 func TestOperationQueryBuilder(t *testing.T) {
 	tt := test.Start(t).Scenario("base")
 	defer tt.Finish()
@@ -71,7 +114,7 @@ func TestOperationQueryBuilder(t *testing.T) {
 	tt.Assert.NoError(err)
 
 	// Operations for account queries will use hopp.history_operation_id in their predicates.
-	want := "SELECT hop.id, hop.transaction_id, hop.application_order, hop.type, hop.details, hop.source_account, ht.transaction_hash FROM history_operations hop LEFT JOIN history_transactions ht ON ht.id = hop.transaction_id JOIN history_operation_participants hopp ON hopp.history_operation_id = hop.id WHERE hopp.history_account_id = ? AND hopp.history_operation_id > ? ORDER BY hopp.history_operation_id asc LIMIT 10"
+	want := "SELECT hop.id, hop.transaction_id, hop.application_order, hop.type, hop.details, hop.source_account, ht.transaction_hash, ht.successful AS transaction_successful, ht.memo_type AS transaction_memo_type, ht.memo AS transaction_memo FROM history_operations hop LEFT JOIN history_transactions ht ON ht.id = hop.transaction_id JOIN history_operation_participants hopp ON hopp.history_operation_id = hop.id WHERE hopp.history_account_id = ? AND hopp.history_operation_id > ? ORDER BY hopp.history_operation_id asc LIMIT 10"
 	tt.Assert.EqualValues(want, got)
 
 	opsQ = q.Operations().ForLedger(2).Page(db2.PageQuery{Cursor: "8589938689", Order: "asc", Limit: 10})
@@ -80,6 +123,6 @@ func TestOperationQueryBuilder(t *testing.T) {
 	tt.Assert.NoError(err)
 
 	// Other operation queries will use hop.id in their predicates.
-	want = "SELECT hop.id, hop.transaction_id, hop.application_order, hop.type, hop.details, hop.source_account, ht.transaction_hash FROM history_operations hop LEFT JOIN history_transactions ht ON ht.id = hop.transaction_id WHERE hop.id >= ? AND hop.id < ? AND hop.id > ? ORDER BY hop.id asc LIMIT 10"
+	want = "SELECT hop.id, hop.transaction_id, hop.application_order, hop.type, hop.details, hop.source_account, ht.transaction_hash, ht.successful AS transaction_successful, ht.memo_type AS transaction_memo_type, ht.memo AS transaction_memo FROM history_operations hop LEFT JOIN history_transactions ht ON ht.id = hop.transaction_id WHERE hop.id >= ? AND hop.id < ? AND hop.id > ? ORDER BY hop.id asc LIMIT 10"
 	tt.Assert.EqualValues(want, got)
 }
@@ -5,6 +5,7 @@ import (
 	"math"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/guregu/null"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/support/errors"
 	"github.com/kinecosystem/go/support/time"
@@ -60,6 +61,19 @@ func (q *TradesQ) ForOffer(id int64) *TradesQ {
 	return q
 }
 
+// ForAsset filters the query results to trades on either side of which the
+// provided asset was exchanged.
+func (q *TradesQ) ForAsset(asset xdr.Asset) *TradesQ {
+	var assetID int64
+	assetID, q.Err = q.parent.GetAssetID(asset)
+	if q.Err != nil {
+		return q
+	}
+
+	q.sql = q.sql.Where("(htrd.base_asset_id = ? OR htrd.counter_asset_id = ?)", assetID, assetID)
+	return q
+}
+
 //Filter by asset pair. This function is private to ensure that correct order and proper select statement are coupled
 func (q *TradesQ) forAssetPair(baseAssetId int64, counterAssetId int64) *TradesQ {
 	q.sql = q.sql.Where(sq.Eq{"base_asset_id": baseAssetId, "counter_asset_id": counterAssetId})
@@ -210,7 +224,8 @@ var tradesInsert = sq.Insert("history_trades").Columns(
 	"price_d",
 )
 
-// Trade records a trade into the history_trades table
+// Trade records a trade into the history_trades table.  The price of the trade
+// is left NULL when `sellPrice` is nil.
 func (q *Q) InsertTrade(
 	opid int64,
 	order int32,
@@ -218,7 +233,7 @@ func (q *Q) InsertTrade(
 	buyOfferExists bool,
 	buyOffer xdr.OfferEntry,
 	trade xdr.ClaimOfferAtom,
-	sellPrice xdr.Price,
+	sellPrice *xdr.Price,
 	ledgerClosedAt time.Millis,
 ) error {
 	sellerAccountId, err := q.GetCreateAccountID(trade.SellerId)
@@ -257,6 +272,10 @@ func (q *Q) InsertTrade(
 	var baseAccountId, counterAccountId int64
 	var baseAmount, counterAmount xdr.Int64
 	var baseOfferId, counterOfferId int64
+	var price xdr.Price
+	if sellPrice != nil {
+		price = *sellPrice
+	}
 
 	if orderPreserved {
 		baseAccountId = sellerAccountId
@@ -272,7 +291,13 @@ func (q *Q) InsertTrade(
 		counterAmount = trade.AmountSold
 		baseOfferId = buyOfferId
 		counterOfferId = sellOfferId
-		sellPrice.Invert()
+		price.Invert()
+	}
+
+	var priceN, priceD null.Int
+	if sellPrice != nil {
+		priceN = null.IntFrom(int64(price.N))
+		priceD = null.IntFrom(int64(price.D))
 	}
 
 	sql := tradesInsert.Values(
@@ -289,8 +314,8 @@ func (q *Q) InsertTrade(
 		counterAssetId,
 		counterAmount,
 		orderPreserved,
-		sellPrice.N,
-		sellPrice.D,
+		priceN,
+		priceD,
 	)
 
 	_, err = q.Exec(sql)
@@ -55,20 +55,9 @@ type TradeAggregationsQ struct {
 func (q Q) GetTradeAggregationsQ(baseAssetID int64, counterAssetID int64, resolution int64,
 	offset int64, pagingParams db2.PageQuery) (*TradeAggregationsQ, error) {
 
-	//convert resolution to a duration struct
-	resolutionDuration := time.Duration(resolution) * time.Millisecond
-	offsetDuration := time.Duration(offset) * time.Millisecond
-
-	//check if resolution allowed
-	if StrictResolutionFiltering {
-		if _, ok := AllowedResolutions[resolutionDuration]; !ok {
-			return &TradeAggregationsQ{}, errors.New("resolution is not allowed")
-		}
-	}
-	// check if offset is allowed. Offset must be 1) a multiple of an hour 2) less than the resolution and 3)
-	// less than 24 hours
-	if offsetDuration%time.Hour != 0 || offsetDuration >= time.Hour*24 || offsetDuration > resolutionDuration {
-		return &TradeAggregationsQ{}, errors.New("offset is not allowed.")
+	err := validateBuckets(resolution, offset)
+	if err != nil {
+		return &TradeAggregationsQ{}, err
 	}
 
 	return &TradeAggregationsQ{
@@ -82,14 +71,7 @@ func (q Q) GetTradeAggregationsQ(baseAssetID int64, counterAssetID int64, resolu
 
 // WithStartTime adds an optional lower time boundary filter to the trades being aggregated.
 func (q *TradeAggregationsQ) WithStartTime(startTime strtime.Millis) (*TradeAggregationsQ, error) {
-	offsetMillis := strtime.MillisFromInt64(q.offset)
-	var adjustedStartTime strtime.Millis
-	// Round up to offset if the provided start time is less than the offset.
-	if startTime < offsetMillis {
-		adjustedStartTime = offsetMillis
-	} else {
-		adjustedStartTime = (startTime - offsetMillis).RoundUp(q.resolution) + offsetMillis
-	}
+	adjustedStartTime := adjustStartTime(startTime, q.resolution, q.offset)
 	if !q.endTime.IsNil() && adjustedStartTime > q.endTime {
 		return &TradeAggregationsQ{}, errors.New("start time is not allowed")
 	} else {
@@ -100,14 +82,9 @@ func (q *TradeAggregationsQ) WithStartTime(startTime strtime.Millis) (*TradeAggr
 
 // WithEndTime adds an upper optional time boundary filter to the trades being aggregated.
 func (q *TradeAggregationsQ) WithEndTime(endTime strtime.Millis) (*TradeAggregationsQ, error) {
-	// Round upper boundary down, to not deliver partial bucket
-	offsetMillis := strtime.MillisFromInt64(q.offset)
-	var adjustedEndTime strtime.Millis
-	// the end time isn't allowed to be less than the offset
-	if endTime < offsetMillis {
-		return &TradeAggregationsQ{}, errors.New("end time is not allowed")
-	} else {
-		adjustedEndTime = (endTime - offsetMillis).RoundDown(q.resolution) + offsetMillis
+	adjustedEndTime, err := adjustEndTime(endTime, q.resolution, q.offset)
+	if err != nil {
+		return &TradeAggregationsQ{}, err
 	}
 	if adjustedEndTime < q.startTime {
 		return &TradeAggregationsQ{}, errors.New("end time is not allowed")
@@ -132,6 +109,9 @@ func (q *TradeAggregationsQ) GetSql() sq.SelectBuilder {
 	bucketSQL = bucketSQL.From("history_trades").
 		Where(sq.Eq{"base_asset_id": q.baseAssetID, "counter_asset_id": q.counterAssetID})
 
+	//trades ingested from a history archive have no price and are not aggregated
+	bucketSQL = bucketSQL.Where("price_n IS NOT NULL")
+
 	//adjust time range and apply time filters
 	bucketSQL = bucketSQL.Where(sq.GtOrEq{"ledger_closed_at": q.startTime.ToTime()})
 	if !q.endTime.IsNil() {
@@ -158,6 +138,48 @@ func (q *TradeAggregationsQ) GetSql() sq.SelectBuilder {
 		OrderBy("timestamp " + q.pagingParams.Order)
 }
 
+// validateBuckets checks that buckets of `resolution` milliseconds, starting
+// `offset` milliseconds after the epoch, are allowed.
+func validateBuckets(resolution int64, offset int64) error {
+	//convert resolution to a duration struct
+	resolutionDuration := time.Duration(resolution) * time.Millisecond
+	offsetDuration := time.Duration(offset) * time.Millisecond
+
+	//check if resolution allowed
+	if StrictResolutionFiltering {
+		if _, ok := AllowedResolutions[resolutionDuration]; !ok {
+			return errors.New("resolution is not allowed")
+		}
+	}
+	// check if offset is allowed. Offset must be 1) a multiple of an hour 2) less than the resolution and 3)
+	// less than 24 hours
+	if offsetDuration%time.Hour != 0 || offsetDuration >= time.Hour*24 || offsetDuration > resolutionDuration {
+		return errors.New("offset is not allowed.")
+	}
+	return nil
+}
+
+// adjustStartTime rounds a lower time boundary up to the start of a bucket.
+func adjustStartTime(startTime strtime.Millis, resolution int64, offset int64) strtime.Millis {
+	offsetMillis := strtime.MillisFromInt64(offset)
+	// Round up to offset if the provided start time is less than the offset.
+	if startTime < offsetMillis {
+		return offsetMillis
+	}
+	return (startTime - offsetMillis).RoundUp(resolution) + offsetMillis
+}
+
+// adjustEndTime rounds an upper time boundary down to the start of a bucket,
+// to not deliver partial buckets.
+func adjustEndTime(endTime strtime.Millis, resolution int64, offset int64) (strtime.Millis, error) {
+	offsetMillis := strtime.MillisFromInt64(offset)
+	// the end time isn't allowed to be less than the offset
+	if endTime < offsetMillis {
+		return 0, errors.New("end time is not allowed")
+	}
+	return (endTime - offsetMillis).RoundDown(resolution) + offsetMillis, nil
+}
+
 // formatBucketTimestampSelect formats a sql select clause for a bucketed timestamp, based on given resolution
 // and the offset. Given a time t, it gives it a timestamp defined by
 // f(t) = ((t - offset)/resolution)*resolution + offset.
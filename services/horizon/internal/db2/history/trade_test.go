@@ -68,4 +68,19 @@ func TestTradeQueries(t *testing.T) {
 	tt.Assert.Equal(xdr.Int64(1000000000), trades[0].BaseAmount)
 	tt.Assert.Equal(xdr.Int64(2000000000), trades[0].CounterAmount)
 	tt.Assert.Equal(false, trades[0].BaseIsSeller)
+
+	// asset filter
+	usd := xdr.MustNewCreditAsset("USD", "GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU")
+	trades = nil
+	err = q.Trades().ForAsset(usd).Page(db2.MustPageQuery("", false, "asc", 100)).Select(&trades)
+	tt.Require.NoError(err)
+	if tt.Assert.NotEmpty(trades) {
+		for _, trade := range trades {
+			tt.Assert.True(trade.BaseAssetCode == "USD" || trade.CounterAssetCode == "USD")
+		}
+	}
+
+	// unknown assets aren't found
+	err = q.Trades().ForAsset(xdr.MustNewCreditAsset("XYZ", "GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU")).Select(&trades)
+	tt.Assert.True(q.NoRows(err))
 }
@@ -4,8 +4,14 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	"github.com/kinecosystem/go/services/horizon/internal/toid"
+	strtime "github.com/kinecosystem/go/support/time"
 )
 
+// IsSuccessful returns true when the transaction was successfully applied.
+func (t *Transaction) IsSuccessful() bool {
+	return t.Successful == nil || *t.Successful
+}
+
 // TransactionByHash is a query that loads a single row from the
 // `history_transactions` table based upon the provided hash.
 func (q *Q) TransactionByHash(dest interface{}, hash string) error {
@@ -16,15 +22,79 @@ func (q *Q) TransactionByHash(dest interface{}, hash string) error {
 	return q.Get(dest, sql)
 }
 
-// TransactionsForLastXLedgers filters the query to only the last X ledgers worth of transactions.
-// Currently, we hard code the query to return the last 5 ledgers worth of transactions. In the
-// future this may be configurable.
-func (q *Q) TransactionsForLastXLedgers(currentSeq int32, dest interface{}) error {
+// TransactionsForLastXLedgers loads the fee stats of the successful
+// transactions of the last `ledgers` ledgers, up to and including
+// `currentSeq`: the min, mode, max and percentiles of the fees paid per
+// operation.
+func (q *Q) TransactionsForLastXLedgers(currentSeq int32, ledgers int32, dest interface{}) error {
 	return q.GetRaw(dest, `
-		SELECT min(fee_paid/operation_count),  mode() within group (order by fee_paid/operation_count)
+		SELECT
+			min(fee_paid/operation_count),
+			mode() within group (order by fee_paid/operation_count),
+			max(fee_paid/operation_count),
+			percentile_disc(0.10) within group (order by fee_paid/operation_count) as p10,
+			percentile_disc(0.20) within group (order by fee_paid/operation_count) as p20,
+			percentile_disc(0.30) within group (order by fee_paid/operation_count) as p30,
+			percentile_disc(0.40) within group (order by fee_paid/operation_count) as p40,
+			percentile_disc(0.50) within group (order by fee_paid/operation_count) as p50,
+			percentile_disc(0.60) within group (order by fee_paid/operation_count) as p60,
+			percentile_disc(0.70) within group (order by fee_paid/operation_count) as p70,
+			percentile_disc(0.80) within group (order by fee_paid/operation_count) as p80,
+			percentile_disc(0.90) within group (order by fee_paid/operation_count) as p90,
+			percentile_disc(0.95) within group (order by fee_paid/operation_count) as p95,
+			percentile_disc(0.99) within group (order by fee_paid/operation_count) as p99
 		FROM history_transactions
 		WHERE ledger_sequence > $1 AND ledger_sequence <= $2
-	`, currentSeq-5, currentSeq)
+		AND (successful = true OR successful IS NULL)
+	`, currentSeq-ledgers, currentSeq)
+}
+
+// MaxFeesForLastXLedgers loads the max fee paid per operation in each of the
+// last `ledgers` ledgers, up to and including `currentSeq`, the latest first.
+// Failed transactions are left out; ledgers without successful transactions
+// report their base fee.
+func (q *Q) MaxFeesForLastXLedgers(currentSeq int32, ledgers int32, dest interface{}) error {
+	return q.SelectRaw(dest, `
+		SELECT
+			hl.sequence,
+			COALESCE(max(ht.fee_paid/ht.operation_count), hl.base_fee) as max_fee
+		FROM history_ledgers hl
+		LEFT JOIN history_transactions ht ON ht.ledger_sequence = hl.sequence
+			AND (ht.successful = true OR ht.successful IS NULL)
+		WHERE hl.sequence > $1 AND hl.sequence <= $2
+		GROUP BY hl.sequence, hl.base_fee
+		ORDER BY hl.sequence DESC
+	`, currentSeq-ledgers, currentSeq)
+}
+
+// TransactionsChangingAccount loads, in application order, the transactions of
+// the ledgers after `seq` up to and including `last` that may have changed the
+// entries of account `aid`: the ones it participates in, and the ones
+// producing its effects, such as trades crossing its offers.
+func (q *Q) TransactionsChangingAccount(dest interface{}, aid string, seq int32, last int32) error {
+	var account Account
+	err := q.AccountByAddress(&account, aid)
+	if err != nil {
+		return err
+	}
+
+	start := toid.New(seq+1, 0, 0).ToInt64()
+	end := toid.New(last+1, 0, 0).ToInt64()
+	sql := selectTransaction.
+		Where(`ht.id IN (
+			SELECT htp.history_transaction_id
+			FROM history_transaction_participants htp
+			WHERE htp.history_account_id = ?
+			AND htp.history_transaction_id >= ? AND htp.history_transaction_id < ?
+			UNION
+			SELECT heff.history_operation_id - (heff.history_operation_id % ?)
+			FROM history_effects heff
+			WHERE heff.history_account_id = ?
+			AND heff.history_operation_id >= ? AND heff.history_operation_id < ?
+		)`, account.ID, start, end, toid.OperationMask+1, account.ID, start, end).
+		OrderBy("ht.id asc")
+
+	return q.Select(dest, sql)
 }
 
 // Transactions provides a helper to filter rows from the `history_transactions`
@@ -72,6 +142,44 @@ func (q *TransactionsQ) ForLedger(seq int32) *TransactionsQ {
 	return q
 }
 
+// ForTimeRange filters the query to only transactions in the ledgers closed
+// within [start, end).  Unset bounds are ignored.
+func (q *TransactionsQ) ForTimeRange(start, end strtime.Millis) *TransactionsQ {
+	if q.Err != nil {
+		return q
+	}
+
+	from, to, err := q.parent.TimeRange(start, end)
+	if err != nil {
+		q.Err = err
+		return q
+	}
+
+	q.sql = q.sql.Where("ht.id >= ? AND ht.id < ?", from, to)
+	return q
+}
+
+// ForMemo filters the query to only transactions with the memo of type
+// `memoType`, and of value `memo` when it isn't empty.
+func (q *TransactionsQ) ForMemo(memoType string, memo string) *TransactionsQ {
+	if q.Err != nil {
+		return q
+	}
+
+	q.sql = q.sql.Where("ht.memo_type = ?", memoType)
+	if memo != "" {
+		q.sql = q.sql.Where("ht.memo = ?", memo)
+	}
+	return q
+}
+
+// IncludeFailed includes failed transactions in the query being built, which
+// are excluded by default.
+func (q *TransactionsQ) IncludeFailed() *TransactionsQ {
+	q.includeFailed = true
+	return q
+}
+
 // Page specifies the paging constraints for the query being built by `q`.
 func (q *TransactionsQ) Page(page db2.PageQuery) *TransactionsQ {
 	if q.Err != nil {
@@ -88,10 +196,18 @@ func (q *TransactionsQ) Select(dest interface{}) error {
 		return q.Err
 	}
 
+	if !q.includeFailed {
+		q.sql = q.sql.Where(successfulTransactions)
+	}
+
 	q.Err = q.parent.Select(dest, q.sql)
 	return q.Err
 }
 
+// successfulTransactions filters out failed transactions.  Transactions
+// ingested before failed ones were have no `successful` value.
+const successfulTransactions = "(ht.successful = true OR ht.successful IS NULL)"
+
 var selectTransaction = sq.Select(
 	"ht.id, " +
 		"ht.transaction_hash, " +
@@ -112,6 +228,7 @@ var selectTransaction = sq.Select(
 		"ht.memo, " +
 		"lower(ht.time_bounds) AS valid_after, " +
 		"upper(ht.time_bounds) AS valid_before, " +
-		"hl.closed_at AS ledger_close_time").
+		"hl.closed_at AS ledger_close_time, " +
+		"ht.successful").
 	From("history_transactions ht").
 	LeftJoin("history_ledgers hl ON ht.ledger_sequence = hl.sequence")
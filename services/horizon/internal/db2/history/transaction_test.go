@@ -22,3 +22,32 @@ func TestTransactionQueries(t *testing.T) {
 	err = q.TransactionByHash(&tx, fake)
 	tt.Assert.Equal(err, sql.ErrNoRows)
 }
+
+func TestFeeStatsSkipFailedTransactions(t *testing.T) {
+	tt := test.Start(t).Scenario("operation_fee_stats_3")
+	defer tt.Finish()
+	q := &Q{tt.HorizonSession()}
+
+	_, err := tt.HorizonDB.Exec(
+		`UPDATE history_transactions SET successful = false WHERE ledger_sequence IN (7, 8)`,
+	)
+	tt.Require.NoError(err)
+
+	var stats FeeStats
+	err = q.TransactionsForLastXLedgers(9, 5, &stats)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(int64(200), stats.Min.Int64)
+	tt.Assert.Equal(int64(400), stats.Max.Int64)
+
+	// ledgers 7 and 8 only hold failed transactions and report their base fee
+	var maxFees []LedgerMaxFee
+	err = q.MaxFeesForLastXLedgers(9, 5, &maxFees)
+	tt.Require.NoError(err)
+	tt.Assert.Equal([]LedgerMaxFee{
+		{Sequence: 9, MaxFee: 400},
+		{Sequence: 8, MaxFee: 100},
+		{Sequence: 7, MaxFee: 100},
+		{Sequence: 6, MaxFee: 400},
+		{Sequence: 5, MaxFee: 200},
+	}, maxFees)
+}
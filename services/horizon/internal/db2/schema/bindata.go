@@ -7,7 +7,13 @@
 // migrations/13_trade_offer_ids.sql
 // migrations/14_fix_asset_toml_field.sql
 // migrations/15_ledger_failed_txs.sql
+// migrations/16_webhooks.sql
+// migrations/17_ingest_failed_transactions.sql
+// migrations/18_transactions_by_memo.sql
+// migrations/19_operation_assets.sql
 // migrations/1_initial_schema.sql
+// migrations/20_webhook_cursor.sql
+// migrations/21_ledgers_from_archive.sql
 // migrations/2_index_participants_by_toid.sql
 // migrations/3_use_sequence_in_history_accounts.sql
 // migrations/4_add_protocol_version.sql
@@ -84,7 +90,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _latestSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5c\x6d\x6f\xdb\x38\x12\xfe\x9e\x5f\x41\x2c\x0a\xd8\xc6\x39\x3d\xcb\x49\x9c\xb7\x6d\x01\xaf\xa3\xa6\xc6\xba\x4a\xd7\x96\xaf\x5b\x14\x05\x41\x4b\xb4\xcd\xab\x24\xaa\x12\x9d\x4d\xf6\x70\xff\xfd\xa0\x57\xeb\x85\x14\x25\x5b\x69\xef\x5b\x63\x8d\x9e\x79\x66\x38\xc3\x21\x87\x54\x4f\x4f\x4f\x4e\x4f\xc1\x47\xea\xb3\x8d\x87\x17\x7f\xcc\x80\x89\x18\x5a\x21\x1f\x03\x73\x67\xbb\x27\xa7\xa7\x27\xc1\xf3\xbb\x9d\xed\x62\x13\xac\x3d\x6a\xef\x05\x1e\xb1\xe7\x13\xea\x80\xeb\xd7\xa3\xd7\x4a\x46\x6a\xf5\x0c\xdc\x0d\x0c\x5e\x2f\x88\x9c\x2c\x54\x1d\xf8\x0c\x31\x6c\x63\x87\x41\x46\x6c\x4c\x77\x0c\xbc\x01\x83\xdb\xf0\x91\x45\x8d\x6f\xe5\x5f\x0d\x8b\x04\xd2\xd8\x31\xa8\x49\x9c\x0d\x78\x03\x3a\x4b\xfd\xdd\x55\xe7\x36\x81\x73\x4c\xe4\x99\xd0\xa0\xce\x9a\x7a\x36\x71\x36\xd0\x67\x1e\x71\x36\x3e\x78\x03\xa8\x13\x63\x6c\xb1\xf1\x0d\xae\x77\x8e\xc1\x08\x75\xe0\x8a\x9a\x04\x07\xcf\xd7\xc8\xf2\x71\x4e\x8d\x4d\x1c\x68\x63\xdf\x47\x9b\x50\xe0\x2f\xe4\x39\xc4\xd9\xdc\xc6\xdc\x31\xf2\x8c\x2d\x74\x11\xdb\x82\x37\xc0\xdd\xad\x2c\x62\xf4\x03\x63\x0d\xc4\x90\x45\x03\xb1\xc8\x9f\x1a\xb2\xf1\x0d\x58\x13\xcf\x67\x10\x6d\x36\x5d\xe4\x3c\x63\x2b\xb4\xba\x0f\xf6\xff\xee\xdd\x02\xfd\xd9\xc5\x37\xe0\xdd\x52\x9b\xe8\xd3\x07\xed\x16\x2c\x8c\x2d\xb6\xd1\x4d\x8c\x7d\x0b\x1e\xfe\x72\xb0\x77\x03\x4e\xc3\x81\x98\xcc\xd5\xb1\xae\xa6\xd2\x72\x7c\x30\x57\xf5\xe5\x5c\x5b\x64\x7e\x3b\x01\x00\x80\xd9\x58\xbb\x5f\x8e\xef\x55\xe0\x7f\xb7\xc0\xf4\xc3\x87\xa5\x3e\xfe\x6d\xa6\x82\x85\x3e\x9f\x4e\xf4\x50\x62\xbc\x00\xaf\xe0\x2b\xb0\x50\x67\xea\x44\x07\xaf\x94\xe0\xaf\xdb\x93\xbc\x79\x16\x7a\x51\xeb\x64\xf0\xad\x19\x37\xe4\x19\x67\xa3\x27\xe8\x7a\xc4\xc0\x21\x05\x67\x67\x63\x8f\x18\x5f\xbe\xf6\x41\xfa\xcf\x63\xed\xab\xa1\x21\x35\x31\xfd\xe9\x20\x0b\xbb\x27\x00\x4c\xc6\x0b\x15\x7c\x7a\xaf\x6a\xe0\x95\xf2\x45\xf9\xfa\xcf\x57\xca\x97\xe1\xd7\xb7\xaf\x86\xe1\xbf\x87\x5f\x86\x5f\x81\x1e\x3d\x04\xea\x6c\xa1\x06\x4e\x51\xb5\xbb\x1e\xd7\x33\xc4\x79\x69\xcf\xc8\x35\xbc\xb4\x67\x7e\x3d\xc4\x33\x61\x3e\x76\x39\x19\x30\xbe\xbf\x9f\xab\xf7\x63\x5d\xad\xe7\x88\x54\xbc\x8c\x18\x32\x06\x60\x11\xf8\x2a\x98\xbf\x92\x19\xa0\x1f\xfd\xac\x7f\xfe\xa8\x82\x37\xd9\x8c\xe8\xf1\xb2\xb6\x55\x8e\x45\xc0\x02\xc5\x24\x8d\xeb\x33\x4c\x13\xa3\x5b\x8e\xa8\x83\x59\xf2\x40\x0b\x4c\x73\x09\x99\xa7\xbb\x8f\xb2\x32\xdb\x24\x58\x5b\x65\xcb\x01\x2d\xb2\xcd\x26\x49\x25\xdb\xa0\x72\x99\x78\x8d\x76\x16\x83\x0c\xad\x2c\xec\xbb\xc8\xc0\x41\x1d\xed\xdc\xe6\x9f\xfe\x45\xd8\x16\x52\x62\x66\x4a\x63\xce\x56\xe4\xfb\x98\xc1\xa0\x82\xfb\x89\x89\x61\x82\xd5\x33\x2f\xca\xc5\x0c\x46\x6c\x11\x31\xc1\x8a\x6c\x88\xc3\x80\xf6\xa0\x03\x6d\x39\x9b\x45\xe6\x20\x9b\xee\x1c\x06\x8c\x2d\xf2\x90\xc1\xb0\x07\x1e\x91\xf7\x1c\xac\x00\xf2\x62\xce\xce\x86\xc8\x30\x02\x59\x1f\x10\x87\xe1\x0d\xf6\x0a\x22\x6b\x0b\x6d\x7c\xe0\xdb\xc8\xb2\xca\x6a\x18\xb5\xad\xb2\x92\xee\xf0\xe2\xa2\x97\x4a\x96\x87\x7d\x43\x3d\x17\xda\x64\xe3\xa1\x60\x45\x71\xb8\x3b\x0a\x38\x7b\x97\x30\xfc\x54\x72\x88\xeb\x5a\x04\x9b\x10\x31\x10\xac\x92\x7c\x86\x6c\x17\x04\x63\x16\xfe\x09\xfe\xa6\x0e\x2e\x13\xdd\x12\x9f\x51\xef\x39\x75\x11\x24\x26\xf4\xf1\xf7\x84\xf0\x42\xfd\x63\xa9\x6a\x93\x9a\x9c\x13\x69\x11\x6a\x1c\x86\xe3\xb9\x0e\x3e\x4d\xf5\xf7\x40\x09\x7f\x98\x6a\x93\xb9\xfa\x41\xd5\x74\xf0\xdb\xe7\xf8\x27\xed\x01\x7c\x98\x6a\xff\x1a\xcf\x96\x6a\xfa\xf7\xf8\xcf\xfd\xdf\x93\xf1\xe4\xbd\x0a\x14\x99\x31\x07\xbb\xbd\x08\x54\x0a\xc5\x3b\xf5\xdd\x78\x39\xd3\x81\x83\x9f\xd8\x23\xb2\xba\x1d\x81\xc5\x9d\x9b\x1b\x0f\x6f\x0c\x0b\xf9\x7e\xaf\x38\x5c\xa6\xe9\x61\xdf\xe7\xc4\xd6\xe8\xbc\x57\x31\x50\x41\x82\xb4\x60\x59\x08\xb3\xb7\x8b\x9f\x19\x51\x36\xb2\x67\x17\xf3\x69\x72\xc5\x0d\x6a\xf2\xc4\x95\x21\x5f\x9c\xf8\xfe\x0e\x7b\x9c\x17\x2e\x46\x55\x19\x96\x37\xa4\xe5\xb0\xcd\x62\xfe\xb0\xa0\xad\x32\x04\x3c\x7c\xd2\xd4\x3b\xf0\xdb\x67\x89\x45\xe3\x99\xae\xce\x25\x06\xa5\x58\x85\xc7\xaf\x89\x29\xe2\x86\xd7\x6b\x6c\xb4\x10\x75\x31\x4e\x1c\x76\x85\x9c\x81\xa2\x99\x3e\x91\xa3\x2e\x8e\xe6\x41\xa1\xe4\x2f\xd4\x33\xb1\xf7\x8b\x20\x9a\xc3\x38\xe6\x3f\x32\x31\x43\xc4\xf2\xc1\xbf\x7d\xea\xac\xc4\xc1\x66\x61\x73\x83\xbd\xe3\xfd\x10\xe3\xc4\x7e\xf0\xf1\xf7\x1d\x76\x0c\x11\xb7\x48\x18\x6e\x91\xbf\xad\x95\x85\xae\x87\x1f\x09\xdd\xf9\x50\xfa\x62\xec\x16\x0f\x39\x3e\x8a\xb6\xbe\xe1\x40\xa4\x3c\x92\x59\x6e\x50\xd0\xb0\x1f\x88\x7a\xf2\x86\x45\x7d\x5e\x61\x0a\x36\xf2\x69\x6d\x2a\xbe\xe3\x61\xc4\xa4\x2f\x45\xb2\x3b\xd7\xac\x2d\x9b\x86\x4e\xfc\xa7\xed\x52\x8f\x61\x0f\x26\xbd\x88\xa2\x2d\x4a\x69\x3d\xc0\x90\x05\x0d\x4a\x1c\x9f\x1f\x83\x6b\x8c\xa1\x4b\xa9\xc5\x7f\xba\x42\x3e\x86\x6b\x2c\x1a\xeb\xf0\xb1\x87\x7d\xec\x3d\x8a\x44\x82\x75\x28\x7b\x82\xe1\x32\x89\xfc\x2d\x92\x72\x3d\xca\xa8\x41\x2d\xa1\x5d\xc5\x31\x4a\x82\x05\x23\x13\x7b\xe1\xf2\x22\xfa\xdd\xdf\x19\x06\xf6\xfd\xf5\xce\x82\xc2\x40\x89\x0d\x47\xc4\xc2\xa6\x58\x4a\x9c\x56\xfb\x78\x72\x91\xc7\x88\x41\x5c\xd4\x46\xf5\xe6\xc3\xca\x6a\x5e\xfd\xd9\x46\x3e\x7f\x35\x35\xb9\xdd\x32\x56\xa9\xe3\x47\x95\xb5\x46\x86\x1e\x59\xe6\x2a\x75\x95\xcb\x1e\x5f\xbc\xa2\x0c\xa6\x2f\xb4\x18\x9b\xb2\x6d\x4e\x36\x9d\x84\x5b\xa1\x60\xe5\x6f\x44\xa6\x84\x15\xf0\xc8\x02\x18\x67\x3e\xdd\x79\xc1\xfe\x31\x8a\x6e\x41\xe9\x49\xa6\x93\x4e\xe7\xe6\x46\xbc\x15\x13\xe7\x01\xf3\x90\x89\x8f\x77\x67\x04\x53\x58\x57\x1c\xbb\x5e\x88\xa7\xc4\x43\xaa\x17\x5d\xaf\xb1\x27\x54\x1b\xce\xf2\xb2\x55\x4f\x24\x14\x2d\x91\x2b\x45\xa2\x7d\x30\x57\x20\xd4\x80\x3d\xa9\xae\x54\xae\x52\x5d\x2a\x55\xa1\x31\xa4\x44\x7c\xe8\x63\xcb\xc2\x1e\x58\x51\x6a\x61\xe4\x24\x35\x89\x18\x18\x3a\xb9\xfa\x1b\xfd\x96\xaf\xc9\x21\x46\xc1\x83\x79\x06\xdc\x87\x93\x07\x6d\xa1\xcf\xc7\x53\x4d\x2f\x84\x05\xcc\xf8\x09\x86\xbd\x7e\x30\x79\xaf\x4e\x7e\x07\xdd\x6e\xd6\x83\x6f\xc1\xa0\xd7\x93\x41\xf1\x5e\x4f\x9c\xf6\x6b\xc9\x8f\x35\xf0\x72\x3e\x2d\xc0\x17\x1c\x1e\x12\xac\x4c\xa5\x74\xa6\x68\xb5\x8e\x8a\x80\xeb\x56\xd2\x3a\x53\xd8\x31\xb5\x54\xc4\xaf\xdd\x6a\x2a\xd1\xf2\xa3\xea\x69\x43\x63\x8f\xac\xa8\x12\x6d\xe5\x9a\x2a\x7a\xa1\xa2\xaa\x66\x5e\x69\x35\x56\x93\xf8\xcc\x52\xaa\xbd\x89\x8a\xe7\x7e\xc9\xd6\xac\x6e\xe1\xad\xae\xa1\x5c\xd9\xbd\x6a\xf1\x2e\x03\x09\x53\x4f\xb4\x43\xfb\x29\x7b\x2c\xf6\x04\xb1\xf3\x88\x2d\xea\x62\x5e\xdf\x92\x3d\x05\x3b\x9e\x9d\xc5\x04\x0f\x6d\xcc\x90\xe0\x51\xe0\x05\xd1\x63\x9f\x6c\x1c\xc4\x76\x1e\xe6\xb5\xd8\xae\x47\xbd\x2f\x5f\xf7\x8b\x97\xff\xfc\x97\xb7\x7c\xf9\xf2\xb5\xb8\xf5\xc2\x36\x15\x74\xc3\xf6\x58\x0e\x75\x70\xe5\x62\x68\x8f\x55\x86\x89\x2d\x23\x36\x86\x2b\xba\x73\xcc\xb0\x65\x7d\xe5\x21\x67\x53\xd5\xbb\x8d\x7a\x79\xc4\x4c\xb2\x27\xe6\x52\x2b\xe5\xa3\xf4\x79\xd0\x66\xc5\x76\x10\x88\x9e\x4f\x1e\x66\xcb\x0f\x5a\x30\xa4\x0b\x55\xaf\xe8\x7b\x66\x3b\x4c\xd9\xae\x67\xb3\x7d\x41\x7b\x46\x08\xf0\x1b\x19\x55\xb9\x9f\xa8\x63\xa4\xb0\x72\xb6\x66\xa6\x50\x43\x23\x43\x25\xd3\x3c\xdf\xd4\x3b\xc4\x10\x58\x53\x4f\x72\xfa\x03\xee\xc6\xfa\x58\x62\x9e\x00\xb2\xea\x14\xa5\x0e\xec\x54\x5b\xa8\x73\x1d\x4c\x35\xfd\xa1\x74\x92\x12\x16\xdc\x05\xe8\x76\x14\x48\x1c\xc2\x08\xb2\xa0\x1f\x62\xbd\xf6\xbf\x5b\x9d\x3e\xe8\x0c\x07\xca\xf5\xe9\x40\x39\x3d\x53\x80\x72\x75\x33\xbc\xbc\x19\x8e\x5e\x5f\x2a\x67\xe7\x97\x97\xff\x18\x28\x9d\xde\x6d\x3d\xf4\x21\x24\x8e\x89\x9f\xf2\x5e\x5d\x3d\x43\x46\x89\x59\xa9\x69\x38\x3a\x3f\x1f\x35\xd1\x74\x06\x77\x3e\x4e\xab\x06\x24\x0e\x2c\x9e\x49\x54\xea\x3b\x1b\x5c\x37\xd3\x77\x0e\x91\x69\xc2\x62\x9f\xa9\x52\xc7\xb9\x72\x7e\xa9\x34\xd1\x71\x01\xa3\x12\x95\xac\x96\xc3\xf3\xc9\x4a\x15\x17\x17\xc3\x51\x23\x33\x46\x89\x8a\x78\x06\x93\xab\x18\x29\xa3\xc1\xb0\x89\x8a\x4b\x68\x53\x93\xac\x9f\xeb\x5b\x71\x79\xa1\x5c\x37\x0a\xb3\xab\x9c\x15\x51\x16\xd6\xd0\x73\x35\x1c\x5c\x9c\x35\xd3\x13\x0c\x3a\xda\x6c\x3c\xbc\x41\x8c\x7a\xd5\x31\x75\x75\x3e\x1a\x35\xf2\xd4\x75\x08\x1f\xf5\x20\xe1\x93\xe9\x55\xa3\x5f\x9e\x5f\x35\x22\xaf\x0c\x42\xf8\x78\x14\xc2\x9d\x67\xa5\x82\xeb\xc1\xc5\xf0\xbc\x91\x02\x25\xab\x20\xdd\xca\x04\x13\x40\xb5\xa2\x8b\x61\xb3\x88\x52\x86\xb9\x81\x8e\x37\x8f\xd1\xc5\xb6\x2a\x4d\x57\x03\xe5\xe2\xaa\x51\x60\x29\x67\x91\x39\xe9\x96\xbb\x72\xc4\xaf\x06\xd7\xa3\xb3\x66\x2e\x3b\x87\x6b\xf2\x14\x5b\xc3\xa8\x6d\xc1\x35\xc1\x56\xe5\xd4\x78\xa5\x28\xc3\xd1\x55\x23\x25\x17\xc9\x59\x48\xd2\xa3\x7e\xaa\x36\x43\x19\xa6\x91\x25\x28\x4d\x95\x47\xcd\x4d\x4a\x5e\xa3\x63\xf8\xa0\x8a\x4b\x70\xe3\xab\x4b\xfb\x5b\x87\xaf\x7d\x5c\x7d\x44\xdd\x07\x4a\x3f\xba\xcf\x51\xc3\xdc\xf2\xe9\xf3\x11\xc6\x56\x9e\x78\xb6\x62\x6a\x6e\x55\xda\xc4\x50\xde\x89\xe7\x11\x2b\x99\xaa\x03\xc4\x16\x60\x6b\x1c\xa0\x1c\x3e\x4c\xcd\x3a\xf8\x6d\x0c\x5b\xf5\xba\xbb\xc9\x30\x0a\x3a\xf6\x2d\xb8\x9c\xd3\xb8\x6e\x07\x55\xde\xc3\x3b\x7c\x28\x9b\x36\x8f\xda\x18\x4c\xd9\xde\xa2\xc9\x70\x0a\x5b\x45\xcd\x5d\x92\xbd\x68\x96\xad\xa5\xee\x37\xfc\x9c\x40\xef\xdb\xb6\x4d\xb7\x67\x19\xc4\xe8\x5e\xe9\xdd\x5d\xb6\x09\x5c\x54\x08\x3e\xce\xa7\x1f\xc6\xf3\xcf\xe0\x77\xf5\x33\xe8\x12\x53\x76\x9f\xac\xf8\x77\x4b\xac\x0b\xa8\x3c\xe6\x3c\xc5\x52\xf6\x85\xc6\x42\x61\x76\xde\xdf\x1a\x82\xfb\xfb\x46\x30\x7b\x39\x08\xb6\x62\x5d\x5e\x2d\xcf\xb8\x83\x88\x81\xa5\x36\xfd\x63\xa9\x82\xee\x5e\xbc\x9f\xb9\x38\xd5\xcf\x5d\x73\x6a\xe8\x9a\x76\x86\xb5\xb1\xe1\x8d\x06\x55\xd0\x68\x91\xcc\xe5\xed\x5a\xc6\x57\x52\x65\x69\x05\xad\xda\x96\x0b\x7b\x2f\xd2\xa9\xaf\x5d\xeb\x45\x6a\xaa\xec\xaf\xa4\x26\xf5\x40\x14\xd2\xab\xe7\x30\xda\x13\x43\xa6\xda\x9d\xfa\x67\xbd\x9e\x7d\x28\x9a\x47\x01\x0f\x5a\x31\x19\x96\x8b\xa9\x76\x0f\x56\xcc\xc3\x38\x9b\x5d\x62\x36\x51\x8e\x1d\xcf\x27\xbe\x92\x58\x8b\x91\x20\xaf\x57\xe9\x3a\xfb\x60\x3a\x7b\x88\x2c\x93\xdc\x01\x47\x9e\x4f\x24\xdc\x2f\x9d\x20\xf0\xc8\x6d\x91\xbf\x3d\x86\x59\x78\x90\x52\x8b\x56\xf1\xf8\x85\xc7\x26\x5a\x16\x1f\xc3\x27\x42\xa8\xc7\xa8\x70\xb6\xd3\x2f\x1f\xe3\x70\x53\x1e\xe2\x20\x36\xc2\xe7\x07\x30\x8d\xab\x44\x44\xb8\x00\x97\xa5\x9d\x5c\x91\xcc\x31\xe6\xdd\x68\xe8\x27\xb7\x17\x44\x64\xf7\x3d\xe6\x23\x69\x12\xb3\x36\xc1\xfd\xf1\x6d\x9f\x7b\x0d\x43\x42\x9a\xba\xd0\x6d\x8b\x77\x8c\x95\xa5\x2e\x28\x55\x07\x59\xc2\x37\x80\x3d\xb5\x67\x40\x8c\x25\x88\xe9\x03\x4d\xc8\x9f\xc5\x97\x8d\xa0\x6e\x10\x95\x5b\x7a\x90\x0d\x31\xf9\x3d\xc6\xa1\xce\xaf\x76\x74\x7a\xb3\x35\x98\xaa\x8f\xf7\x75\x1e\x2e\x4b\x39\xb9\xa6\x9b\xe3\xc8\x67\x94\xf5\x6b\x5b\xb4\x4a\x98\xf5\xa6\x37\x1e\x41\x16\x0d\x09\x3b\x66\x58\xf7\x18\x87\x87\xa4\x2c\xfc\x98\x67\x06\x4a\xb2\x17\xa4\x8e\x20\x5c\x06\x2b\x30\x37\x71\x81\x67\xe1\x66\x56\x35\xc1\xb0\x25\xda\x0e\xbd\x10\xaa\x16\xb9\xa4\x0f\x2b\xa4\x56\xb8\xf3\x75\x34\xbf\x02\x9e\x8c\x64\xf9\xca\x99\x94\x69\x3b\x7e\xcc\xa1\xd5\x65\x29\xf5\x66\x3b\xdc\x6a\x71\xaa\xe6\x92\x30\xb6\x28\xfd\xb6\x73\x8f\x63\x94\xc7\xaa\x3d\xa2\xc9\xa5\x36\x2e\x3f\x17\x11\x2f\xfc\x3a\xbf\x15\x86\x45\xb4\x7a\x79\x1b\x13\xec\x97\xee\xe1\xf5\x4b\x77\x39\x05\x46\xb4\x30\x6f\xc7\x38\x32\xc6\x0d\x57\x47\x01\x6a\x6b\xde\x6d\xe0\x58\xa9\xdf\xa2\xe3\xed\xd2\xd9\x02\x75\x60\xfc\x81\xdb\xb1\x0e\x95\x2a\xc8\xed\xd3\x92\x0f\xf6\xf2\x3b\xa3\x48\xb0\x01\xf7\xe3\xe3\xa0\x0a\x5b\xce\x98\x93\x65\x79\xc0\x78\x15\x1e\xe0\xb1\x67\xf7\xf0\x3d\x78\x25\xaa\x74\xd9\x1f\x08\x49\x88\xc6\x6b\xa8\x00\x32\x0d\xa2\x96\xd8\xf2\xa0\xa5\xcb\xb7\xba\x91\x9c\x01\x6f\x3b\x18\x72\xd0\x87\xac\x37\xc5\x70\x85\xaf\x99\xda\x77\x74\xe9\x7b\x29\x29\xfd\xc2\x0b\xf5\x8d\xc9\x7c\xbe\xf6\x62\xfe\xcf\x7e\x22\x27\xb3\x24\x23\x5b\xdf\x08\xde\xc7\x78\x2f\x66\x0d\xf7\xcb\x3f\x99\x59\xbc\x97\xea\xdb\x97\x34\x51\x5e\xcc\xa6\xf4\x1a\xac\xcc\x0e\x61\xb7\x2b\x0f\xbd\x3f\x11\x7c\x89\xd4\x2e\xa2\x73\x37\xc0\x4d\x13\x3c\x0f\x9a\xdf\x42\xb5\x94\xe1\x55\x2a\xea\xd8\x20\xd9\xd7\x55\x2a\x6b\xaf\x7c\x95\x81\x6b\x71\x97\x17\xb1\xec\x66\xfb\x25\xc2\xa6\x8c\x7f\xf0\x56\x3f\xba\xad\x93\x14\xf2\xa4\xc3\x08\x57\x94\x7e\x3b\xd8\xcb\x15\x98\xd2\x25\x42\xb7\x9b\x7c\x5a\x76\xfa\xf6\x2d\xe8\xf8\xd4\x32\x33\xa7\x69\x9d\x9b\x1b\x86\x9f\x58\xaf\xd7\x07\x62\x41\x83\x9a\xf5\x04\xa3\x5e\xbc\x58\x74\x45\x77\x9b\x2d\xab\xa5\x3e\x27\x5a\x4d\x20\x27\x5a\xa0\xd0\x03\x9f\xde\xab\x73\x35\x0a\x32\xf0\x06\x9c\x9d\x09\x4e\x2f\xca\x07\xd1\xc4\x84\xeb\xcc\x31\xd1\xbb\xdf\x7f\xcc\x71\x74\xac\x16\xbc\x7b\x98\xab\xd3\x7b\x2d\x3d\x02\x02\x73\xf5\x9d\x3a\x57\xb5\x89\xba\x28\x9c\x8a\x84\x4f\x1f\x34\xb0\xfc\x78\x17\x84\xcc\x5c\x8d\xfe\x3f\xa5\xe0\xa7\x3b\x75\xa6\xea\x2a\x98\x8c\x17\x93\xf1\x9d\x5a\xfd\x0d\x20\xff\xa3\xad\xb4\x8b\xd0\x9e\x33\xf2\x7a\x24\x87\x64\x22\x26\x79\xff\x14\xdb\x46\x5c\x67\xc5\x0b\x7d\xc9\x89\xa2\xd0\x13\xf1\x56\xf6\xa7\xfb\x21\xcb\x83\xe7\x85\xa4\x4b\x50\x1d\x30\xcd\x3c\x50\x6e\x2a\xfd\x44\x37\x08\xc8\xe4\x7d\xc1\x69\x83\xb5\x1b\x14\xc5\x16\xc7\xff\x83\x43\xc4\xa1\x51\xea\x21\xd5\x8d\x0e\xd1\x7f\x3d\x09\x0c\x6a\xbb\x16\x66\x38\xb4\xe1\x7f\x01\x00\x00\xff\xff\x2d\x9f\x4a\x26\xa7\x52\x00\x00")
+var _latestSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x5d\xfb\x6f\xdb\x46\x12\xfe\x3d\x7f\xc5\xa2\x08\x60\x0b\x27\xe7\x44\xd9\x96\x5f\x6d\x00\x55\x66\x5c\xa1\x8e\x9c\xea\x71\x6d\x50\x04\x04\x25\xae\x64\x5e\x28\x91\x25\xa9\xc4\xee\xe1\xfe\xf7\x9b\xe5\x4b\x5c\x72\x5f\x7c\x38\xb9\xfe\xd0\x5a\xe4\x70\xe6\xfb\x66\x67\x77\xf6\x31\x64\x4f\x4e\x5e\x9d\x9c\xa0\x0f\x6e\x10\x6e\x7c\x3c\xfb\xed\x1e\x59\x66\x68\x2e\xcd\x00\x23\x6b\xbf\xf5\xe0\xde\x2b\x72\xff\x16\xfe\xc6\x16\x5a\xfb\xee\xf6\x20\xf0\x05\xfb\x81\xed\xee\xd0\xd5\x9b\xc1\x1b\x2d\x27\xb5\x7c\x46\xde\xc6\x20\x8f\x17\x44\x5e\xcd\xf4\x39\x0a\x42\x33\xc4\x5b\xbc\x0b\x8d\xd0\xde\x62\x77\x1f\xa2\x9f\x50\xef\x26\xba\xe5\xb8\xab\xcf\xe5\xab\x2b\xc7\x26\xd2\x78\xb7\x72\x2d\x7b\xb7\x81\x1b\x47\x8b\xf9\xbb\xcb\xa3\x9b\x54\xdd\xce\x32\x7d\xcb\x58\xb9\xbb\xb5\xeb\x6f\x41\xc2\x08\x42\x1f\xfe\x13\x80\xa4\xbb\x4b\x74\x3c\x62\x50\xbd\xde\xef\x56\x21\xc0\x31\x96\xa0\x09\x93\xfb\x6b\xd3\x09\x30\x65\x06\x14\x18\x5b\x1c\x04\xe6\x26\x12\xf8\x6a\xfa\x3b\xd0\x75\x93\x60\xc7\xa6\xbf\x7a\x34\x3c\x33\x7c\x84\x7b\xde\x7e\xe9\xd8\xab\x2e\x21\xbb\x02\x9f\x38\x2e\x11\x3b\x89\xfc\x39\x31\xb7\xf8\x1a\xad\x6d\x3f\x08\x0d\x73\xb3\x39\x36\x77\xcf\xd8\x89\x58\x77\xd1\xe1\xef\xce\x0d\x9a\x3f\x7b\x20\xf8\x6e\x31\x19\xcd\xc7\x0f\x93\x1b\x34\x03\xa4\x5b\xf3\x3a\xd1\x7d\x83\x1e\xbe\xee\xb0\x7f\x8d\x4e\xa2\x86\x18\x4d\xf5\xe1\x5c\xcf\xa4\xe5\xfa\xd1\x54\x9f\x2f\xa6\x93\x59\xee\xda\x2b\x04\xff\xdc\x0f\x27\x77\x8b\xe1\x9d\x8e\x82\xbf\x1c\x34\x7e\xff\x7e\x31\x1f\xfe\x7c\xaf\xa3\xd9\x7c\x3a\x1e\xcd\x23\x89\xe1\x0c\xbd\x36\x5e\xa3\x99\x7e\xaf\x8f\xe6\xe8\xb5\x46\x7e\x01\x3b\x8a\x9e\x63\xbe\x28\x3b\x99\xfa\xd6\xc8\xf5\x59\xe4\xb6\xe6\x93\xe1\xf9\xf6\x0a\x47\x10\x76\xfb\x2d\x86\x1f\x7f\x7e\xea\xa2\xec\xcf\xa6\xfc\x14\x2c\x64\x14\xb3\x4b\xb5\x18\x1e\xc3\xb5\xd1\x70\xa6\xa3\xdf\x7f\xd1\x27\xd0\x98\x7f\x6a\x9f\xfe\x09\xff\xee\x7f\x7a\xfb\xba\x1f\xfd\xdd\x87\xbf\xd1\x3c\xbe\x89\xf4\x7b\x90\x04\xa7\xe8\x93\xdb\x0e\xd3\x33\xd0\x43\x5e\xd8\x33\x72\x0b\x2f\xed\x99\x1f\xeb\x78\x26\xea\x8f\xc7\x8c\x1e\x30\xbc\xbb\x9b\xea\x77\xc0\x51\xcd\x11\x99\x78\x59\x63\x84\x18\xa1\x19\xf1\x15\x19\xbf\xd2\x11\xa0\x1b\x5f\x9e\x7f\xfc\xa0\xc3\xe5\x5c\x8f\xe8\xb0\x7a\x6d\xab\x18\x8b\x0a\x0b\x10\xd3\x6e\xac\x8e\x30\xeb\x18\xc7\xe5\x88\xaa\x8d\x92\xa5\xb4\x80\x94\xea\x90\x34\xdc\x43\x94\x75\xb8\xdd\xa1\x55\xb4\x0c\xa5\x45\xb4\xf9\x4e\x22\x44\x4b\x32\x97\x85\xd7\xe6\xde\x81\x9c\x6b\x2e\x1d\x1c\x78\xe6\x0a\x93\x3c\x7a\x74\x43\xdf\xfd\x6a\x87\x8f\x86\x6b\x5b\xb9\xd4\x48\x71\x35\x83\x00\x87\x06\xc9\xe0\x41\x4a\x31\xea\x60\x6a\xf4\xe2\xbe\x98\xd3\x91\x30\xb2\x61\xca\x60\x6f\xec\x5d\x88\x26\x0f\x73\x34\x59\xdc\xdf\xc7\x74\xcc\xad\xbb\x87\x8b\xab\x47\xd3\x37\x57\x21\xf6\xd1\x17\xd3\x7f\x26\x33\x00\x5a\x0c\xd8\x1a\xe6\x6a\x45\x64\x03\x04\x5a\xf0\x06\x44\x69\x91\xb5\x63\xc2\x74\x20\xd8\x9a\x8e\x53\x36\x13\xba\x5b\xa7\x6c\xe4\xb8\x7f\x7e\xde\xc9\x24\xcb\xcd\xbe\x71\x7d\x0f\x26\x0b\x1b\xdf\x24\x33\x8a\xfa\xee\x28\xe8\x39\xb8\x24\xc4\x4f\x25\x87\x78\x1e\x4c\x52\x2c\xc3\x0c\x11\x99\x25\x81\x0f\x61\x8a\x45\xda\x2c\xfa\x89\xfe\x76\x77\xb8\x0c\xf4\xd1\x0e\x42\xd7\x7f\xce\x5c\x64\xd8\x96\x11\xe0\xbf\x52\xc0\x33\xfd\xb7\x85\x3e\x19\x29\x62\x4e\xa5\x79\x5a\x93\x30\x1c\x4e\xe7\xe8\xf7\xf1\xfc\x17\xa4\x45\x17\xc6\x13\x78\xfc\xbd\x3e\x99\xa3\x9f\x3f\x26\x97\x26\x0f\xe8\xfd\x78\xf2\xaf\xe1\xfd\x42\xcf\x7e\x0f\xff\x38\xfc\x1e\x0d\x47\xbf\xe8\x48\x93\x91\xa9\xed\xf6\xa2\xa2\x52\x28\xde\xea\xef\x86\x8b\xfb\x39\xda\x41\x33\x7c\x31\x9d\xe3\x23\x0e\xe3\xa3\xeb\x6b\x1f\x6f\x56\x30\xca\x05\x9d\x62\x73\x59\x96\x0f\x33\x49\x46\x6c\x0d\xce\x3a\x82\x86\x22\x1d\xa4\x05\x66\x91\x9a\x03\x2f\x76\xcf\x88\x7b\x63\x08\xa6\xd8\x30\x99\xe2\x30\x11\x67\x89\x6b\x7d\xb6\xb8\x1d\x04\x7b\x10\x2b\x3f\x70\x3e\x10\xf5\x30\x9a\x48\xcb\x61\x9b\xd7\xf9\xcd\x82\x56\x44\x04\x3d\xfc\x3e\xd1\x6f\xc1\x96\x84\xd1\xf0\x7e\xae\x4f\x25\x84\x32\x5d\x85\xdb\x6f\x6c\x8b\x87\x0d\xaf\xd7\x78\xd5\x42\xd4\x25\x7a\x92\xb0\x2b\xf4\x19\x83\x37\xd2\xa7\x72\xae\x87\xe3\x71\x90\x2b\xf9\x83\xeb\x5b\xd8\xff\x81\x13\xcd\x51\x1c\xb3\x6f\x59\x38\x34\x6d\x27\x40\xff\x0e\xdc\xdd\x92\x1f\x6c\x0e\xb6\xe0\xd9\xe6\x7e\x48\xf4\x24\x7e\x80\x36\xd9\xc3\xfa\x95\x87\x2d\x16\x36\x1e\xcd\xe0\x51\xa9\x17\x7a\x3e\xfe\x62\xbb\xfb\xc0\x90\x3e\x98\xb8\xc5\x37\x77\x81\x19\x2f\x7d\xa3\x86\xc8\x70\xa4\xa3\x5c\xaf\x60\xe1\xd0\x10\x6a\xf2\x2b\xc7\x0d\x58\x89\x89\x2c\xe4\xb3\xdc\x54\x7c\xc6\xc7\x66\x28\x7d\x28\x96\xdd\x7b\x96\xb2\x6c\x16\x3a\xc9\xcf\xad\xe7\xfa\xe0\x16\x23\xdd\x8b\x28\x72\xd1\x4a\xf3\x01\x58\xcb\x03\x6f\x1b\xb2\x31\x33\x06\xd7\x18\x1b\x9e\xeb\x3a\xec\xbb\x64\x6b\xc4\x00\x11\x4e\x5b\x47\xb7\x21\x2d\x60\xff\x0b\x4f\x84\xcc\x43\xc3\x27\x23\x9a\x26\xd9\x7f\xf3\xa4\x3c\xdf\x0d\xdd\x95\xeb\x70\x79\xf5\x38\x51\x86\x4d\xe8\x41\xd1\xf4\x22\xbe\x1e\xec\x57\x2b\x48\x53\xeb\xbd\x63\x70\x03\x25\x21\x0e\x3d\x08\x1a\x41\x26\xe5\xbb\x30\x21\xf3\x57\x8f\x36\x30\x5c\x82\x9f\xb0\xb9\xcb\x40\x45\xb3\x4a\x85\x41\xff\x10\x80\x2d\xa5\xc3\xa2\xc2\xc2\x08\x25\x1f\x79\xa8\xa1\xd4\x60\x24\x54\x15\x32\x9e\xe9\x87\xf6\xca\xf6\xcc\x5d\xab\x94\xf2\x6a\x65\x19\xbf\x06\x63\xee\xe8\x5d\x95\x72\xbb\x49\x5c\x68\xe3\x5b\x25\xf5\x4a\x44\x1b\x26\x79\xa1\xad\x72\xd2\x67\x8b\x0b\x26\x01\xd9\x03\x2d\xc6\xa6\x6c\x91\x97\x1f\x4c\xb8\x0b\x41\xb2\xee\x59\xc5\x54\xa2\xfc\xdf\x30\xfd\x27\xe3\x9e\xbb\xf7\xc9\xea\x39\x8e\x6e\x4e\xe2\x4d\xc7\xad\x23\x98\xe7\xf3\x17\xa2\xfc\x7e\x00\xf4\x2c\xdc\xdc\x9d\xb1\x9a\xca\x63\x96\x78\xb6\x94\x24\x84\x3a\xb9\xdb\x85\x69\x9e\xcf\x35\x1b\xe5\x38\xd9\x9c\x2f\x16\x4a\x07\x53\x81\x48\xbc\x0b\xc0\x14\x88\x2c\x00\x10\x99\xad\x4c\x4e\x68\x2e\x93\x12\x58\x8c\x20\xd9\x01\x74\x38\xc7\x01\x87\x26\xe9\x2d\xcd\xc8\x64\x37\x66\x47\xcd\x3e\xe2\x6b\xf4\x8c\x24\xd2\x51\xf0\x20\x8d\x80\x79\x73\xf4\x30\x99\xcd\xa7\xc3\x31\x0c\x5e\x74\x58\x18\x39\x3f\x19\xd1\x49\x07\x82\x21\x6b\xf4\x2b\x3a\x3e\xce\x7b\xf0\x2d\xea\x75\x3a\x32\x55\xac\xc7\x53\xa7\xfd\x58\xf2\xa3\x82\x3e\xca\xa7\x05\xf5\x05\x87\x47\x00\x85\x5d\x29\x1b\x29\x5a\xcd\xa3\x3c\xc5\xaa\x99\x54\x65\x08\x6b\x92\x4b\x79\xf8\xda\xcd\xa6\x12\x2b\xdf\x2a\x9f\x56\x24\xdb\x30\xa3\x4a\xac\x95\x73\x2a\xef\x01\x41\x56\xcd\x3d\xd2\x6a\xac\xa6\xf1\x99\x87\xa4\xbc\x84\x4c\xc6\x7e\xc9\xc2\x54\x35\xf1\x8a\x73\x28\x53\xf6\x60\x9a\xbf\xc6\x32\xb9\x5d\x8f\xb7\x3e\xfd\x2e\x2b\x4c\x58\xab\xe1\xdd\x17\xec\x00\x28\xd6\xae\x2d\xdc\x86\xf5\xde\xde\x09\x39\x37\xb7\x30\x35\xc9\x2d\xc7\xe0\x0a\x21\x5f\xb8\x1a\xd8\x9b\x9d\x19\xee\x41\x11\xc3\xc9\x57\x83\xce\x9f\x9f\x0e\x53\x95\xff\xfc\x97\x35\x59\x01\x89\xc2\x32\x13\x6f\x5d\xce\xce\xdf\x41\xd7\x0e\x48\x0b\xa7\x3e\x07\x5d\x65\x35\x09\x21\x70\x9e\xb1\x84\x66\xb2\xa2\xed\xf9\x4b\x08\xd7\x0d\x2e\x2e\x3d\xd3\x4c\x5a\x1e\x05\xbf\xe2\xe5\xa3\xeb\x7e\x36\x56\x7b\x3f\x70\xfd\xda\x1d\x88\x56\x23\x1b\xda\x13\x29\x95\x78\xce\xe5\x3e\xda\x06\x19\x43\xe8\x7c\x07\xe6\x7e\x42\x1a\x2b\xc7\xa5\x4f\x5a\xd8\x81\x55\xb3\x6f\x37\x98\x32\x96\x55\x49\x66\xe0\xc1\x7e\x19\xac\x7c\xdb\x13\xe6\x2f\xcf\x7c\x76\x5c\x93\x7d\x2a\x11\x86\x78\xeb\xe5\xce\x5e\x78\x1b\x10\x64\x2b\xdd\x48\xa4\xab\x4d\x37\xa3\x33\x44\xec\xfb\x6e\x7e\xdf\x42\xad\x77\x0b\xf2\x6b\xd9\x53\xed\x64\x54\xae\xde\x97\xce\xa1\xca\x84\x6a\x66\x4d\xae\xfe\x43\x9e\x2c\x8b\x30\x32\x63\x2a\x94\x8f\xbc\xe6\x01\x4f\x69\x93\xc4\xfc\xde\x77\xa4\xe7\x8a\x01\x86\x08\xab\x94\xd3\x44\x7b\xb0\xb2\x83\x16\xb5\xf3\x15\xd5\x63\x95\x58\x2e\xde\x8c\x37\xf2\xcb\xe2\x96\xbb\x0e\xe5\xf3\x76\x7b\x0f\x4b\xf5\xb7\xea\x40\x2a\xb4\x1a\xf6\x21\x96\x89\x72\x37\xa2\xa4\x04\x73\xcc\x64\x4f\x13\x04\x12\x94\xc9\x18\xac\x84\x2d\xee\x46\x0f\x93\xfb\xe2\x79\x11\x8a\xef\x8f\x1e\xee\x17\xef\x27\xa4\x2f\x91\x5a\x01\xfe\xc1\x68\xfe\x08\x2a\x7f\x2c\x5a\x6d\xeb\xac\x3d\x12\x1c\xfd\x95\x48\x09\xb7\xdc\x54\x48\x72\x17\x97\xad\xd1\xe4\x5a\xa8\x44\x54\xb2\x12\x12\x51\x65\xcc\x38\x1a\x93\x63\xe8\x54\xa2\xc3\x4d\x51\x2a\x04\xe8\x0c\xd2\x1a\x07\x5a\x6d\x25\x1a\xac\x51\x82\xcd\xe4\xd6\x84\xf5\xc2\x1a\x26\x48\xe2\x4a\x1d\x74\x3b\x9c\x0f\x25\x44\x38\x2a\x45\x15\x2f\x2a\x6a\xc7\x93\x99\x0e\x03\x37\x4c\x94\x1f\x4a\x55\x2f\xd1\xc8\x3c\x43\xc7\x47\x9a\x61\xef\xec\xd0\x36\x1d\x23\x88\x74\xbd\x09\xfe\x72\x8e\xba\xe8\xa8\xdf\xd3\xae\x4e\x7a\xda\xc9\xa9\x86\xb4\xcb\xeb\xfe\xc5\x75\x7f\xf0\xe6\x42\x3b\x3d\xbb\xb8\xf8\x47\x4f\x3b\x02\x3f\x28\x69\xef\x83\x76\x0b\x3f\xd1\x01\xbe\x84\xe0\x77\x6d\x4b\x68\xa9\x3f\x38\x3b\x1b\x54\xb1\x74\x6a\xec\x03\x9c\xad\x71\xc1\xac\x51\xac\x1f\x11\xda\x3b\xed\x5d\x55\xb3\x77\x66\x98\x96\x65\x14\xcf\x04\x85\x36\xce\xb4\xb3\x0b\xad\x8a\x8d\x73\x23\x9e\x37\xa4\x7b\x7b\x51\x2d\x99\xd0\xc4\xf9\x79\x7f\x50\x89\xc6\x20\x35\x91\x24\x13\xb9\x89\x81\x36\xe8\xf5\xab\x98\xb8\x30\xb6\xae\x65\xaf\x9f\xd5\x59\x5c\x9c\x6b\x57\x95\xc2\xec\x92\x62\x11\xf7\x42\x05\x3b\x97\xfd\xde\xf9\x69\x35\x3b\xa4\xd1\xcd\xcd\x06\xc6\x03\x13\x82\x4b\x1c\x53\x97\x67\x83\x41\x25\x4f\x5d\x45\xea\xe3\xf3\x62\xe3\xc9\xf2\xc5\xda\x2f\xce\x2e\x2b\x81\xd7\x7a\x91\xfa\xa4\x15\xa2\x7d\x72\xa1\x81\xab\xde\x79\xff\xac\x92\x01\x2d\x6f\x20\xdb\x78\x25\x03\x80\xd8\xd0\x79\xbf\x5a\x44\x69\x7d\xaa\xa1\x93\xad\xee\xf8\x25\x04\x91\xa5\xcb\x9e\x76\x7e\x59\x29\xb0\xb4\xd3\x98\x4e\x76\x40\x10\x88\xf5\x5f\x0d\x4e\xab\xb9\xec\xcc\x58\xdb\x4f\x09\x1b\x52\x17\x09\x3f\xb1\x23\x1c\x1a\x2f\x35\xad\x3f\xb8\xac\x64\xe4\x3c\xad\x5b\x49\xeb\x09\x9e\xc4\x34\xb4\x7e\xc5\xc8\x1a\x18\x49\xfe\x94\xe8\x3d\x3f\xd3\xaa\x35\xf4\x05\x84\xcf\x06\x96\x4b\x46\xb9\x12\x42\x62\xaa\x62\xfa\xd0\x2e\x29\xdd\x24\x47\x91\x2d\x36\xb1\x8d\x8a\x03\xa1\x76\x55\x2a\x8a\x10\xeb\xbf\xa8\xe6\xad\x7e\xcf\xa0\x37\xc4\xc4\xda\x2f\x7b\x95\x1a\xb9\xaf\xa5\x35\x56\x46\xbe\xe0\x44\x6c\xe3\xaa\x97\x76\x07\xce\x1c\x47\x58\x5f\x5a\x65\xee\x54\xa9\xf6\x96\xcc\x01\x25\x7a\x93\xf7\x15\x0e\xaf\x1a\xbd\x81\xf6\x12\xd6\xa5\x76\x91\xd6\x8d\xcb\x6d\x14\xe8\x96\x6b\x6c\x1a\x90\x15\x96\x39\xb6\x42\x95\x5a\x69\x56\x21\xca\x2a\x73\x6c\x30\x25\x16\x55\x0d\xb6\xa0\x56\xa1\x6e\xa8\x7e\x33\x55\x2b\x5c\x69\xa3\xd9\xc4\x6b\xe9\x2a\xcd\xc8\x29\x54\x69\xc1\xe5\x8c\x7a\x8d\x76\xb4\xca\x8f\xae\xeb\x37\x65\xd5\x33\xd3\x36\x1a\x53\xb6\x5f\x50\xa5\x39\xb9\x27\xa4\x0d\x5c\x2f\x38\x36\x6a\x41\x2b\xe7\x9c\xa6\x7a\x13\xaa\x6f\xd9\x37\x69\x34\xfe\xae\x88\x4a\x33\xc9\xb7\xeb\xeb\xf3\x56\xda\x69\x6d\x83\x3a\x73\x27\x85\xc9\xbe\xb4\x81\x92\xff\xdb\xf0\x3e\xe3\xe7\x14\xe0\xe1\xe8\xaf\xea\xd6\x50\x4e\x63\xfc\x56\xe2\xed\x6d\xfe\x20\xb1\x68\x10\x7d\x98\x8e\xdf\x0f\xa7\x1f\xd1\xaf\xfa\x47\x74\x6c\x5b\xb2\xb7\x91\x8a\xbf\x5b\x42\x5d\xd0\xca\x42\xce\x32\x2c\x45\x5f\xd8\x75\x2e\xa4\xf9\xc3\x99\x88\x71\x38\x44\x31\xf2\x67\x20\x46\x2b\xec\x68\xb3\x2c\x72\xb5\x80\xa1\xc5\x64\x0c\x71\x8c\x8e\x0f\xe2\xdd\xdc\x69\x50\x97\x3a\xcd\xa9\xe8\x1a\xef\xfb\x10\xaf\xd4\xa8\x9c\x5d\x78\xc9\xa4\xa0\x5d\x66\x6c\x23\x22\xa6\x02\x58\xca\xcc\xb9\x1b\xf3\xd2\x1c\xda\x2e\x7b\x9e\x19\x11\x7f\x21\x34\xa9\x07\x0a\xc5\x16\x85\xba\x88\x76\xd8\xd1\x4a\x59\x5c\x18\x66\x95\x91\xe7\xce\x1d\x18\xc9\xb3\x5d\x06\x07\xc5\x22\x16\x05\xf3\xca\x4c\xe8\xd3\x07\x76\x3a\x6c\x97\x0f\xa5\x5b\x44\xa9\x0c\x42\xca\x2a\x1e\x2c\x97\xcf\xd1\x38\x9a\x42\x1e\x4f\x6e\xf5\x3f\xd4\x8e\x99\x23\x51\x5a\x0b\x80\x2f\x0e\xb3\x8b\xd9\x78\x72\x87\x96\xa1\x8f\x71\x7e\xdc\xe6\xa3\x89\x47\xef\xe6\x78\x92\x33\x7d\x25\x44\x9c\x8c\xb1\xcc\xb6\x02\x6a\xc3\x39\xa8\xc8\x23\xa1\x4a\x0f\x69\x3c\xb1\x70\xb7\x54\xdb\xc7\x02\x47\x4a\x14\x9b\x20\x8b\x4a\x1c\x95\x60\x15\x0b\x23\x59\x68\xe2\x95\x7b\x13\x3c\xb1\x06\x35\x44\x85\xaa\xcb\x6e\xb9\xc0\x92\x85\x91\xec\xfc\x35\x41\x18\x15\xe7\x29\xe1\xcb\x4a\x02\xbb\x51\x45\x1f\x33\xb3\x19\x98\x04\x6a\x04\xb6\x06\xa8\x64\x32\x14\x63\x2b\xa8\xcb\x63\x4c\xdf\x23\xa5\xe0\xb1\x5e\x7c\xe8\xa6\x2f\x39\xf0\xc0\x1e\x8e\x71\x1b\xc2\xb4\x2d\x65\x80\x87\x2a\xef\x2e\xaa\x01\xda\xf5\x0c\xaf\x2d\xdc\x89\xae\x3c\x74\xce\x8c\xac\x16\x13\x36\x81\xf0\xa9\x3d\x02\x89\x2e\x4e\x00\xd7\xa4\x40\x97\xec\x97\x49\x80\xd7\xc8\x50\xe3\xd6\xe2\x90\x80\x3f\xe8\xa8\xeb\x7c\xb1\xa3\x5d\x93\xe8\x8f\x72\x41\x63\x47\xe7\x74\xb1\xc1\xb2\xd2\x50\xf1\x8d\x48\xd5\x08\x89\x8d\x35\x74\xae\x29\x76\xae\x08\xaf\x18\x5d\xf6\x56\x35\x49\xc7\xcd\x43\x98\x56\x97\x07\x9b\xbe\x22\x4e\x61\x64\x23\xca\x87\x6b\x5b\xb0\x4a\x3a\xd5\x52\x04\x0b\x60\x18\x47\x7a\xd8\xa4\x41\x0f\x3a\xea\xf7\x74\x59\xaf\x0e\x7d\x8b\x18\xc9\xbf\x9e\xd6\x00\x70\x59\x59\x01\x39\x79\x63\x8f\xc2\x59\x78\x2f\x4e\x0c\x30\x3a\xe2\x6d\x07\x5e\xa4\x4a\x09\x5c\x7a\xae\xcc\x85\x56\x78\xe3\xae\x31\xbe\x82\x3e\x19\xc8\xf2\x0b\x7f\x52\xa4\xed\xf8\x91\xd2\xa6\x8a\x52\xea\xcd\x76\xb0\x29\x61\x12\x63\x49\x11\x3b\xb0\x2c\xdb\x7b\xcd\x10\xd1\xba\x94\x5b\x34\x7d\xa5\x90\x89\xcf\x33\x6d\x3f\xfa\x32\x64\x2b\x08\x8b\xda\xd4\xfa\x6d\x96\xe5\x8a\x90\xbb\xa5\x37\x69\x39\x24\x5a\x18\xb7\x13\x3d\x32\xc4\x15\x27\x9d\x44\x6b\x6b\xde\xad\xe0\x58\xa9\xdf\xe2\x72\xbd\xd2\x11\x37\x49\xf0\xf1\xc7\x95\x9a\x3a\x54\x6a\x80\x5a\x8b\xa7\x1f\x8b\xa2\x57\xbf\xb1\x60\x05\xec\xcd\xe3\x40\xa4\x5b\x8e\x98\xd1\xcb\x68\x85\xc9\xe2\x86\xe8\x23\xab\xc1\xda\xf1\x20\xd4\x2a\x5d\x4d\x11\x21\x09\xd0\xb4\x04\x84\xbc\x08\x98\x06\x51\x4b\x68\x59\xaa\xa5\xd3\x37\xd5\x48\xce\x29\x6f\x3b\x18\x28\xd5\x75\xe6\x9b\x7c\x75\x85\x2f\xe9\xb4\xef\xe8\xd2\xb7\x7a\xa4\xf0\x0b\x0f\xa8\x93\xc9\x7d\x3a\xe9\xc5\xfc\x9f\xff\x3c\x93\x8c\x49\x4e\x56\x9d\x04\xeb\x43\x50\x2f\xc6\x86\xf9\xd5\x29\x19\x2d\xd6\x43\xea\xfc\xd2\x8d\xb2\x17\xe3\x94\xbd\x84\x2c\xe3\xc1\xdd\xd1\xa4\x55\x1f\x0a\x53\x5e\xa2\x6b\x17\xb5\x33\x97\xbe\x55\x3b\x38\xad\x94\x5e\x42\xb5\xd4\xc3\x45\x26\x54\x38\x48\xd6\x75\x42\x63\xed\xa5\xaf\xb2\x62\x25\xec\xf2\x24\x46\x55\x7a\xbe\x40\xd8\x94\xf5\xd7\x5e\xea\xc7\xd5\xc7\x69\x22\x4f\x37\x6e\x8d\x25\xcc\xf6\x6a\x7b\x59\xa0\x53\x3a\x45\x38\x3e\x4e\x3f\xec\x73\xf2\xf6\x2d\x3a\x0a\x5c\xc7\xca\x9d\xc5\x1f\x5d\x5f\x93\xd7\x85\x3b\x9d\x2e\xe2\x0b\x92\x83\x1d\x25\xc1\xf8\xbc\x85\x2f\xba\x74\xf7\x9b\xc7\x50\xc9\x3c\x25\x2a\x06\x40\x89\x16\x20\x74\xc8\x67\xab\xa7\x7a\x1c\x64\xe8\x27\x74\x7a\xaa\xf2\x8e\x33\x78\x38\xff\x26\x76\xed\x76\x93\xab\x26\xcd\xc7\x38\x50\xa5\x5a\xb0\xf0\x52\xb8\x72\x1d\x8e\x6d\x19\xeb\xdc\xa9\xe5\xbb\x5f\xbf\x4d\x35\x4e\x62\x16\xbd\x7b\x98\xea\xe3\xbb\x49\x76\x4e\x89\xa6\xfa\x3b\x68\x8a\xc9\x48\x9f\x15\x8e\xee\xa2\xbb\xe0\x88\xc5\x87\x5b\xe2\xbb\xa9\x1e\x7f\x8c\x9c\x5c\xba\xd5\xef\x75\xb8\x34\x1a\xce\x46\xc3\x5b\x5d\xfc\x09\x29\xf6\x37\x7f\xb2\x6d\x90\xf6\x9c\x41\xdb\x91\xd4\x08\xf0\x90\xd0\xfe\x29\xee\x7b\x31\x9d\x95\xac\x54\x24\x05\x15\x5c\x4f\x24\x6b\xf1\xef\xee\x87\x3c\x0e\x96\x17\xd2\x6d\x0e\x71\xc0\x54\xf3\x40\x79\x57\xec\x3b\xba\x81\x03\x86\xf6\x05\x63\x1f\xaf\xdd\xa0\x28\xee\xd1\xfc\x3f\x38\x84\x1f\x1a\xa5\x4d\xb0\x6a\xd1\xa1\x56\xb4\x52\xf8\xce\x47\x7b\x1e\xa9\x5d\xc7\xc2\x42\x44\x3b\xa6\x20\x41\xf9\x85\x59\x45\x92\x8e\xb6\xbc\xa1\x95\xf7\x3f\xba\x41\x2b\x77\xeb\x39\x38\xc4\x11\xc5\xff\x01\x9d\x9e\x4a\xdc\x15\x67\x00\x00")
 
 func latestSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -99,7 +105,7 @@ func latestSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "latest.sql", size: 21159, mode: os.FileMode(420), modTime: time.Unix(1548955647, 0)}
+	info := bindataFileInfo{name: "latest.sql", size: 26389, mode: os.FileMode(420), modTime: time.Unix(1792274178, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -224,6 +230,86 @@ func migrations15_ledger_failed_txsSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations16_webhooksSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa5\x93\xc1\x6e\x82\x40\x10\x86\xef\x3c\xc5\x1c\x35\xad\x49\xdb\xb4\x5e\x3c\x51\x58\x1b\x53\x8a\x06\x25\xa9\x27\xb2\xc0\xa8\x9b\x22\x4b\x76\x07\xad\x7d\xfa\xae\x85\x1a\x45\x4c\x4d\xca\x91\xf9\x86\x99\xf9\xfe\xd0\xeb\xc1\xcd\x5a\x2c\x15\x27\x84\xb0\xb0\x2c\x27\x60\xf6\x8c\xc1\xcc\x7e\xf6\x18\x6c\x31\x5e\x49\xf9\x11\xe9\x32\xd6\x89\x12\x05\x09\x99\x6b\xe8\x58\x60\x1e\x91\x42\x2c\x96\x1a\x95\xe0\x19\x4c\x82\xd1\x9b\x1d\xcc\xe1\x95\xcd\x6f\x7f\xaa\xa5\xca\x20\x59\x71\xc5\x13\x42\x05\x1b\xae\x76\x22\x5f\x82\x3f\x9e\x81\x1f\x7a\x5e\xc5\x68\x4c\x14\xd2\x39\xd6\xe9\x3f\x76\x1b\x28\x4f\x12\x59\xe6\x17\xd8\x1a\xd1\x1a\x29\xa2\x5d\x81\x7f\x53\x89\x4c\xdb\xa8\xfb\x87\x13\x4a\x68\x5d\x9a\xea\x39\xf7\xd4\xaf\x39\x5c\x2c\x30\xa9\x87\x8a\x9c\x70\x89\xaa\x2a\x98\xc3\x8c\xd0\x34\xe2\x04\x24\xd6\xa8\x89\xaf\x0b\xd8\x0a\x5a\xc9\xb2\x7a\x03\x5f\x32\xc7\xc3\x91\x56\x77\x70\xc1\x7c\x8a\x99\xd8\x18\xc7\x78\x9d\xf6\xe3\xa0\xa2\x0a\x35\x7b\x1d\xe6\x40\xc0\x86\x2c\x60\xbe\xc3\xa6\xed\xd9\x76\x44\xda\x85\xb1\x0f\x2e\xf3\x98\xd9\xc5\xb1\xa7\x8e\xed\xb2\xea\xdb\x05\xdf\x65\x92\xa7\x40\xf8\x49\xcd\x78\x88\x70\x5d\x90\xfe\x95\x60\xfa\x87\x76\xe8\xcd\xe0\xae\x01\xe6\xa6\x37\xaa\xe9\x6b\xe5\x54\x9d\x19\xd7\x14\xa1\x52\x52\xfd\x2c\xf0\x6f\xcd\x23\xdf\x65\xef\x2d\x9a\xa3\x78\x17\x1d\x6f\xb9\xb7\xd1\x12\x46\x38\x1d\xf9\x2f\x10\x93\x42\x84\x4e\xe3\xaa\xfd\x94\xde\xd1\x6f\xe5\xca\x6d\x6e\x59\x6e\x30\x9e\x5c\x0c\x77\xd0\x56\x3e\x49\x66\x60\x7d\x03\xf2\xe6\xb5\xcb\xaa\x03\x00\x00")
+
+func migrations16_webhooksSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations16_webhooksSql,
+		"migrations/16_webhooks.sql",
+	)
+}
+
+func migrations16_webhooksSql() (*asset, error) {
+	bytes, err := migrations16_webhooksSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/16_webhooks.sql", size: 938, mode: os.FileMode(420), modTime: time.Unix(1792263088, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations17_ingest_failed_transactionsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x8e\xb1\x0e\x82\x30\x18\x84\xf7\x3e\xc5\xed\x5a\x5f\x80\x09\x2d\x5b\x15\x43\x60\x36\xa5\xfe\xd0\x26\xb5\x35\x6d\x09\xf1\xed\x45\x07\x03\x93\xe3\xe5\xee\xbe\x7c\x9c\x63\xf7\xb0\x63\x54\x99\xd0\x3d\x19\xe3\x1c\x97\x4e\x4a\x0c\x21\x22\x1b\x42\x8e\xca\x27\xa5\xb3\x0d\x3e\xc1\xfa\x91\x52\xa6\x3b\x7a\x5a\x7a\xc2\xa0\xac\x5b\xd2\x66\x33\x53\xa4\x3d\x66\x63\xb5\xf9\xc0\xd4\x32\x53\xce\x21\x4d\x5a\x53\x4a\xc3\xe4\x0e\xac\x94\x6d\xd5\xa0\x2d\x8f\xb2\x82\xb1\x29\x87\xf8\xba\x6d\x18\xa5\x10\xab\x03\xfa\x10\x1c\x29\x5f\x7c\xed\x7e\xb6\x22\xcc\x9e\xfd\x67\x89\xa6\xbe\xe2\x54\xcb\xee\x7c\x59\x31\x0b\xf6\x06\xbb\x98\xa0\x23\xf9\x00\x00\x00")
+
+func migrations17_ingest_failed_transactionsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations17_ingest_failed_transactionsSql,
+		"migrations/17_ingest_failed_transactions.sql",
+	)
+}
+
+func migrations17_ingest_failed_transactionsSql() (*asset, error) {
+	bytes, err := migrations17_ingest_failed_transactionsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/17_ingest_failed_transactions.sql", size: 249, mode: os.FileMode(420), modTime: time.Unix(1792264488, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations18_transactions_by_memoSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd3\xd5\x55\xd0\xce\xcd\x4c\x2f\x4a\x2c\x49\x55\x08\x2d\xe0\xe2\x72\x0e\x72\x75\x0c\x71\x55\xf0\xf4\x73\x71\x8d\x50\x48\xaa\x8c\xcf\x4d\xcd\xcd\x57\xf0\xf7\x53\xc8\xc8\x2c\x2e\xc9\x2f\xaa\x8c\x2f\x29\x4a\xcc\x2b\x4e\x4c\x2e\xc9\xcc\xcf\x2b\x56\x08\x0d\xf6\xf4\x73\x57\x48\x2a\x29\x4a\x4d\x55\xd0\x00\xa9\x8c\x2f\xa9\x2c\x48\xd5\x51\x00\x31\x35\xad\xb9\xb8\x74\x91\x0c\x77\xc9\x2f\xcf\xe3\xe2\x72\x09\xf2\x0f\x40\x35\xdc\x9a\x0b\x00\x80\x13\x79\x96\x83\x00\x00\x00")
+
+func migrations18_transactions_by_memoSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations18_transactions_by_memoSql,
+		"migrations/18_transactions_by_memo.sql",
+	)
+}
+
+func migrations18_transactions_by_memoSql() (*asset, error) {
+	bytes, err := migrations18_transactions_by_memoSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/18_transactions_by_memo.sql", size: 131, mode: os.FileMode(420), modTime: time.Unix(1792265214, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations19_operation_assetsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x90\xc1\x0a\x82\x40\x10\x86\xef\xf3\x14\x73\x34\xd2\x27\xf0\x64\xb9\x84\xb0\xac\x65\x2e\x74\x13\xa5\x41\xf7\x90\x2b\xbb\x0b\xd1\xdb\xb7\x85\x48\x5a\x11\xcd\x75\xbe\x7f\xbe\x9f\x89\x22\x5c\x5f\x54\x6b\x6a\x47\x28\x07\x80\x6d\xc1\x92\x92\x61\x99\x6c\x38\xc3\x4e\x59\xa7\xcd\xad\xd2\x03\x79\x40\xe9\xbe\xaa\xad\x25\x67\x31\x00\xf4\xf3\xbe\x56\x67\x6c\x54\xab\x7a\x87\x22\x2f\x51\x48\xce\xc3\x19\xf9\x8c\x3f\x28\x8f\x50\x4b\x66\xc2\x60\x15\x4f\x6e\x29\xb2\x83\x64\x98\x89\x94\x9d\xb0\xd3\x75\xd5\x8c\x41\xcc\xc5\xf7\x4a\xf2\x98\x89\x1d\x36\xce\x10\x61\xb0\xf4\x85\x1f\xbb\x7a\xe7\xa8\x9c\xb9\x3a\xed\x0b\xfe\xad\x5a\x1c\x86\xe8\xe5\xb1\xa9\xbe\xf6\x00\x69\x91\xef\x7f\x3c\x36\x86\x3b\x3b\xe5\x60\x3f\x90\x01\x00\x00")
+
+func migrations19_operation_assetsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations19_operation_assetsSql,
+		"migrations/19_operation_assets.sql",
+	)
+}
+
+func migrations19_operation_assetsSql() (*asset, error) {
+	bytes, err := migrations19_operation_assetsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/19_operation_assets.sql", size: 400, mode: os.FileMode(420), modTime: time.Unix(1792265598, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _migrations1_initial_schemaSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\x5f\x6f\xdb\xc8\x11\x7f\xf7\xa7\x18\xdc\x8b\x6c\xd4\x6a\x2f\xb8\xe2\x70\x95\xe1\x03\x14\x99\x69\x84\xca\x54\x22\x51\x4d\x82\xc3\x61\xb1\x22\x47\xd4\xd6\xe4\x2e\xb3\xbb\x74\xa4\x2b\xfa\xdd\x0b\x52\x24\xc5\xff\xa4\x1c\xc9\xf7\x28\xee\xec\xcc\xfc\x66\x66\x7f\x33\x5c\x6a\x38\x84\xbf\xf8\xcc\x95\x54\x23\xac\x82\xab\xe1\xf0\x6a\x38\x84\x0f\x42\x69\x57\xe2\xf2\xe3\x0c\x1c\xaa\xe9\x9a\x2a\x04\x27\xf4\xe3\xe5\xab\xa5\x61\x81\xd2\x54\xa3\x8f\x5c\x13\xcd\x7c\x14\xa1\x86\x7b\xf8\xf1\x2e\x5e\xf2\x84\xfd\x54\x7d\x6a\x7b\x2c\x92\x46\x6e\x0b\x87\x71\x17\xee\x61\xb0\xb2\xde\xfd\x32\xb8\x4b\xd5\x71\x87\x4a\x87\xd8\x82\x6f\x84\xf4\x19\x77\x89\xd2\x92\x71\x57\xc1\x3d\x08\x9e\xe8\xd8\xa2\xfd\x44\x36\x21\xb7\x35\x13\x9c\xac\x85\xc3\x30\x5a\xdf\x50\x4f\x61\xc1\x8c\xcf\x38\xf1\x51\x29\xea\xc6\x02\xdf\xa8\xe4\x8c\xbb\x77\x57\x09\x3c\x93\xfa\x38\x82\xc0\x0b\x5c\xf5\xd5\xbb\x03\x6b\x1f\xe0\x08\x8c\xcf\x96\x61\x2e\xa7\x73\xf3\x0e\x96\xf6\x16\x7d\x3a\x82\xe1\x1d\xcc\xbf\x71\x94\x23\x18\xc6\xc8\x27\x0b\x63\x6c\x19\x47\x49\x98\xbe\x03\x73\x6e\x81\xf1\x79\xba\xb4\x96\xa9\x42\xf8\x34\xb5\xde\xc3\x72\xf2\xde\x78\x1c\x43\xe0\x12\x9b\x6a\xea\x89\xc8\x7a\xc1\xfc\x51\x4b\xc9\x91\xc9\xfc\xf1\xd1\x30\xad\x16\x37\x0e\x02\x30\x37\xab\x4a\x60\xba\x84\xc1\x87\xd9\xdf\x02\x37\x4a\x5e\x20\x85\x8d\x4e\x28\xa9\x07\x1e\xe5\x6e\x48\x5d\x1c\x94\xfd\xd8\x2a\x2d\x24\x9e\x2f\x0a\x07\x7d\xc5\x20\x84\x6b\x8f\xd9\xcd\x01\x28\xba\xf0\x32\xfc\x89\xd9\x08\x7e\x54\xb2\xa0\xf7\x01\xc2\x46\x48\x88\x9e\x47\x15\xa7\x50\x2b\x10\x1b\xb8\x7e\xc2\xfd\x2d\x3c\x53\x2f\xc4\x1b\x08\x28\x93\x2a\x0e\x49\x5c\x86\x48\xa5\xbd\x25\x01\xd5\x5b\xb8\x4f\xbc\xbe\x2d\xa6\x30\x12\x73\x70\x43\x43\x4f\x13\x4d\xd7\x1e\xaa\x80\xda\x18\x95\xf3\xa0\xb4\xfa\x8d\xe9\x2d\x11\xcc\xc9\x55\x68\x31\xee\x2c\xf2\x6c\x4f\xa8\x6d\x8b\x90\x6b\x95\xc2\xb7\xc6\x6f\x67\xc6\x11\x7c\x12\xbb\x2c\x02\x77\x60\x65\x66\x47\xf9\x7c\xc4\xfb\x2a\x5a\xe1\xfa\x0a\x00\x80\x39\xb0\x66\x2e\xe3\x3a\xce\x94\xb9\x9a\xcd\x6e\xe3\xe7\xd4\x71\x24\x2a\x05\xf6\x96\x4a\x6a\x6b\x94\xf0\x4c\xe5\x9e\x71\xf7\xfa\xe7\xbf\xdf\x5c\xdd\x54\x6a\x25\xd1\x8e\x9b\x0d\xda\xe7\x76\x39\x51\x9a\x78\x5c\x02\x42\x9a\x10\xa4\x72\x22\x40\x49\x63\x5e\x68\x92\xfc\x41\x48\x07\xe5\x0f\xc0\xb8\x46\x17\x65\x69\x35\xae\x97\xfa\x25\x07\x35\x65\x9e\x82\xff\x28\xc1\xd7\xcd\x41\xf1\xd0\x71\x51\x9e\x39\x28\x89\xd2\x24\x28\x0a\xbf\x86\xc8\xed\x26\x47\x0f\xc2\x64\x4b\xd5\xb6\x3e\xa3\x25\xf9\x40\xe2\x33\x13\xa1\x22\x9d\x1b\x93\x18\x49\xca\x15\x3d\xb0\x6f\x9c\x95\xcc\x8f\x07\xe3\xdd\x78\x35\xb3\xe0\xc7\x92\x85\x63\x56\xfa\xc9\xdb\x9e\x50\xe8\x10\xaa\x21\xea\x20\x4a\x53\x3f\x80\xe8\x20\x45\xbd\x24\x7a\x02\x7f\x08\x8e\xe5\x3d\x12\xa9\xee\xdc\x74\x90\x0d\x03\xa7\xb7\x6c\x56\x47\xc9\x4f\x3f\x10\x52\xa3\x24\xcf\x28\x15\x13\xbc\x82\xe5\x4d\xb9\xa2\x84\xa6\x1e\xb1\x05\xe3\xaa\xbe\x20\x37\x88\x24\x10\xc2\xab\x5f\x8d\x9a\x2e\xd9\x60\x53\xae\xe3\x65\x89\x0a\xe5\x73\x93\x88\x4f\x77\x44\xef\x88\x42\x4d\x14\xfb\xa3\x2a\xd5\x5c\xca\xc7\xb4\x05\x54\x6a\x66\xb3\x80\x9e\x9d\xa1\xea\x6d\x1c\xf9\xaa\x1e\x53\xff\xe3\xde\x4d\x20\xa7\xe2\x27\xcc\x21\x0a\xbf\xa6\x61\x58\x1a\x1f\x57\x86\x39\x69\x89\x44\x1e\x7c\x2a\xdd\xcf\x46\x8c\x60\x69\x8d\x17\xd6\xa1\x91\xbe\x89\x1f\x4c\xcd\xc9\xc2\x88\x5b\xdf\xdb\x2f\xc9\x23\x73\x0e\x8f\x53\xf3\xdf\xe3\xd9\xca\xc8\x7e\x8f\x3f\x1f\x7f\x4f\xc6\x93\xf7\x06\xbc\x39\x0b\x50\x98\x7f\x32\x8d\x07\x78\xfb\xa5\x03\xf1\x78\x66\x19\x8b\x13\x01\x67\xba\x3b\xc4\xff\xca\x9c\x4e\x2c\x97\x2a\xd4\xae\x66\x9a\xa7\xc7\xc6\x86\x1b\x04\x1e\xb3\x0f\xb8\xe2\x7e\xf4\x9d\xed\xe8\xf0\x48\x89\x50\xda\x98\x96\x7a\x03\xf7\xa7\x3c\x35\x18\x8c\x46\x15\x89\x1e\x87\x22\x0f\xef\x72\xb4\xd0\x64\x25\x8e\x7d\x03\x2d\xd4\xed\xad\x4f\xc0\xf7\x90\x42\x93\x67\xe7\xa5\x85\x0e\x2b\xaf\x45\x0c\x27\x82\xfd\x4e\x6a\xe8\xb0\x56\x25\x87\xa6\x0d\x2d\xf4\x90\xdb\x72\xb9\x92\x4d\x29\x22\xef\x5f\xef\x71\x2c\x99\xc2\x3a\x86\xbc\xbe\x0c\xd2\x4e\x06\xb5\xb2\x47\xd3\xcd\xf3\x0a\x6d\x6c\xcd\x4d\xb3\xde\x9f\x32\xad\xe9\x1d\x41\xfe\x8c\x9e\x08\x10\x34\xee\x2a\x54\xbd\x8b\x66\xa7\xd0\xd3\x0d\x8b\x3e\x46\xaf\x90\xb5\x4b\x51\x14\x9a\x96\x15\x73\x39\xd5\xa1\xc4\xba\x37\xaa\x7f\xfc\x7c\xf3\xdb\xef\x47\x16\xfe\xef\xff\xea\x78\xf8\xb7\xdf\xcb\x43\x1c\xfa\x82\xc4\xdd\xa0\xca\xd9\x99\x2e\x2e\x38\xb6\xb2\xfa\x51\x57\x55\x4d\x82\x8c\xf9\x48\xd6\x22\xe4\x8e\x8a\x32\xf7\x8b\xa4\xdc\xc5\x98\x0c\xf3\x87\x89\x39\xe9\xd1\x49\x6c\xf7\x3a\xef\x87\xe3\x32\x37\x67\x5d\xdd\x1d\x0e\xf2\x93\xf9\x6c\xf5\x68\x46\x29\x8d\x5e\xa8\x53\x94\x1c\x77\xfa\x99\x7a\xd7\x83\x5e\x03\xc5\x60\x34\x92\xe8\xda\x1e\x55\xaa\xc2\xe8\x67\x43\xd1\xd8\xac\x4e\xc2\xd1\xc1\x7e\x6d\x48\x3a\x42\x11\x3c\xe1\xfe\x78\xad\x62\x2e\xad\xc5\x78\x6a\xb6\xa0\xad\x12\xde\x89\x09\x8c\x4b\x69\xfc\xf0\x90\xb3\xd6\xc7\x47\xf8\xb0\x98\x3e\x8e\x17\x5f\xe0\x5f\xc6\x17\xb8\x66\xce\xe9\x3d\xf8\x82\x48\x9b\x6c\xb6\x61\x6d\xf5\xb3\x13\xed\x3a\x1b\x50\x52\x48\x53\xf3\xc1\xf8\xfc\x82\x46\x15\xef\xcb\xe9\x83\xb9\x59\xdf\xb6\x56\xcb\xa9\xf9\x4f\x58\x6b\x89\x08\xd7\x89\xf0\x6d\xa5\x2f\xd4\x79\x1a\xb5\xb7\xb3\xb9\x19\xf7\xca\x5e\x3e\x96\x3b\x6c\x9d\x6b\x87\x86\x7a\x36\xe7\x0e\xea\xfa\xb9\x57\xea\xe5\xb7\xd5\xb6\x5d\x5b\xe3\x04\xc9\x7a\x7f\x58\xff\x5e\xb7\x57\xe6\xf4\xe3\x2a\xf5\xbe\xa4\x3b\x8f\x21\xbd\x76\x2b\xb8\x5f\xf7\x9a\x7d\x9b\xde\xa0\x35\x79\x7e\xa4\xd5\x73\xfa\xcc\x9c\xde\xde\x1e\xa7\xfa\xdb\xda\x8b\x82\x0e\x04\x22\x20\xc1\x45\x40\x24\x8a\xf3\x38\x1a\xfa\xdf\x8b\x60\x55\xd1\x64\x37\x7a\xeb\xfd\xd9\x01\x15\x75\xe7\x31\xa5\x77\x95\x05\x10\xf5\xee\xe5\x4f\xef\x45\x7c\xac\x18\xe8\x77\x6c\x6b\xbc\x65\xdc\xc1\x1d\x29\xdf\xab\x13\xc1\x49\x72\x79\x7e\x56\xd7\x3b\xad\xe5\x71\x64\x97\xfc\x45\xf6\x3e\x08\x9e\x00\xe4\xcc\xe1\x6f\x33\xd4\xed\x7e\x67\x0a\x12\x0a\x88\xf4\x45\x73\xf1\x79\xe8\xbd\xd5\x44\x27\x01\x45\x42\x1d\x5e\x27\x87\x23\x52\x99\x5d\x72\x5f\xc2\xf5\x3a\x3b\x9d\x87\x34\x93\xec\x0f\xe2\xa2\x35\x53\xb0\xf3\x12\x8a\x69\x56\x57\xba\xc5\xbf\x70\x0a\x2a\x1f\x0d\x3a\xb1\x94\x36\xf4\x47\x96\xfb\x86\xf3\x3a\x99\xc9\x7f\x34\xea\x82\x95\x93\xed\x8f\xa8\xee\xf3\xd4\xeb\x40\xab\xfd\x30\xd6\x85\xb1\x6e\x53\x7f\xb0\xe9\xa4\xf8\x3a\x00\xb3\x8b\x9e\x2e\x50\x8d\x93\x7f\x51\xf5\xf1\x8e\xfc\xe2\xdc\x50\x36\x55\x3b\x55\x9d\xca\x10\x45\xa5\xc5\x7b\xe4\x4b\x50\x44\x9b\xbd\x3e\x80\x8a\x3b\x4e\x03\x77\xa1\x9e\x59\xb5\xd2\x0b\x48\x5d\xe7\x8c\x87\x66\xbd\xbb\xd0\x34\x9e\x28\x6e\x18\x08\x5f\x38\x8f\x57\x13\xd2\x9c\x8f\xfc\xf8\x79\xf1\xe3\x52\x35\xf6\xe2\x49\x58\x4b\xea\x60\x36\x1b\xa5\xef\x92\x64\x2d\xc4\xd3\x79\x0a\xaa\xc5\x40\xe7\x08\x76\x7d\x9d\x7e\x17\x1b\xfe\xfa\x2b\x0c\x94\xf0\x1c\x42\x95\x42\x1d\x97\xe2\x60\x34\xd2\xb8\xd3\x37\x37\xb7\xd0\x2c\x68\x0b\xa7\x9f\x20\x53\x2a\x44\xd9\x2c\xba\x16\xa1\xbb\xd5\xbd\xcc\x17\x44\xdb\x1d\x28\x88\x96\x5c\xb8\x81\x4f\xef\x8d\x85\x71\x38\x4f\x70\x0f\x3f\xfd\x94\xcb\x5e\xd3\xbf\xf9\xc0\x16\x7e\xe0\xa1\xc6\x38\x13\xf9\x3f\x02\x3e\x88\x6f\xfc\xca\x91\x22\x80\xf8\x3f\x4e\xf5\xe5\x62\x53\x65\x53\x07\xef\x3a\x04\x8b\x07\xaa\x6d\x53\x8e\x23\x7a\x89\xf5\xd7\x9c\xb6\xb6\x36\x99\xb4\xaa\xda\x64\xb2\x37\x96\x4c\xe8\xff\x01\x00\x00\xff\xff\x5d\xb2\x1f\x7d\x3f\x29\x00\x00")
 
 func migrations1_initial_schemaSqlBytes() ([]byte, error) {
@@ -244,6 +330,46 @@ func migrations1_initial_schemaSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations20_webhook_cursorSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd3\xd5\x55\xd0\xce\xcd\x4c\x2f\x4a\x2c\x49\x55\x08\x2d\xe0\xe2\x72\x0e\x72\x75\x0c\x71\x55\x08\x71\x74\xf2\x71\x55\x28\x4f\x4d\xca\xc8\xcf\xcf\x8e\x4f\x2e\x2d\x2a\xce\x2f\x52\xd0\xe0\x52\x00\x82\xcc\x14\x85\xcc\xbc\x92\xd4\xf4\xd4\x22\x85\x80\x20\x4f\x5f\xc7\xa0\x48\x05\x6f\xd7\x48\x05\x67\x0f\x57\x67\x6f\x05\x0d\xa0\xac\xad\x82\xa1\xa6\x0e\x58\x29\x54\x5f\x72\x46\x62\x51\x62\x72\x09\x50\x43\x59\x62\x51\x65\x66\x5e\xba\x86\x99\x89\xa6\x82\x9f\x7f\x88\x82\x5f\xa8\x8f\x0f\x97\xa6\x35\x17\x97\x2e\x92\x33\x5c\xf2\xcb\xf3\xb8\xb8\x5c\x82\xfc\x03\xb0\x3a\xc3\x9a\x0b\x00\xd5\x7f\x46\x18\xb4\x00\x00\x00")
+
+func migrations20_webhook_cursorSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations20_webhook_cursorSql,
+		"migrations/20_webhook_cursor.sql",
+	)
+}
+
+func migrations20_webhook_cursorSql() (*asset, error) {
+	bytes, err := migrations20_webhook_cursorSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/20_webhook_cursor.sql", size: 180, mode: os.FileMode(420), modTime: time.Unix(1792270808, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations21_ledgers_from_archiveSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\xd2\xcb\x0a\xc2\x30\x10\x05\xd0\x7d\xbf\x62\x76\x2e\xa4\x5f\x50\x5c\x44\x13\x51\x88\x6d\x69\x13\xc4\x55\x89\x3a\x7d\x40\xdb\x48\x1a\x7c\xfc\xbd\x0f\x34\x28\x54\x28\xe8\xf6\x32\x9c\x19\x2e\xe3\xfb\x30\x6e\xaa\xc2\x28\x8b\x20\x0f\x1e\xe1\x82\x25\x20\xc8\x94\x33\x88\x42\xbe\x81\xb2\xea\xac\x36\x97\xac\xc6\x7d\x81\xa6\x03\x42\x29\xcc\x22\x2e\x57\x21\xe4\x46\x37\x99\x32\xbb\xb2\x3a\x22\x6c\xb5\xae\x51\xb5\x40\xd9\x9c\x48\x2e\x20\x57\x75\x87\x10\x46\x02\x42\xc9\x79\xf0\x1d\xb6\x46\xb5\x9d\xda\xd9\x4a\xb7\x37\xfd\x31\xf5\xf4\xed\x39\x6b\xd0\x2a\xa0\x49\x14\xff\x2c\xe5\x88\xbd\x9a\xe7\xbf\x15\x40\xf5\xa9\xf5\x64\x4c\x89\x60\xfd\x6a\xca\x84\x3b\x6b\x02\xa3\x11\xac\x17\x2c\x61\x2e\x5a\xa6\x4f\x75\x80\xe1\x0e\xfa\x74\x5c\xec\xac\x1f\x9a\xbb\xaf\xfa\x5b\x71\x03\xb1\xd7\xa3\x3c\x7a\xee\xf9\x94\xc0\xbb\x02\xb6\x8c\xa1\x8d\x73\x02\x00\x00")
+
+func migrations21_ledgers_from_archiveSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations21_ledgers_from_archiveSql,
+		"migrations/21_ledgers_from_archive.sql",
+	)
+}
+
+func migrations21_ledgers_from_archiveSql() (*asset, error) {
+	bytes, err := migrations21_ledgers_from_archiveSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/21_ledgers_from_archive.sql", size: 627, mode: os.FileMode(420), modTime: time.Unix(1792274178, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _migrations2_index_participants_by_toidSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\xb1\xca\xc2\x50\x0c\x46\xf7\x3c\x45\xc6\xff\x47\xfa\x04\x9d\xc4\x16\xe9\xd2\x4a\xb5\xe0\x76\x49\xdb\x8b\xcd\xe0\xcd\x25\x37\x20\x7d\x7b\x41\x07\x5b\xbb\xb8\x86\x8f\x73\x72\xb2\x0c\x77\x77\xbe\x29\x99\xc7\x2e\x02\x1c\xda\x72\x7f\x29\xb1\xaa\x8b\xf2\x8a\x93\x44\xd7\xcf\x6e\x12\x1e\xb1\xa9\x71\xe2\x64\xa2\xb3\x93\xe8\x95\x8c\x25\xb8\x48\x6a\x3c\x70\xa4\x60\x09\xbb\x73\x55\x1f\xb1\x37\xf5\x1e\xff\xb6\x5b\x1e\xff\xf3\x2f\xbc\xbd\xf1\xb6\xc6\x9b\x52\x48\x34\xfc\x28\x58\xae\x5f\x0a\x58\x26\x15\xf2\x08\x00\x45\xdb\x9c\xb6\x49\xf9\xea\xfe\xf9\x25\x87\x67\x00\x00\x00\xff\xff\x33\xec\x54\x7a\x15\x01\x00\x00")
 
 func migrations2_index_participants_by_toidSqlBytes() ([]byte, error) {
@@ -483,7 +609,13 @@ var _bindata = map[string]func() (*asset, error){
 	"migrations/13_trade_offer_ids.sql":                 migrations13_trade_offer_idsSql,
 	"migrations/14_fix_asset_toml_field.sql":            migrations14_fix_asset_toml_fieldSql,
 	"migrations/15_ledger_failed_txs.sql":               migrations15_ledger_failed_txsSql,
+	"migrations/16_webhooks.sql":                        migrations16_webhooksSql,
+	"migrations/17_ingest_failed_transactions.sql":      migrations17_ingest_failed_transactionsSql,
+	"migrations/18_transactions_by_memo.sql":            migrations18_transactions_by_memoSql,
+	"migrations/19_operation_assets.sql":                migrations19_operation_assetsSql,
 	"migrations/1_initial_schema.sql":                   migrations1_initial_schemaSql,
+	"migrations/20_webhook_cursor.sql":                  migrations20_webhook_cursorSql,
+	"migrations/21_ledgers_from_archive.sql":            migrations21_ledgers_from_archiveSql,
 	"migrations/2_index_participants_by_toid.sql":       migrations2_index_participants_by_toidSql,
 	"migrations/3_use_sequence_in_history_accounts.sql": migrations3_use_sequence_in_history_accountsSql,
 	"migrations/4_add_protocol_version.sql":             migrations4_add_protocol_versionSql,
@@ -544,7 +676,13 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"13_trade_offer_ids.sql":                 &bintree{migrations13_trade_offer_idsSql, map[string]*bintree{}},
 		"14_fix_asset_toml_field.sql":            &bintree{migrations14_fix_asset_toml_fieldSql, map[string]*bintree{}},
 		"15_ledger_failed_txs.sql":               &bintree{migrations15_ledger_failed_txsSql, map[string]*bintree{}},
+		"16_webhooks.sql":                        &bintree{migrations16_webhooksSql, map[string]*bintree{}},
+		"17_ingest_failed_transactions.sql":      &bintree{migrations17_ingest_failed_transactionsSql, map[string]*bintree{}},
+		"18_transactions_by_memo.sql":            &bintree{migrations18_transactions_by_memoSql, map[string]*bintree{}},
+		"19_operation_assets.sql":                &bintree{migrations19_operation_assetsSql, map[string]*bintree{}},
 		"1_initial_schema.sql":                   &bintree{migrations1_initial_schemaSql, map[string]*bintree{}},
+		"20_webhook_cursor.sql":                  &bintree{migrations20_webhook_cursorSql, map[string]*bintree{}},
+		"21_ledgers_from_archive.sql":            &bintree{migrations21_ledgers_from_archiveSql, map[string]*bintree{}},
 		"2_index_participants_by_toid.sql":       &bintree{migrations2_index_participants_by_toidSql, map[string]*bintree{}},
 		"3_use_sequence_in_history_accounts.sql": &bintree{migrations3_use_sequence_in_history_accountsSql, map[string]*bintree{}},
 		"4_add_protocol_version.sql":             &bintree{migrations4_add_protocol_versionSql, map[string]*bintree{}},
@@ -208,7 +208,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -329,15 +340,97 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer NOT NULL,
+    cursor character varying(64) NOT NULL,
+    CONSTRAINT webhook_cursor_id_check CHECK ((id = 1))
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigint NOT NULL,
+    subscription_id bigint NOT NULL,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
 );
 
 
+--
+-- Name: webhook_deliveries_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE webhook_deliveries_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: webhook_deliveries_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE webhook_deliveries_id_seq OWNED BY webhook_deliveries.id;
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigint NOT NULL,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_subscriptions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE webhook_subscriptions_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: webhook_subscriptions_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE webhook_subscriptions_id_seq OWNED BY webhook_subscriptions.id;
+
+
 --
 -- Name: history_assets id; Type: DEFAULT; Schema: public; Owner: -
 --
@@ -359,6 +452,20 @@ ALTER TABLE ONLY history_operation_participants ALTER COLUMN id SET DEFAULT next
 ALTER TABLE ONLY history_transaction_participants ALTER COLUMN id SET DEFAULT nextval('history_transaction_participants_id_seq'::regclass);
 
 
+--
+-- Name: webhook_deliveries id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_deliveries ALTER COLUMN id SET DEFAULT nextval('webhook_deliveries_id_seq'::regclass);
+
+
+--
+-- Name: webhook_subscriptions id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_subscriptions ALTER COLUMN id SET DEFAULT nextval('webhook_subscriptions_id_seq'::regclass);
+
+
 --
 -- Data for Name: asset_stats; Type: TABLE DATA; Schema: public; Owner: -
 --
@@ -385,6 +492,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.815412+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81602+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -469,6 +582,38 @@ SELECT pg_catalog.setval('history_transaction_participants_id_seq', 1, false);
 
 
 
+--
+-- Data for Name: webhook_cursor; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
+--
+-- Data for Name: webhook_deliveries; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
+--
+-- Name: webhook_deliveries_id_seq; Type: SEQUENCE SET; Schema: public; Owner: -
+--
+
+SELECT pg_catalog.setval('webhook_deliveries_id_seq', 1, false);
+
+
+--
+-- Data for Name: webhook_subscriptions; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
+--
+-- Name: webhook_subscriptions_id_seq; Type: SEQUENCE SET; Schema: public; Owner: -
+--
+
+SELECT pg_catalog.setval('webhook_subscriptions_id_seq', 1, false);
+
+
 --
 -- Name: asset_stats asset_stats_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -517,6 +662,30 @@ ALTER TABLE ONLY history_transaction_participants
     ADD CONSTRAINT history_transaction_participants_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: webhook_cursor webhook_cursor_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_cursor
+    ADD CONSTRAINT webhook_cursor_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: webhook_deliveries webhook_deliveries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: webhook_subscriptions webhook_subscriptions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_subscriptions
+    ADD CONSTRAINT webhook_subscriptions_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: asset_by_code; Type: INDEX; Schema: public; Owner: -
 --
@@ -552,6 +721,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -587,6 +763,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -769,6 +959,13 @@ CREATE UNIQUE INDEX index_history_transactions_on_id ON history_transactions USI
 CREATE INDEX trade_effects_by_order_book ON history_effects USING btree (((details ->> 'sold_asset_type'::text)), ((details ->> 'sold_asset_code'::text)), ((details ->> 'sold_asset_issuer'::text)), ((details ->> 'bought_asset_type'::text)), ((details ->> 'bought_asset_code'::text)), ((details ->> 'bought_asset_issuer'::text))) WHERE (type = 33);
 
 
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
 --
 -- Name: asset_stats asset_stats_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -809,6 +1006,14 @@ ALTER TABLE ONLY history_trades
     ADD CONSTRAINT history_trades_counter_asset_id_fkey FOREIGN KEY (counter_asset_id) REFERENCES history_assets(id);
 
 
+--
+-- Name: webhook_deliveries webhook_deliveries_subscription_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_subscription_id_fkey FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE;
+
+
 --
 -- PostgreSQL database dump complete
 --
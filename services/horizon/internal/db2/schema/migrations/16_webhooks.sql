@@ -0,0 +1,30 @@
+-- +migrate Up
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+-- +migrate Down
+
+DROP TABLE webhook_deliveries;
+DROP TABLE webhook_subscriptions;
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- NULL for the transactions ingested before failed transactions were, which
+-- are all successful.
+ALTER TABLE history_transactions ADD successful boolean;
+
+-- +migrate Down
+
+ALTER TABLE history_transactions DROP COLUMN successful;
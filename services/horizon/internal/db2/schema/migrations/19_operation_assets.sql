@@ -0,0 +1,13 @@
+-- +migrate Up
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
+);
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+-- +migrate Down
+
+DROP TABLE history_operation_assets;
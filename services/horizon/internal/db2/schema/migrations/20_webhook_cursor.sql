@@ -0,0 +1,10 @@
+-- +migrate Up
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
+);
+
+-- +migrate Down
+
+DROP TABLE webhook_cursor;
@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE ONLY history_ledgers ADD COLUMN from_archive boolean DEFAULT false NOT NULL;
+ALTER TABLE ONLY history_transactions ALTER COLUMN tx_meta DROP NOT NULL;
+ALTER TABLE ONLY history_transactions ALTER COLUMN tx_fee_meta DROP NOT NULL;
+
+-- +migrate Down
+UPDATE history_transactions SET tx_meta = '' WHERE tx_meta IS NULL;
+UPDATE history_transactions SET tx_fee_meta = '' WHERE tx_fee_meta IS NULL;
+ALTER TABLE ONLY history_transactions ALTER COLUMN tx_meta SET NOT NULL;
+ALTER TABLE ONLY history_transactions ALTER COLUMN tx_fee_meta SET NOT NULL;
+ALTER TABLE ONLY history_ledgers DROP COLUMN from_archive;
@@ -0,0 +1,31 @@
+// Package graphpath provides an implementation of paths.Finder that searches
+// for the cheapest payment paths in an in-memory graph of stellar-core's order
+// books.
+//
+// The graph is built from every offer in stellar-core's `offers` table and is
+// reloaded at most once per ledger, so a path find does not hit the database
+// for every asset it visits. For every asset A the graph holds the order books
+// of offers selling A, one per asset those offers are buying.
+//
+// The search is a Bellman-Ford relaxation bounded by the maximum path length.
+// Like simplepath, it starts at the destination asset and walks back towards
+// the source assets:
+//  1. Iteration `k` holds, for every asset, the cheapest known path of `k`
+//     assets that ends with the destination asset.
+//  2. Every path is extended by each asset that can buy its head asset and the
+//     new path is kept only if it is cheaper than any other path of `k+1`
+//     assets starting with the same asset.
+//  3. Paths starting with one of the source assets are collected after every
+//     iteration and finally ranked by their source cost.
+//
+// Edges are not weighted by the logarithm of the best price, which would
+// ignore the depth of the order books. Instead, the weight of an edge is the
+// exact amount returned by `orderBook.CostToConsumeLiquidity` for the amount
+// that has to flow through it, so the cost of a path is the `sendMax` needed to
+// deliver `DestinationAmount` and paths without enough liquidity are dropped.
+//
+// Strict send queries run the same relaxation in the opposite direction: they
+// start at the source asset with `SourceAmount`, every edge is weighted by the
+// amount returned by `orderBook.AmountReceived`, and for every asset the path
+// receiving the most of it is kept.
+package graphpath
@@ -0,0 +1,143 @@
+package graphpath
+
+import (
+	"sync"
+
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/ledger"
+	"github.com/kinecosystem/go/services/horizon/internal/paths"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/support/log"
+)
+
+// Finder implements the paths.Finder interface and searches for the cheapest
+// payment paths in an in-memory graph of the order books stored in a
+// stellar-core's database. The graph is reloaded at most once per ledger.
+type Finder struct {
+	Q *core.Q
+
+	lock   sync.Mutex
+	graph  *orderBookGraph
+	ledger int32
+}
+
+// ensure the struct is paths.Finder compliant
+var _ paths.Finder = &Finder{}
+
+// Find performs a path find with the provided query.
+func (f *Finder) Find(q paths.Query, maxLength uint) (result []paths.Path, err error) {
+	log.WithField("source_assets", q.SourceAssets).
+		WithField("destination_asset", q.DestinationAsset).
+		WithField("destination_amount", q.DestinationAmount).
+		Info("Starting pathfind")
+
+	if len(q.SourceAssets) == 0 {
+		err = errors.New("No source assets")
+		return
+	}
+
+	if maxLength == 0 {
+		maxLength = paths.MaxPathLength
+	}
+
+	if maxLength < 2 || maxLength > paths.MaxPathLength {
+		err = errors.New("invalid value of maxLength")
+		return
+	}
+
+	graph, err := f.currentGraph()
+	if err != nil {
+		return
+	}
+
+	s := &search{
+		Query:     q,
+		Graph:     graph,
+		MaxLength: maxLength,
+	}
+
+	s.Run()
+
+	result, err = s.Results, s.Err
+
+	log.WithField("found", len(s.Results)).
+		WithField("err", s.Err).
+		Info("Finished pathfind")
+	return
+}
+
+// FindStrictSend performs a strict send path find with the provided query.
+func (f *Finder) FindStrictSend(q paths.StrictSendQuery, maxLength uint) (result []paths.Path, err error) {
+	log.WithField("source_asset", q.SourceAsset).
+		WithField("source_amount", q.SourceAmount).
+		WithField("destination_assets", q.DestinationAssets).
+		Info("Starting strict send pathfind")
+
+	if len(q.DestinationAssets) == 0 {
+		err = errors.New("No destination assets")
+		return
+	}
+
+	if maxLength == 0 {
+		maxLength = paths.MaxPathLength
+	}
+
+	if maxLength < 2 || maxLength > paths.MaxPathLength {
+		err = errors.New("invalid value of maxLength")
+		return
+	}
+
+	graph, err := f.currentGraph()
+	if err != nil {
+		return
+	}
+
+	s := &sendSearch{
+		Query:     q,
+		Graph:     graph,
+		MaxLength: maxLength,
+	}
+
+	s.Run()
+
+	result, err = s.Results, s.Err
+
+	log.WithField("found", len(s.Results)).
+		WithField("err", s.Err).
+		Info("Finished strict send pathfind")
+	return
+}
+
+// currentGraph returns the order book graph of the latest ledger known to
+// stellar-core, rebuilding it if a new ledger was closed since it was last
+// loaded.
+func (f *Finder) currentGraph() (*orderBookGraph, error) {
+	latest := ledger.CurrentState().CoreLatest
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.graph != nil && f.ledger == latest {
+		return f.graph, nil
+	}
+
+	var offers []core.Offer
+	q := &core.Q{Session: f.Q.Clone()}
+	err := q.AllOffers(&offers)
+	if err != nil {
+		return nil, errors.Wrap(err, "q.AllOffers error")
+	}
+
+	graph, err := newOrderBookGraph(offers)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithField("ledger", latest).
+		WithField("offers", len(offers)).
+		Debug("Loaded order book graph")
+
+	f.graph = graph
+	f.ledger = latest
+	return graph, nil
+}
@@ -0,0 +1,97 @@
+package graphpath
+
+import (
+	"testing"
+
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/paths"
+	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/kinecosystem/go/xdr"
+)
+
+func TestFinder(t *testing.T) {
+	tt := test.Start(t).Scenario("paths")
+	defer tt.Finish()
+
+	finder := &Finder{
+		Q: &core.Q{Session: tt.CoreSession()},
+	}
+
+	native := makeAsset(xdr.AssetTypeAssetTypeNative, "", "")
+	usd := makeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"USD",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+	eur := makeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"EUR",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+	inter1 := makeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"1",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+	inter21 := makeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"21",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+	inter22 := makeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"22",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+
+	query := paths.Query{
+		DestinationAddress: "GAEDTJ4PPEFVW5XV2S7LUXBEHNQMX5Q2GM562RJGOQG7GVCE5H3HIB4V",
+		DestinationAsset:   eur,
+		DestinationAmount:  xdr.Int64(200000000), // 20.0000000
+		SourceAssets:       []xdr.Asset{usd},
+	}
+
+	p, err := finder.Find(query, paths.MaxPathLength)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(p, 3)
+
+		// Cheapest first:
+		// - selling 10 USD for EUR, price = 0.5
+		// - selling 10 USD for EUR, price = 0.5
+		tt.Assert.Equal(p[0].Source.String(), usd.String())
+		tt.Assert.Equal(p[0].Destination.String(), eur.String())
+		tt.Assert.Equal(p[0].Cost, xdr.Int64(100000000)) // 10.0000000
+		tt.Assert.Len(p[0].Path, 0)
+
+		// Same cost, shorter path first
+		tt.Assert.Equal(p[1].Cost, xdr.Int64(200000000))
+		if tt.Assert.Len(p[1].Path, 1) {
+			tt.Assert.Equal(p[1].Path[0].String(), inter1.String())
+		}
+
+		tt.Assert.Equal(p[2].Cost, xdr.Int64(200000000))
+		if tt.Assert.Len(p[2].Path, 2) {
+			tt.Assert.Equal(p[2].Path[0].String(), inter21.String())
+			tt.Assert.Equal(p[2].Path[1].String(), inter22.String())
+		}
+	}
+
+	query.DestinationAmount = xdr.Int64(500000001)
+	p, err = finder.Find(query, paths.MaxPathLength)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(p, 0)
+	}
+
+	// paths that involve native currencies can be found
+	query = paths.Query{
+		DestinationAddress: "GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN",
+		DestinationAsset:   native,
+		DestinationAmount:  xdr.Int64(1),
+		SourceAssets:       []xdr.Asset{usd, native},
+	}
+	p, err = finder.Find(query, paths.MaxPathLength)
+	if tt.Assert.NoError(err) && tt.Assert.NotEmpty(p) {
+		// results for the first source asset come first
+		tt.Assert.Equal(p[0].Source.String(), usd.String())
+		tt.Assert.Equal(p[len(p)-1].Source.String(), native.String())
+	}
+
+	// invalid path length
+	_, err = finder.Find(query, 1)
+	tt.Assert.Error(err)
+}
@@ -0,0 +1,80 @@
+package graphpath
+
+import (
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// orderBookGraph is an in-memory snapshot of all the order books in the
+// ledger. It is never modified after being built, so it is safe to share it
+// between concurrent searches.
+type orderBookGraph struct {
+	// books maps the string representation of an asset to the order books of
+	// offers selling it, keyed by the asset those offers are buying.
+	books map[string]map[string]*orderBook
+	// buying maps the string representation of an asset to the order books
+	// of offers buying it, keyed by the asset those offers are selling.
+	buying map[string]map[string]*orderBook
+}
+
+// newOrderBookGraph builds a graph from offers. Offers are expected to be
+// sorted by price, cheapest first.
+func newOrderBookGraph(offers []core.Offer) (*orderBookGraph, error) {
+	graph := &orderBookGraph{
+		books:  map[string]map[string]*orderBook{},
+		buying: map[string]map[string]*orderBook{},
+	}
+
+	for _, offer := range offers {
+		selling, err := offer.SellingAsset()
+		if err != nil {
+			return nil, errors.Wrap(err, "offer.SellingAsset error")
+		}
+
+		buying, err := offer.BuyingAsset()
+		if err != nil {
+			return nil, errors.Wrap(err, "offer.BuyingAsset error")
+		}
+
+		graph.add(selling, buying, offer)
+	}
+
+	return graph, nil
+}
+
+func (g *orderBookGraph) add(selling, buying xdr.Asset, offer core.Offer) {
+	sellingID := selling.String()
+	buyingID := buying.String()
+
+	books, ok := g.books[sellingID]
+	if !ok {
+		books = map[string]*orderBook{}
+		g.books[sellingID] = books
+	}
+
+	ob, ok := books[buyingID]
+	if !ok {
+		ob = &orderBook{Selling: selling, Buying: buying}
+		books[buyingID] = ob
+
+		if _, ok := g.buying[buyingID]; !ok {
+			g.buying[buyingID] = map[string]*orderBook{}
+		}
+		g.buying[buyingID][sellingID] = ob
+	}
+
+	ob.Offers = append(ob.Offers, offer)
+}
+
+// Selling returns the order books of the offers selling the asset with the
+// provided string representation, keyed by the asset they are buying.
+func (g *orderBookGraph) Selling(id string) map[string]*orderBook {
+	return g.books[id]
+}
+
+// Buying returns the order books of the offers buying the asset with the
+// provided string representation, keyed by the asset they are selling.
+func (g *orderBookGraph) Buying(id string) map[string]*orderBook {
+	return g.buying[id]
+}
@@ -0,0 +1,99 @@
+package graphpath
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/paths"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// ErrNotEnough represents an error that occurs when pricing a trade on an
+// orderbook.  This error occurs when the orderbook cannot fulfill the
+// requested amount.
+var ErrNotEnough = errors.New("not enough depth")
+
+// orderBook represents a one-way orderbook that is selling you a specific
+// asset (ob.Selling), held in memory.
+type orderBook struct {
+	Selling xdr.Asset // the offers are selling this asset
+	Buying  xdr.Asset // the offers are buying this asset
+	// Offers are sorted by price, cheapest first
+	Offers []core.Offer
+}
+
+// CostToConsumeLiquidity returns the buyingAmount (ob.Buying) needed to consume the sellingAmount (ob.Selling)
+func (ob *orderBook) CostToConsumeLiquidity(sellingAmount xdr.Int64) (xdr.Int64, error) {
+	// remaining is the units of ob.Selling that we want to consume
+	remaining := int64(sellingAmount)
+	var buyingAmount int64
+	for _, offer := range ob.Offers {
+		buyingUnitsExtracted, sellingUnitsExtracted, e := paths.ConvertToBuyingUnits(
+			int64(offer.Amount),
+			remaining,
+			int64(offer.Pricen),
+			int64(offer.Priced),
+		)
+		if e != nil {
+			return 0, e
+		}
+		// overflow check
+		if paths.WillAddOverflow(buyingAmount, buyingUnitsExtracted) {
+			return xdr.Int64(0), fmt.Errorf("adding these two values will cause an integer overflow: %d, %d", buyingAmount, buyingUnitsExtracted)
+		}
+		buyingAmount += buyingUnitsExtracted
+		remaining -= sellingUnitsExtracted
+
+		// check if we got all the units we wanted
+		if remaining <= 0 {
+			return xdr.Int64(buyingAmount), nil
+		}
+	}
+	return 0, ErrNotEnough
+}
+
+// AmountReceived returns the sellingAmount (ob.Selling) received when spending the buyingAmount (ob.Buying)
+func (ob *orderBook) AmountReceived(buyingAmount xdr.Int64) (xdr.Int64, error) {
+	// remaining is the units of ob.Buying that we want to spend
+	remaining := int64(buyingAmount)
+	var sellingAmount int64
+	for _, offer := range ob.Offers {
+		wholeOfferCost, _, e := paths.ConvertToBuyingUnits(
+			int64(offer.Amount),
+			int64(offer.Amount),
+			int64(offer.Pricen),
+			int64(offer.Priced),
+		)
+		if e != nil {
+			return 0, e
+		}
+		sellingUnitsExtracted, buyingUnitsSpent, e := paths.ConvertToSellingUnits(
+			int64(offer.Amount),
+			remaining,
+			int64(offer.Pricen),
+			int64(offer.Priced),
+		)
+		if e != nil {
+			return 0, e
+		}
+		// overflow check
+		if paths.WillAddOverflow(sellingAmount, sellingUnitsExtracted) {
+			return xdr.Int64(0), fmt.Errorf("adding these two values will cause an integer overflow: %d, %d", sellingAmount, sellingUnitsExtracted)
+		}
+		sellingAmount += sellingUnitsExtracted
+		remaining -= buyingUnitsSpent
+
+		// check if we spent all the units we wanted, or if what was left
+		// could not pay for the whole offer: the offers after it are more
+		// expensive.  Rounding can leave units of an offer that was taken
+		// whole, so compare with the cost of taking all of it.
+		if remaining <= 0 || buyingUnitsSpent < wholeOfferCost {
+			if sellingAmount == 0 {
+				return 0, ErrNotEnough
+			}
+			return xdr.Int64(sellingAmount), nil
+		}
+	}
+	return 0, ErrNotEnough
+}
@@ -0,0 +1,110 @@
+package graphpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/xdr"
+)
+
+func TestOrderBook(t *testing.T) {
+	ob := orderBook{
+		Selling: makeAsset(
+			xdr.AssetTypeAssetTypeCreditAlphanum4,
+			"EUR",
+			"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"),
+		Buying: makeAsset(
+			xdr.AssetTypeAssetTypeCreditAlphanum4,
+			"USD",
+			"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"),
+		Offers: []core.Offer{
+			{OfferID: 1, Amount: 100, Pricen: 1, Priced: 2},
+			{OfferID: 2, Amount: 100, Pricen: 1, Priced: 1},
+		},
+	}
+
+	testCases := []struct {
+		scenario    string
+		eur         int64
+		wantCostUSD int64
+	}{
+		{"first unit", 2, 1},                         // taking from the first offer, where the price is 0.5
+		{"first full offer", 100, 50},                // taking all from first offer (p=0.5)
+		{"first full offer + 1", 101, 51},            // taking all from first offer (p=0.5), and first unit of second offer (p=1.0)
+		{"first offer and half of second", 150, 100}, // taking all from first offer (p=0.5), and half of second offer (p=1.0)
+		{"both full offers", 200, 150},               // taking all from both offers (p=0.5, p=1.0)
+	}
+
+	for _, kase := range testCases {
+		t.Run(kase.scenario, func(t *testing.T) {
+			r, err := ob.CostToConsumeLiquidity(xdr.Int64(kase.eur))
+			if assert.NoError(t, err) {
+				assert.Equal(t, xdr.Int64(kase.wantCostUSD), r)
+			}
+		})
+	}
+
+	// taking 1 more than there is available
+	t.Run("one more than available liquidity", func(t *testing.T) {
+		_, err := ob.CostToConsumeLiquidity(xdr.Int64(201))
+		assert.Equal(t, ErrNotEnough, err)
+	})
+}
+
+func TestOrderBook_AmountReceived(t *testing.T) {
+	ob := orderBook{
+		Selling: makeAsset(
+			xdr.AssetTypeAssetTypeCreditAlphanum4,
+			"EUR",
+			"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"),
+		Buying: makeAsset(
+			xdr.AssetTypeAssetTypeCreditAlphanum4,
+			"USD",
+			"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"),
+		Offers: []core.Offer{
+			// rounding leaves 1 unit of this offer when it is taken whole
+			{OfferID: 1, Amount: 20, Pricen: 7, Priced: 11},
+			{OfferID: 2, Amount: 100, Pricen: 1, Priced: 1},
+			{OfferID: 3, Amount: 10, Pricen: 3, Priced: 1},
+		},
+	}
+
+	testCases := []struct {
+		scenario   string
+		usd        int64
+		wantEURGot int64
+	}{
+		{"part of first offer", 10, 15},                // 15 EUR cost ceil(15 * 7/11) = 10 USD
+		{"first full offer", 13, 19},                   // the 19 EUR that can be taken cost 13 USD
+		{"first offer and part of second", 100, 106},   // 19 EUR for 13 USD, and 87 EUR for 87 USD
+		{"first two full offers", 113, 119},            // 19 EUR for 13 USD, and 100 EUR for 100 USD
+		{"budget too small for third offer", 117, 120}, // 1 EUR of the third offer for 3 USD, the last USD can't buy any
+		{"all offers", 143, 129},                       // 19 EUR for 13 USD, 100 EUR for 100 USD and 10 EUR for 30 USD
+	}
+
+	for _, kase := range testCases {
+		t.Run(kase.scenario, func(t *testing.T) {
+			r, err := ob.AmountReceived(xdr.Int64(kase.usd))
+			if assert.NoError(t, err) {
+				assert.Equal(t, xdr.Int64(kase.wantEURGot), r)
+			}
+		})
+	}
+
+	t.Run("one more than available liquidity", func(t *testing.T) {
+		_, err := ob.AmountReceived(xdr.Int64(144))
+		assert.Equal(t, ErrNotEnough, err)
+	})
+
+	t.Run("less than the price of a unit", func(t *testing.T) {
+		expensive := orderBook{
+			Selling: ob.Selling,
+			Buying:  ob.Buying,
+			Offers:  []core.Offer{{OfferID: 3, Amount: 10, Pricen: 3, Priced: 1}},
+		}
+		_, err := expensive.AmountReceived(xdr.Int64(2))
+		assert.Equal(t, ErrNotEnough, err)
+	})
+}
@@ -0,0 +1,215 @@
+package graphpath
+
+import (
+	"sort"
+
+	"github.com/kinecosystem/go/services/horizon/internal/paths"
+	"github.com/kinecosystem/go/xdr"
+)
+
+const maxResults = 20
+
+// search represents a single query against the graph finder. It provides a
+// place to store the results of the query, mostly for the purposes of code
+// clarity.
+//
+// The search struct is used as follows:
+//
+// 1.  Create an instance, ensuring the Query, Graph and MaxLength fields are set
+// 2.  Call Run() to perform the search.
+type search struct {
+	Query     paths.Query
+	Graph     *orderBookGraph
+	MaxLength uint
+
+	// targets maps the string representation of the source assets to their
+	// position in Query.SourceAssets
+	targets map[string]int
+
+	//This fields below are initialized after the search is run
+	Err     error
+	Results []paths.Path
+}
+
+// pathNode represents a path as a linked list together with its amount. Paths
+// found by search point from source to destination, paths found by sendSearch
+// point from destination back to source.
+type pathNode struct {
+	ID    string
+	Asset xdr.Asset
+	Tail  *pathNode
+	// Amount is the amount of Asset needed to deliver the destination amount
+	// through this path (search) or received when sending the source amount
+	// through it (sendSearch)
+	Amount xdr.Int64
+	Depth  uint
+}
+
+// IsOnPath returns true if the asset with the given string representation is
+// in the path.
+func (p *pathNode) IsOnPath(id string) bool {
+	for cur := p; cur != nil; cur = cur.Tail {
+		if cur.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Flatten walks the list and returns a slice of assets
+func (p *pathNode) Flatten() []xdr.Asset {
+	var result []xdr.Asset
+	for cur := p; cur != nil; cur = cur.Tail {
+		result = append(result, cur.Asset)
+	}
+	return result
+}
+
+func (p *pathNode) asPath() paths.Path {
+	return newPath(p.Flatten(), p.Amount, 0)
+}
+
+// newPath builds a paths.Path out of the assets from source to destination.
+func newPath(assets []xdr.Asset, cost, destinationAmount xdr.Int64) paths.Path {
+	result := paths.Path{
+		Source:            assets[0],
+		Destination:       assets[len(assets)-1],
+		Cost:              cost,
+		DestinationAmount: destinationAmount,
+	}
+
+	// exclude the source and the destination assets
+	if len(assets) > 2 {
+		result.Path = assets[1 : len(assets)-1]
+	}
+
+	return result
+}
+
+// Run triggers the search, which will populate the Results and Err field for
+// the search after completion.
+func (s *search) Run() {
+	s.targets = map[string]int{}
+	for i, a := range s.Query.SourceAssets {
+		id := a.String()
+		if _, ok := s.targets[id]; !ok {
+			s.targets[id] = i
+		}
+	}
+
+	destination := &pathNode{
+		ID:     s.Query.DestinationAsset.String(),
+		Asset:  s.Query.DestinationAsset,
+		Amount: s.Query.DestinationAmount,
+		Depth:  1,
+	}
+
+	level := map[string]*pathNode{destination.ID: destination}
+	s.collect(level)
+
+	for depth := uint(2); depth <= s.MaxLength && len(level) > 0; depth++ {
+		level = s.relax(level, depth)
+		if s.Err != nil {
+			return
+		}
+		s.collect(level)
+	}
+
+	s.rank()
+}
+
+// relax extends every path in level by one asset and returns, for every asset
+// reached, the cheapest of the resulting paths of `depth` assets.
+func (s *search) relax(level map[string]*pathNode, depth uint) map[string]*pathNode {
+	next := map[string]*pathNode{}
+
+	for _, id := range sortedIDs(level) {
+		cur := level[id]
+
+		for buyingID, ob := range s.Graph.Selling(id) {
+			// We don't want the same asset on the path twice as buying and
+			// then selling the asset will be a bad deal in most cases
+			// (especially A -> B -> A trades).
+			if cur.IsOnPath(buyingID) {
+				continue
+			}
+
+			// The last asset of the path has to be one of the source assets.
+			if depth == s.MaxLength && !s.isTarget(buyingID) {
+				continue
+			}
+
+			cost, err := ob.CostToConsumeLiquidity(cur.Amount)
+			if err == ErrNotEnough {
+				continue
+			}
+			if err != nil {
+				s.Err = err
+				return nil
+			}
+
+			if best, ok := next[buyingID]; ok && best.Amount <= cost {
+				continue
+			}
+
+			next[buyingID] = &pathNode{
+				ID:     buyingID,
+				Asset:  ob.Buying,
+				Tail:   cur,
+				Amount: cost,
+				Depth:  depth,
+			}
+		}
+	}
+
+	return next
+}
+
+// collect appends the paths in level starting with one of the source assets
+// to the results.
+func (s *search) collect(level map[string]*pathNode) {
+	for _, id := range sortedIDs(level) {
+		if s.isTarget(id) {
+			s.Results = append(s.Results, level[id].asPath())
+		}
+	}
+}
+
+// rank orders the results by source asset (in the order given in the query)
+// and then by cost, cheapest first, and trims them to maxResults.
+func (s *search) rank() {
+	sort.SliceStable(s.Results, func(i, j int) bool {
+		a, b := s.Results[i], s.Results[j]
+
+		ai, bi := s.targets[a.Source.String()], s.targets[b.Source.String()]
+		if ai != bi {
+			return ai < bi
+		}
+
+		if a.Cost != b.Cost {
+			return a.Cost < b.Cost
+		}
+
+		return len(a.Path) < len(b.Path)
+	})
+
+	if len(s.Results) > maxResults {
+		s.Results = s.Results[:maxResults]
+	}
+}
+
+// isTarget returns true if the asset id provided is one of the targets
+// for this search (i.e. one of the requesting account's trusted assets)
+func (s *search) isTarget(id string) bool {
+	_, found := s.targets[id]
+	return found
+}
+
+func sortedIDs(level map[string]*pathNode) []string {
+	ids := make([]string, 0, len(level))
+	for id := range level {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,149 @@
+package graphpath
+
+import (
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/paths"
+	"github.com/kinecosystem/go/xdr"
+)
+
+const issuer = "GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"
+
+func makeOffer(id int64, selling, buying string, amount xdr.Int64, pricen, priced int32) core.Offer {
+	return core.Offer{
+		OfferID:          id,
+		SellingAssetType: xdr.AssetTypeAssetTypeCreditAlphanum4,
+		SellingAssetCode: null.StringFrom(selling),
+		SellingIssuer:    null.StringFrom(issuer),
+		BuyingAssetType:  xdr.AssetTypeAssetTypeCreditAlphanum4,
+		BuyingAssetCode:  null.StringFrom(buying),
+		BuyingIssuer:     null.StringFrom(issuer),
+		Amount:           amount,
+		Pricen:           pricen,
+		Priced:           priced,
+		Price:            float64(pricen) / float64(priced),
+	}
+}
+
+func TestSearch(t *testing.T) {
+	usd := makeAsset(xdr.AssetTypeAssetTypeCreditAlphanum4, "USD", issuer)
+	eur := makeAsset(xdr.AssetTypeAssetTypeCreditAlphanum4, "EUR", issuer)
+	x := makeAsset(xdr.AssetTypeAssetTypeCreditAlphanum4, "X", issuer)
+
+	graph, err := newOrderBookGraph([]core.Offer{
+		makeOffer(1, "EUR", "X", 100, 1, 1),
+		makeOffer(2, "X", "USD", 100, 1, 1),
+		makeOffer(3, "EUR", "USD", 100, 2, 1),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	s := &search{
+		Query: paths.Query{
+			DestinationAsset:  eur,
+			DestinationAmount: 10,
+			SourceAssets:      []xdr.Asset{usd},
+		},
+		Graph:     graph,
+		MaxLength: paths.MaxPathLength,
+	}
+	s.Run()
+
+	if assert.NoError(t, s.Err) && assert.Len(t, s.Results, 2) {
+		// USD -> X -> EUR is cheaper than the direct path, so it comes first
+		assert.Equal(t, usd.String(), s.Results[0].Source.String())
+		assert.Equal(t, eur.String(), s.Results[0].Destination.String())
+		assert.Equal(t, xdr.Int64(10), s.Results[0].Cost)
+		if assert.Len(t, s.Results[0].Path, 1) {
+			assert.Equal(t, x.String(), s.Results[0].Path[0].String())
+		}
+
+		assert.Equal(t, xdr.Int64(20), s.Results[1].Cost)
+		assert.Len(t, s.Results[1].Path, 0)
+	}
+
+	// paths of two assets only leave the direct path
+	s.MaxLength = 2
+	s.Results = nil
+	s.Run()
+
+	if assert.NoError(t, s.Err) && assert.Len(t, s.Results, 1) {
+		assert.Equal(t, xdr.Int64(20), s.Results[0].Cost)
+	}
+
+	// not enough liquidity in any of the order books
+	s.MaxLength = paths.MaxPathLength
+	s.Query.DestinationAmount = 101
+	s.Results = nil
+	s.Run()
+
+	if assert.NoError(t, s.Err) {
+		assert.Len(t, s.Results, 0)
+	}
+}
+
+func TestSendSearch(t *testing.T) {
+	usd := makeAsset(xdr.AssetTypeAssetTypeCreditAlphanum4, "USD", issuer)
+	eur := makeAsset(xdr.AssetTypeAssetTypeCreditAlphanum4, "EUR", issuer)
+	x := makeAsset(xdr.AssetTypeAssetTypeCreditAlphanum4, "X", issuer)
+
+	graph, err := newOrderBookGraph([]core.Offer{
+		// rounding leaves 1 X of this offer when it is taken whole
+		makeOffer(1, "X", "USD", 20, 7, 11),
+		makeOffer(2, "X", "USD", 100, 1, 1),
+		makeOffer(3, "EUR", "X", 1000, 1, 1),
+		makeOffer(4, "EUR", "USD", 1000, 2, 1),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	s := &sendSearch{
+		Query: paths.StrictSendQuery{
+			SourceAsset:       usd,
+			SourceAmount:      100,
+			DestinationAssets: []xdr.Asset{eur},
+		},
+		Graph:     graph,
+		MaxLength: paths.MaxPathLength,
+	}
+	s.Run()
+
+	if assert.NoError(t, s.Err) && assert.Len(t, s.Results, 2) {
+		// USD -> X -> EUR receives more than the direct path, so it comes first
+		assert.Equal(t, usd.String(), s.Results[0].Source.String())
+		assert.Equal(t, eur.String(), s.Results[0].Destination.String())
+		assert.Equal(t, xdr.Int64(100), s.Results[0].Cost)
+		assert.Equal(t, xdr.Int64(106), s.Results[0].DestinationAmount)
+		if assert.Len(t, s.Results[0].Path, 1) {
+			assert.Equal(t, x.String(), s.Results[0].Path[0].String())
+		}
+
+		assert.Equal(t, xdr.Int64(50), s.Results[1].DestinationAmount)
+		assert.Len(t, s.Results[1].Path, 0)
+	}
+
+	// paths of two assets only leave the direct path
+	s.MaxLength = 2
+	s.Results = nil
+	s.Run()
+
+	if assert.NoError(t, s.Err) && assert.Len(t, s.Results, 1) {
+		assert.Equal(t, xdr.Int64(50), s.Results[0].DestinationAmount)
+	}
+
+	// not enough liquidity in any of the order books
+	s.MaxLength = paths.MaxPathLength
+	s.Query.SourceAmount = 2001
+	s.Results = nil
+	s.Run()
+
+	if assert.NoError(t, s.Err) {
+		assert.Len(t, s.Results, 0)
+	}
+}
@@ -0,0 +1,157 @@
+package graphpath
+
+import (
+	"sort"
+
+	"github.com/kinecosystem/go/services/horizon/internal/paths"
+)
+
+// sendSearch represents a single strict send query against the graph finder.
+// It is the mirror image of search: it starts at the source asset and walks
+// towards the destination assets, keeping for every asset the path of a given
+// length that receives the most of it.
+//
+// The sendSearch struct is used as follows:
+//
+// 1.  Create an instance, ensuring the Query, Graph and MaxLength fields are set
+// 2.  Call Run() to perform the search.
+type sendSearch struct {
+	Query     paths.StrictSendQuery
+	Graph     *orderBookGraph
+	MaxLength uint
+
+	// targets maps the string representation of the destination assets to
+	// their position in Query.DestinationAssets
+	targets map[string]int
+
+	//This fields below are initialized after the search is run
+	Err     error
+	Results []paths.Path
+}
+
+// Run triggers the search, which will populate the Results and Err field for
+// the search after completion.
+func (s *sendSearch) Run() {
+	s.targets = map[string]int{}
+	for i, a := range s.Query.DestinationAssets {
+		id := a.String()
+		if _, ok := s.targets[id]; !ok {
+			s.targets[id] = i
+		}
+	}
+
+	source := &pathNode{
+		ID:     s.Query.SourceAsset.String(),
+		Asset:  s.Query.SourceAsset,
+		Amount: s.Query.SourceAmount,
+		Depth:  1,
+	}
+
+	level := map[string]*pathNode{source.ID: source}
+	s.collect(level)
+
+	for depth := uint(2); depth <= s.MaxLength && len(level) > 0; depth++ {
+		level = s.relax(level, depth)
+		if s.Err != nil {
+			return
+		}
+		s.collect(level)
+	}
+
+	s.rank()
+}
+
+// relax extends every path in level by one asset and returns, for every asset
+// reached, the resulting path of `depth` assets that receives the most of it.
+func (s *sendSearch) relax(level map[string]*pathNode, depth uint) map[string]*pathNode {
+	next := map[string]*pathNode{}
+
+	for _, id := range sortedIDs(level) {
+		cur := level[id]
+
+		for sellingID, ob := range s.Graph.Buying(id) {
+			// We don't want the same asset on the path twice.
+			if cur.IsOnPath(sellingID) {
+				continue
+			}
+
+			// The last asset of the path has to be one of the destination assets.
+			if depth == s.MaxLength && !s.isTarget(sellingID) {
+				continue
+			}
+
+			received, err := ob.AmountReceived(cur.Amount)
+			if err == ErrNotEnough {
+				continue
+			}
+			if err != nil {
+				s.Err = err
+				return nil
+			}
+
+			if best, ok := next[sellingID]; ok && best.Amount >= received {
+				continue
+			}
+
+			next[sellingID] = &pathNode{
+				ID:     sellingID,
+				Asset:  ob.Selling,
+				Tail:   cur,
+				Amount: received,
+				Depth:  depth,
+			}
+		}
+	}
+
+	return next
+}
+
+// collect appends the paths in level ending with one of the destination
+// assets to the results.
+func (s *sendSearch) collect(level map[string]*pathNode) {
+	for _, id := range sortedIDs(level) {
+		if !s.isTarget(id) {
+			continue
+		}
+
+		cur := level[id]
+		// the list points from destination back to source
+		assets := cur.Flatten()
+		for i, j := 0, len(assets)-1; i < j; i, j = i+1, j-1 {
+			assets[i], assets[j] = assets[j], assets[i]
+		}
+
+		s.Results = append(s.Results, newPath(assets, s.Query.SourceAmount, cur.Amount))
+	}
+}
+
+// rank orders the results by destination asset (in the order given in the
+// query) and then by destination amount, largest first, and trims them to
+// maxResults.
+func (s *sendSearch) rank() {
+	sort.SliceStable(s.Results, func(i, j int) bool {
+		a, b := s.Results[i], s.Results[j]
+
+		ai, bi := s.targets[a.Destination.String()], s.targets[b.Destination.String()]
+		if ai != bi {
+			return ai < bi
+		}
+
+		if a.DestinationAmount != b.DestinationAmount {
+			return a.DestinationAmount > b.DestinationAmount
+		}
+
+		return len(a.Path) < len(b.Path)
+	})
+
+	if len(s.Results) > maxResults {
+		s.Results = s.Results[:maxResults]
+	}
+}
+
+// isTarget returns true if the asset id provided is one of the destination
+// assets of this search
+func (s *sendSearch) isTarget(id string) bool {
+	_, found := s.targets[id]
+	return found
+}
@@ -0,0 +1,164 @@
+package graphql
+
+import (
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/kinecosystem/go/services/horizon/internal/db2"
+	"github.com/kinecosystem/go/support/errors"
+)
+
+// Cost returns the number of pages of records `req` loads at most, which is
+// the number of HAL requests it replaces: each root field loads one page, and
+// each connection loads one page for every node of its parent connection.
+// ErrCostExceeded is returned once the cost exceeds `max`.
+func Cost(req Request, max int) (int, error) {
+	doc, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err != nil {
+		return 0, err
+	}
+
+	c := costCounter{
+		variables: map[string]interface{}{},
+		fragments: map[string]*ast.FragmentDefinition{},
+		visiting:  map[string]bool{},
+		max:       max,
+	}
+
+	var operation *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.FragmentDefinition:
+			c.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if req.OperationName == "" || (def.Name != nil && def.Name.Value == req.OperationName) {
+				if operation == nil {
+					operation = def
+				}
+			}
+		}
+	}
+
+	if operation == nil {
+		return 0, errors.New("no operation to execute")
+	}
+
+	for _, def := range operation.VariableDefinitions {
+		if def.DefaultValue != nil {
+			c.variables[def.Variable.Name.Value] = def.DefaultValue.GetValue()
+		}
+	}
+	for name, value := range req.Variables {
+		c.variables[name] = value
+	}
+
+	err = c.add(operation.SelectionSet, 1, true)
+	return c.cost, err
+}
+
+type costCounter struct {
+	variables map[string]interface{}
+	fragments map[string]*ast.FragmentDefinition
+	visiting  map[string]bool
+	max       int
+	cost      int
+}
+
+// add counts the pages loaded by the fields of `set`, resolved `nodes` times.
+func (c *costCounter) add(set *ast.SelectionSet, nodes int, root bool) error {
+	if set == nil {
+		return nil
+	}
+
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			name := selection.Name.Value
+			connection := connectionFields[name]
+			if !root && !connection {
+				err := c.add(selection.SelectionSet, nodes, false)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			c.cost += nodes
+			if c.cost > c.max {
+				return ErrCostExceeded
+			}
+
+			children := nodes
+			if connection {
+				children = nodes * c.first(selection)
+			}
+
+			err := c.add(selection.SelectionSet, children, false)
+			if err != nil {
+				return err
+			}
+		case *ast.InlineFragment:
+			err := c.add(selection.SelectionSet, nodes, root)
+			if err != nil {
+				return err
+			}
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			fragment, ok := c.fragments[name]
+			if !ok {
+				return errors.Errorf("unknown fragment %s", name)
+			}
+			if c.visiting[name] {
+				return errors.Errorf("fragment %s spreads itself", name)
+			}
+
+			c.visiting[name] = true
+			err := c.add(fragment.SelectionSet, nodes, root)
+			c.visiting[name] = false
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// first returns the page size requested by a connection field.
+func (c *costCounter) first(field *ast.Field) int {
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+
+		var value interface{}
+		switch v := arg.Value.(type) {
+		case *ast.Variable:
+			value = c.variables[v.Name.Value]
+		default:
+			value = v.GetValue()
+		}
+
+		var first int
+		switch value := value.(type) {
+		case string:
+			first, _ = strconv.Atoi(value)
+		case float64:
+			first = int(value)
+		case int:
+			first = value
+		default:
+			return db2.DefaultPageSize
+		}
+
+		// invalid page sizes fail when resolved, the maximum bounds the cost
+		// of the others
+		if first < 0 || first > db2.MaxPageSize {
+			return db2.MaxPageSize
+		}
+		return first
+	}
+
+	return db2.DefaultPageSize
+}
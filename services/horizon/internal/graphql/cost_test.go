@@ -0,0 +1,85 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCost(t *testing.T) {
+	cases := []struct {
+		name      string
+		req       Request
+		expected  int
+		exceeding bool
+	}{
+		{
+			name:     "single record",
+			req:      Request{Query: `{ account(id: "GA") { id balances { balance } } }`},
+			expected: 1,
+		},
+		{
+			name:     "default page size",
+			req:      Request{Query: `{ account(id: "GA") { operations { edges { node { effects { edges { cursor } } } } } } }`},
+			expected: 1 + 1 + 10,
+		},
+		{
+			name: "several root fields",
+			req: Request{Query: `{
+				ledger(sequence: 2) { transactions(first: 3) { edges { node { operations { edges { cursor } } } } } }
+				trades(first: 5) { edges { cursor } }
+			}`},
+			expected: 1 + 1 + 3 + 1,
+		},
+		{
+			name: "variables",
+			req: Request{
+				Query:     `query ($first: Int = 5, $n: Int) { ledgers(first: $first) { edges { node { effects(first: $n) { edges { cursor } } } } } }`,
+				Variables: map[string]interface{}{"n": float64(2)},
+			},
+			expected: 1 + 5,
+		},
+		{
+			name: "fragments",
+			req: Request{Query: `
+				query { ...root }
+				fragment root on Query { ledgers(first: 4) { edges { node { ...ledger } } } }
+				fragment ledger on Ledger { ... on Ledger { payments { edges { cursor } } } }
+			`},
+			expected: 1 + 4,
+		},
+		{
+			name: "named operation",
+			req: Request{
+				Query:         `query A { ledgers { edges { cursor } } } query B { account(id: "GA") { id } }`,
+				OperationName: "B",
+			},
+			expected: 1,
+		},
+		{
+			name:      "exceeded",
+			req:       Request{Query: `{ ledgers(first: 20) { edges { node { operations(first: 5) { edges { cursor } } } } } }`},
+			exceeding: true,
+		},
+	}
+
+	for _, kase := range cases {
+		t.Run(kase.name, func(t *testing.T) {
+			cost, err := Cost(kase.req, 20)
+			if kase.exceeding {
+				assert.Equal(t, ErrCostExceeded, err)
+				return
+			}
+
+			if assert.NoError(t, err) {
+				assert.Equal(t, kase.expected, cost)
+			}
+		})
+	}
+
+	_, err := Cost(Request{Query: `{ account(`}, 20)
+	assert.Error(t, err)
+
+	_, err = Cost(Request{Query: `query { ...a } fragment a on Query { ...a }`}, 20)
+	assert.Error(t, err)
+}
@@ -0,0 +1,59 @@
+// Package graphql contains the GraphQL interface of horizon's history.  Its
+// resolvers load records with the same queries as the HAL actions and render
+// them with the same resourceadapter populators, so that the fields of each
+// GraphQL type are named after the JSON keys of the matching HAL resource.
+// Lists of records are relay style connections, paged with the `first`,
+// `after` and `order` arguments.
+package graphql
+
+import (
+	"context"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/support/errors"
+)
+
+// DefaultMaxCost is the default cost limit of a query, see Cost.
+const DefaultMaxCost = 100
+
+// ErrCostExceeded is returned by Cost when a query loads more pages of records
+// than allowed.
+var ErrCostExceeded = errors.New("query cost exceeds the limit")
+
+// Request is a GraphQL request, as POSTed in a JSON body.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Queries are the databases the resolvers of a request load records from.
+type Queries struct {
+	History *history.Q
+	Core    *core.Q
+
+	// CoreProtocolVersion is the protocol version supported by stellar-core,
+	// used to load accounts.
+	CoreProtocolVersion int32
+}
+
+type queriesKey struct{}
+
+// Execute runs `req` against the schema, loading records from `q`.  Errors
+// raised while resolving fields are part of the result, as required by the
+// GraphQL specification.
+func Execute(ctx context.Context, q Queries, req Request) *gql.Result {
+	return gql.Do(gql.Params{
+		Schema:         Schema,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        context.WithValue(ctx, queriesKey{}, q),
+	})
+}
+
+func queriesFromContext(ctx context.Context) Queries {
+	return ctx.Value(queriesKey{}).(Queries)
+}
@@ -0,0 +1,442 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/services/horizon/internal/db2"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/support/render/hal"
+)
+
+// filter restricts the records of a connection to the ones of its parent.
+type filter struct {
+	Account     string
+	Ledger      int32
+	Transaction string
+	Operation   int64
+}
+
+// filterFn builds the filter of a connection from its rendered parent.
+type filterFn func(parent map[string]interface{}) (filter, error)
+
+func byAccount(parent map[string]interface{}) (filter, error) {
+	id, _ := parent["id"].(string)
+	return filter{Account: id}, nil
+}
+
+func byLedger(parent map[string]interface{}) (filter, error) {
+	seq, _ := parent["sequence"].(float64)
+	return filter{Ledger: int32(seq)}, nil
+}
+
+func byTransaction(parent map[string]interface{}) (filter, error) {
+	hash, _ := parent["hash"].(string)
+	return filter{Transaction: hash}, nil
+}
+
+func byOperation(parent map[string]interface{}) (filter, error) {
+	id, _ := parent["id"].(string)
+	op, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return filter{}, errors.Wrap(err, "invalid operation id")
+	}
+	return filter{Operation: op}, nil
+}
+
+// loader loads a page of rendered records matching a filter.
+type loader func(context.Context, Queries, filter, db2.PageQuery) ([]hal.Pageable, error)
+
+// connectionField returns a field resolving to the connection to the records
+// loaded by `load`, restricted to those of the parent when `by` is not nil.
+func connectionField(conn *gql.Object, load loader, by filterFn) *gql.Field {
+	return &gql.Field{
+		Type: gql.NewNonNull(conn),
+		Args: pageArgs,
+		Resolve: func(p gql.ResolveParams) (interface{}, error) {
+			var f filter
+			if by != nil {
+				parent, ok := p.Source.(map[string]interface{})
+				if !ok {
+					return nil, errors.New("unexpected parent")
+				}
+
+				var err error
+				f, err = by(parent)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			page, err := pageQuery(p.Args)
+			if err != nil {
+				return nil, err
+			}
+
+			records, err := load(p.Context, queriesFromContext(p.Context), f, page)
+			if err != nil {
+				return nil, err
+			}
+
+			return newConnection(records, page)
+		},
+	}
+}
+
+func pageQuery(args map[string]interface{}) (db2.PageQuery, error) {
+	first, _ := args["first"].(int)
+	if first <= 0 {
+		return db2.PageQuery{}, db2.ErrInvalidLimit
+	}
+
+	after, _ := args["after"].(string)
+	order, _ := args["order"].(string)
+	return db2.NewPageQuery(after, false, order, uint64(first))
+}
+
+func newConnection(records []hal.Pageable, page db2.PageQuery) (map[string]interface{}, error) {
+	edges := make([]interface{}, 0, len(records))
+	var endCursor interface{}
+	for _, record := range records {
+		node, err := toNode(record)
+		if err != nil {
+			return nil, err
+		}
+
+		endCursor = record.PagingToken()
+		edges = append(edges, map[string]interface{}{
+			"cursor": endCursor,
+			"node":   node,
+		})
+	}
+
+	return map[string]interface{}{
+		"edges": edges,
+		"pageInfo": map[string]interface{}{
+			"endCursor": endCursor,
+			// a full page may be followed by an empty one, just like the next
+			// link of a HAL page
+			"hasNextPage": uint64(len(records)) == page.Limit,
+		},
+	}, nil
+}
+
+// toNode returns the JSON object of a rendered resource, which the fields of
+// its GraphQL type are resolved from.
+func toNode(resource interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal resource")
+	}
+
+	var node map[string]interface{}
+	err = json.Unmarshal(raw, &node)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal resource")
+	}
+
+	return node, nil
+}
+
+func loadLedgers(ctx context.Context, q Queries, f filter, page db2.PageQuery) ([]hal.Pageable, error) {
+	var records []history.Ledger
+	err := q.History.Ledgers().Page(page).Select(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]hal.Pageable, 0, len(records))
+	for _, record := range records {
+		var res horizon.Ledger
+		resourceadapter.PopulateLedger(ctx, &res, record)
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+func loadTransactions(ctx context.Context, q Queries, f filter, page db2.PageQuery) ([]hal.Pageable, error) {
+	txs := q.History.Transactions()
+	switch {
+	case f.Account != "":
+		txs.ForAccount(f.Account)
+	case f.Ledger > 0:
+		txs.ForLedger(f.Ledger)
+	}
+
+	var records []history.Transaction
+	err := txs.Page(page).Select(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]hal.Pageable, 0, len(records))
+	for _, record := range records {
+		var res horizon.Transaction
+		resourceadapter.PopulateTransaction(ctx, &res, record)
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+func loadOperations(ctx context.Context, q Queries, f filter, page db2.PageQuery) ([]hal.Pageable, error) {
+	return selectOperations(ctx, q, f, page, false)
+}
+
+func loadPayments(ctx context.Context, q Queries, f filter, page db2.PageQuery) ([]hal.Pageable, error) {
+	return selectOperations(ctx, q, f, page, true)
+}
+
+func selectOperations(
+	ctx context.Context,
+	q Queries,
+	f filter,
+	page db2.PageQuery,
+	onlyPayments bool,
+) ([]hal.Pageable, error) {
+	ops := q.History.Operations()
+	if onlyPayments {
+		ops.OnlyPayments()
+	}
+
+	switch {
+	case f.Account != "":
+		ops.ForAccount(f.Account)
+	case f.Ledger > 0:
+		ops.ForLedger(f.Ledger)
+	case f.Transaction != "":
+		ops.ForTransaction(f.Transaction)
+	}
+
+	var records []history.Operation
+	err := ops.Page(page).Select(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgers := &history.LedgerCache{}
+	for _, record := range records {
+		ledgers.Queue(record.LedgerSequence())
+	}
+	err = ledgers.Load(q.History)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]hal.Pageable, 0, len(records))
+	for _, record := range records {
+		ledger, found := ledgers.Records[record.LedgerSequence()]
+		if !found {
+			return nil, fmt.Errorf("could not find ledger data for sequence %d", record.LedgerSequence())
+		}
+
+		res, err := resourceadapter.NewOperation(ctx, record, ledger)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+func loadEffects(ctx context.Context, q Queries, f filter, page db2.PageQuery) ([]hal.Pageable, error) {
+	effects := q.History.Effects()
+	switch {
+	case f.Account != "":
+		effects.ForAccount(f.Account)
+	case f.Ledger > 0:
+		effects.ForLedger(f.Ledger)
+	case f.Operation > 0:
+		effects.ForOperation(f.Operation)
+	case f.Transaction != "":
+		effects.ForTransaction(f.Transaction)
+	}
+
+	var records []history.Effect
+	err := effects.Page(page).Select(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgers := &history.LedgerCache{}
+	for _, record := range records {
+		ledgers.Queue(record.LedgerSequence())
+	}
+	err = ledgers.Load(q.History)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]hal.Pageable, 0, len(records))
+	for _, record := range records {
+		ledger, found := ledgers.Records[record.LedgerSequence()]
+		if !found {
+			return nil, fmt.Errorf("could not find ledger data for sequence %d", record.LedgerSequence())
+		}
+
+		res, err := resourceadapter.NewEffect(ctx, record, ledger)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+func loadTrades(ctx context.Context, q Queries, f filter, page db2.PageQuery) ([]hal.Pageable, error) {
+	trades := q.History.Trades()
+	if f.Account != "" {
+		trades.ForAccount(f.Account)
+	}
+
+	var records []history.Trade
+	err := trades.Page(page).Select(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]hal.Pageable, 0, len(records))
+	for _, record := range records {
+		var res horizon.Trade
+		resourceadapter.PopulateTrade(ctx, &res, record)
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+func resolveAccount(p gql.ResolveParams) (interface{}, error) {
+	q := queriesFromContext(p.Context)
+	address, _ := p.Args["id"].(string)
+
+	var (
+		coreRecord     core.Account
+		coreData       []core.AccountData
+		coreSigners    []core.Signer
+		coreTrustlines []core.Trustline
+		historyRecord  history.Account
+	)
+
+	err := q.Core.AccountByAddress(&coreRecord, address, q.CoreProtocolVersion)
+	if q.Core.NoRows(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.Core.AllDataByAddress(&coreData, address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.Core.SignersByAddress(&coreSigners, address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.Core.TrustlinesByAddress(&coreTrustlines, address, q.CoreProtocolVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.History.AccountByAddress(&historyRecord, address)
+	// accounts created outside of the known history range have no history
+	// record
+	if err != nil && !q.History.NoRows(err) {
+		return nil, err
+	}
+
+	var res horizon.Account
+	err = resourceadapter.PopulateAccount(
+		p.Context,
+		&res,
+		coreRecord,
+		coreData,
+		coreSigners,
+		coreTrustlines,
+		historyRecord,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return toNode(res)
+}
+
+func resolveLedger(p gql.ResolveParams) (interface{}, error) {
+	q := queriesFromContext(p.Context)
+	seq, _ := p.Args["sequence"].(int)
+
+	var record history.Ledger
+	err := q.History.LedgerBySequence(&record, int32(seq))
+	if q.History.NoRows(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res horizon.Ledger
+	resourceadapter.PopulateLedger(p.Context, &res, record)
+	return toNode(res)
+}
+
+func resolveTransaction(p gql.ResolveParams) (interface{}, error) {
+	q := queriesFromContext(p.Context)
+	hash, _ := p.Args["hash"].(string)
+
+	var record history.Transaction
+	err := q.History.TransactionByHash(&record, hash)
+	if q.History.NoRows(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res horizon.Transaction
+	resourceadapter.PopulateTransaction(p.Context, &res, record)
+	return toNode(res)
+}
+
+func resolveOperation(p gql.ResolveParams) (interface{}, error) {
+	q := queriesFromContext(p.Context)
+	raw, _ := p.Args["id"].(string)
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid operation id")
+	}
+
+	var record history.Operation
+	err = q.History.OperationByID(&record, id)
+	if q.History.NoRows(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var ledger history.Ledger
+	err = q.History.LedgerBySequence(&ledger, record.LedgerSequence())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resourceadapter.NewOperation(p.Context, record, ledger)
+	if err != nil {
+		return nil, err
+	}
+
+	return toNode(res)
+}
@@ -0,0 +1,295 @@
+package graphql
+
+import (
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/kinecosystem/go/services/horizon/internal/db2"
+)
+
+// connectionFields are the names of the fields resolved to connections, each
+// loading a page of records.
+var connectionFields = map[string]bool{
+	"ledgers":      true,
+	"transactions": true,
+	"operations":   true,
+	"payments":     true,
+	"effects":      true,
+	"trades":       true,
+}
+
+// jsonType holds JSON values, such as the type specific details of operations
+// and effects.
+var jsonType = gql.NewScalar(gql.ScalarConfig{
+	Name:        "JSON",
+	Description: "An arbitrary JSON value",
+	Serialize: func(value interface{}) interface{} {
+		return value
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return value
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		return valueAST.GetValue()
+	},
+})
+
+var pageInfoType = gql.NewObject(gql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: gql.Fields{
+		"endCursor":   &gql.Field{Type: gql.String},
+		"hasNextPage": &gql.Field{Type: gql.NewNonNull(gql.Boolean)},
+	},
+})
+
+var pageArgs = gql.FieldConfigArgument{
+	"first": &gql.ArgumentConfig{
+		Type:         gql.Int,
+		DefaultValue: db2.DefaultPageSize,
+		Description:  "The number of records to load, up to 200",
+	},
+	"after": &gql.ArgumentConfig{
+		Type:        gql.String,
+		Description: "The paging token to load records from",
+	},
+	"order": &gql.ArgumentConfig{
+		Type:         gql.String,
+		DefaultValue: db2.OrderAscending,
+		Description:  "Either asc or desc",
+	},
+}
+
+// connectionType returns the relay style connection to pages of `node`.
+func connectionType(node *gql.Object) *gql.Object {
+	edge := gql.NewObject(gql.ObjectConfig{
+		Name: node.Name() + "Edge",
+		Fields: gql.Fields{
+			"cursor": &gql.Field{Type: gql.NewNonNull(gql.String)},
+			"node":   &gql.Field{Type: gql.NewNonNull(node)},
+		},
+	})
+
+	return gql.NewObject(gql.ObjectConfig{
+		Name: node.Name() + "Connection",
+		Fields: gql.Fields{
+			"edges":    &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(edge)))},
+			"pageInfo": &gql.Field{Type: gql.NewNonNull(pageInfoType)},
+		},
+	})
+}
+
+// scalars returns fields of type `typ`, resolved from the keys of the same
+// name of a rendered resource.
+func scalars(typ gql.Output, names ...string) gql.Fields {
+	fields := gql.Fields{}
+	for _, name := range names {
+		fields[name] = &gql.Field{Type: typ}
+	}
+	return fields
+}
+
+func merge(all ...gql.Fields) gql.Fields {
+	fields := gql.Fields{}
+	for _, f := range all {
+		for name, field := range f {
+			fields[name] = field
+		}
+	}
+	return fields
+}
+
+// details resolves to the whole rendered resource, including its type
+// specific fields and links.
+var details = &gql.Field{
+	Type: jsonType,
+	Resolve: func(p gql.ResolveParams) (interface{}, error) {
+		return p.Source, nil
+	},
+}
+
+var effectType = gql.NewObject(gql.ObjectConfig{
+	Name: "Effect",
+	Fields: merge(
+		scalars(gql.String, "id", "paging_token", "account", "type", "created_at"),
+		scalars(gql.Int, "type_i"),
+		gql.Fields{"details": details},
+	),
+})
+
+var effectConnection = connectionType(effectType)
+
+var priceType = gql.NewObject(gql.ObjectConfig{
+	Name:   "Price",
+	Fields: scalars(gql.Int, "n", "d"),
+})
+
+var tradeType = gql.NewObject(gql.ObjectConfig{
+	Name: "Trade",
+	Fields: merge(
+		scalars(gql.String,
+			"id", "paging_token", "ledger_close_time", "offer_id",
+			"base_offer_id", "base_account", "base_amount",
+			"base_asset_type", "base_asset_code", "base_asset_issuer",
+			"counter_offer_id", "counter_account", "counter_amount",
+			"counter_asset_type", "counter_asset_code", "counter_asset_issuer",
+		),
+		scalars(gql.Boolean, "base_is_seller"),
+		gql.Fields{"price": &gql.Field{Type: priceType}},
+	),
+})
+
+var tradeConnection = connectionType(tradeType)
+
+var operationType = gql.NewObject(gql.ObjectConfig{
+	Name: "Operation",
+	Fields: merge(
+		scalars(gql.String, "id", "paging_token", "source_account", "type", "created_at", "transaction_hash"),
+		scalars(gql.Int, "type_i"),
+		gql.Fields{
+			"details": details,
+			"effects": connectionField(effectConnection, loadEffects, byOperation),
+		},
+	),
+})
+
+var operationConnection = connectionType(operationType)
+
+var transactionType = gql.NewObject(gql.ObjectConfig{
+	Name: "Transaction",
+	Fields: merge(
+		scalars(gql.String,
+			"id", "paging_token", "hash", "created_at", "source_account",
+			"source_account_sequence", "envelope_xdr", "result_xdr",
+			"result_meta_xdr", "fee_meta_xdr", "memo_type", "memo",
+			"valid_after", "valid_before",
+		),
+		scalars(gql.Int, "ledger", "fee_paid", "operation_count"),
+		gql.Fields{
+			"signatures": &gql.Field{Type: gql.NewList(gql.String)},
+			"operations": connectionField(operationConnection, loadOperations, byTransaction),
+			"payments":   connectionField(operationConnection, loadPayments, byTransaction),
+			"effects":    connectionField(effectConnection, loadEffects, byTransaction),
+		},
+	),
+})
+
+var transactionConnection = connectionType(transactionType)
+
+var ledgerType = gql.NewObject(gql.ObjectConfig{
+	Name: "Ledger",
+	Fields: merge(
+		scalars(gql.String,
+			"id", "paging_token", "hash", "prev_hash", "closed_at",
+			"total_coins", "fee_pool", "header_xdr",
+		),
+		scalars(gql.Int,
+			"sequence", "successful_transaction_count",
+			"failed_transaction_count", "operation_count",
+			"base_fee_in_stroops", "base_reserve_in_stroops",
+			"max_tx_set_size", "protocol_version",
+		),
+		gql.Fields{
+			"transactions": connectionField(transactionConnection, loadTransactions, byLedger),
+			"operations":   connectionField(operationConnection, loadOperations, byLedger),
+			"payments":     connectionField(operationConnection, loadPayments, byLedger),
+			"effects":      connectionField(effectConnection, loadEffects, byLedger),
+		},
+	),
+})
+
+var ledgerConnection = connectionType(ledgerType)
+
+var balanceType = gql.NewObject(gql.ObjectConfig{
+	Name: "Balance",
+	Fields: scalars(gql.String,
+		"balance", "limit", "buying_liabilities", "selling_liabilities",
+		"asset_type", "asset_code", "asset_issuer",
+	),
+})
+
+var signerType = gql.NewObject(gql.ObjectConfig{
+	Name: "Signer",
+	Fields: merge(
+		scalars(gql.String, "public_key", "key", "type"),
+		scalars(gql.Int, "weight"),
+	),
+})
+
+var accountType = gql.NewObject(gql.ObjectConfig{
+	Name: "Account",
+	Fields: merge(
+		scalars(gql.String,
+			"id", "paging_token", "account_id", "sequence",
+			"inflation_destination", "home_domain",
+		),
+		scalars(gql.Int, "subentry_count"),
+		gql.Fields{
+			"thresholds": &gql.Field{Type: gql.NewObject(gql.ObjectConfig{
+				Name:   "AccountThresholds",
+				Fields: scalars(gql.Int, "low_threshold", "med_threshold", "high_threshold"),
+			})},
+			"flags": &gql.Field{Type: gql.NewObject(gql.ObjectConfig{
+				Name:   "AccountFlags",
+				Fields: scalars(gql.Boolean, "auth_required", "auth_revocable", "auth_immutable"),
+			})},
+			"balances":     &gql.Field{Type: gql.NewList(balanceType)},
+			"signers":      &gql.Field{Type: gql.NewList(signerType)},
+			"data":         &gql.Field{Type: jsonType},
+			"transactions": connectionField(transactionConnection, loadTransactions, byAccount),
+			"operations":   connectionField(operationConnection, loadOperations, byAccount),
+			"payments":     connectionField(operationConnection, loadPayments, byAccount),
+			"effects":      connectionField(effectConnection, loadEffects, byAccount),
+			"trades":       connectionField(tradeConnection, loadTrades, byAccount),
+		},
+	),
+})
+
+var queryType = gql.NewObject(gql.ObjectConfig{
+	Name: "Query",
+	Fields: gql.Fields{
+		"account": &gql.Field{
+			Type: accountType,
+			Args: gql.FieldConfigArgument{
+				"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+			},
+			Resolve: resolveAccount,
+		},
+		"ledger": &gql.Field{
+			Type: ledgerType,
+			Args: gql.FieldConfigArgument{
+				"sequence": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Int)},
+			},
+			Resolve: resolveLedger,
+		},
+		"transaction": &gql.Field{
+			Type: transactionType,
+			Args: gql.FieldConfigArgument{
+				"hash": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+			},
+			Resolve: resolveTransaction,
+		},
+		"operation": &gql.Field{
+			Type: operationType,
+			Args: gql.FieldConfigArgument{
+				"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+			},
+			Resolve: resolveOperation,
+		},
+		"ledgers":      connectionField(ledgerConnection, loadLedgers, nil),
+		"transactions": connectionField(transactionConnection, loadTransactions, nil),
+		"operations":   connectionField(operationConnection, loadOperations, nil),
+		"payments":     connectionField(operationConnection, loadPayments, nil),
+		"effects":      connectionField(effectConnection, loadEffects, nil),
+		"trades":       connectionField(tradeConnection, loadTrades, nil),
+	},
+})
+
+// Schema is the GraphQL schema of horizon's history.
+var Schema gql.Schema
+
+func init() {
+	var err error
+	Schema, err = gql.NewSchema(gql.SchemaConfig{Query: queryType})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,177 @@
+package ingest
+
+import (
+	"encoding/hex"
+	"io"
+
+	"github.com/kinecosystem/go/network"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/support/historyarchive"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// NewArchiveSource connects to the history archive at `archiveURL` and
+// returns a LedgerSource reading the ledgers of the network identified by
+// `passphrase` from it.
+func NewArchiveSource(archiveURL string, passphrase string) (*ArchiveSource, error) {
+	archive, err := historyarchive.Connect(archiveURL, historyarchive.ConnectOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to history archive")
+	}
+
+	return &ArchiveSource{Archive: archive, Network: passphrase}, nil
+}
+
+// LoadLedger implements LedgerSource.  The checkpoint files holding the
+// ledger are downloaded and kept until a ledger of another checkpoint is
+// loaded, so that ledgers are best loaded in order.
+func (as *ArchiveSource) LoadLedger(lb *LedgerBundle) error {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+
+	if lb.Sequence <= 0 {
+		return errors.Errorf("invalid ledger sequence %d", lb.Sequence)
+	}
+
+	seq := uint32(lb.Sequence)
+	checkpoint := historyarchive.NextCheckpoint(seq)
+	if as.checkpoint != checkpoint || as.ledgers == nil {
+		err := as.loadCheckpoint(checkpoint)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load checkpoint %d", checkpoint)
+		}
+	}
+
+	header, ok := as.ledgers[seq]
+	if !ok {
+		return errors.Errorf("ledger %d not found in history archive", seq)
+	}
+
+	lb.Header = core.LedgerHeader{
+		LedgerHash:     hex.EncodeToString(header.Hash[:]),
+		PrevHash:       hex.EncodeToString(header.Header.PreviousLedgerHash[:]),
+		BucketListHash: hex.EncodeToString(header.Header.BucketListHash[:]),
+		CloseTime:      int64(header.Header.ScpValue.CloseTime),
+		Sequence:       seq,
+		Data:           header.Header,
+	}
+
+	envelopes := map[string]xdr.TransactionEnvelope{}
+	for _, env := range as.transactions[seq] {
+		hash, err := network.HashTransaction(&env.Tx, as.Network)
+		if err != nil {
+			return errors.Wrap(err, "failed to hash transaction")
+		}
+		envelopes[hex.EncodeToString(hash[:])] = env
+	}
+
+	// Results are in application order, transaction sets are not.
+	results := as.results[seq]
+	lb.Transactions = make([]core.Transaction, len(results))
+	lb.TransactionFees = make([]core.TransactionFee, len(results))
+	lb.FromArchive = true
+
+	for i, result := range results {
+		hash := hex.EncodeToString(result.TransactionHash[:])
+		env, ok := envelopes[hash]
+		if !ok {
+			return errors.Errorf("transaction %s of ledger %d not found in history archive", hash, seq)
+		}
+
+		// History archives don't hold transaction meta.  The meta of each
+		// operation is left empty so that the ledger is walked like any other,
+		// lb.FromArchive keeps it from being stored.
+		operations := make([]xdr.OperationMeta, len(env.Tx.Operations))
+
+		lb.Transactions[i] = core.Transaction{
+			TransactionHash: hash,
+			LedgerSequence:  lb.Sequence,
+			Index:           int32(i + 1),
+			Envelope:        env,
+			Result:          result,
+			ResultMeta:      xdr.TransactionMeta{Operations: &operations},
+		}
+		lb.TransactionFees[i] = core.TransactionFee{
+			TransactionHash: hash,
+			LedgerSequence:  lb.Sequence,
+			Index:           int32(i + 1),
+		}
+	}
+
+	return nil
+}
+
+// loadCheckpoint downloads the ledger, transactions and results files of
+// `checkpoint`, replacing the ones of the previous checkpoint.
+func (as *ArchiveSource) loadCheckpoint(checkpoint uint32) error {
+	ledgers := map[uint32]xdr.LedgerHeaderHistoryEntry{}
+	transactions := map[uint32][]xdr.TransactionEnvelope{}
+	results := map[uint32][]xdr.TransactionResultPair{}
+
+	err := as.readCategory("ledger", checkpoint, func() interface{} {
+		return &xdr.LedgerHeaderHistoryEntry{}
+	}, func(entry interface{}) {
+		e := entry.(*xdr.LedgerHeaderHistoryEntry)
+		ledgers[uint32(e.Header.LedgerSeq)] = *e
+	})
+	if err != nil {
+		return err
+	}
+
+	err = as.readCategory("transactions", checkpoint, func() interface{} {
+		return &xdr.TransactionHistoryEntry{}
+	}, func(entry interface{}) {
+		e := entry.(*xdr.TransactionHistoryEntry)
+		transactions[uint32(e.LedgerSeq)] = e.TxSet.Txs
+	})
+	if err != nil {
+		return err
+	}
+
+	err = as.readCategory("results", checkpoint, func() interface{} {
+		return &xdr.TransactionHistoryResultEntry{}
+	}, func(entry interface{}) {
+		e := entry.(*xdr.TransactionHistoryResultEntry)
+		results[uint32(e.LedgerSeq)] = e.TxResultSet.Results
+	})
+	if err != nil {
+		return err
+	}
+
+	as.checkpoint = checkpoint
+	as.ledgers = ledgers
+	as.transactions = transactions
+	as.results = results
+	return nil
+}
+
+// readCategory calls `add` with every entry of the `category` file of
+// `checkpoint`, each decoded into a value returned by `alloc`.
+func (as *ArchiveSource) readCategory(
+	category string,
+	checkpoint uint32,
+	alloc func() interface{},
+	add func(interface{}),
+) error {
+	path := historyarchive.CategoryCheckpointPath(category, checkpoint)
+	stream, err := as.Archive.GetXdrStream(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer stream.Close()
+
+	for {
+		entry := alloc()
+		err = stream.ReadOne(entry)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", path)
+		}
+		add(entry)
+	}
+}
+
+var _ LedgerSource = (*ArchiveSource)(nil)
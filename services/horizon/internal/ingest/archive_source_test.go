@@ -0,0 +1,182 @@
+package ingest
+
+import (
+	"compress/gzip"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kinecosystem/go/network"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/ledger"
+	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/kinecosystem/go/support/historyarchive"
+	"github.com/kinecosystem/go/xdr"
+)
+
+func TestArchiveSource(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	dir, err := ioutil.TempDir("", "horizon-archive")
+	tt.Require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// archive ledger 2 as loaded from stellar-core's database
+	expected := &LedgerBundle{Sequence: 2}
+	tt.Require.NoError(expected.Load(tt.CoreSession()))
+	writeArchiveCheckpoint(tt, dir, expected)
+
+	source, err := NewArchiveSource("file://"+dir, network.TestNetworkPassphrase)
+	tt.Require.NoError(err)
+
+	bundle := &LedgerBundle{Sequence: 2}
+	err = source.LoadLedger(bundle)
+	if tt.Assert.NoError(err) {
+		tt.Assert.True(bundle.FromArchive)
+		tt.Assert.Equal(expected.Header.LedgerHash, bundle.Header.LedgerHash)
+		tt.Assert.Equal(expected.Header.PrevHash, bundle.Header.PrevHash)
+		tt.Assert.Equal(expected.Header.CloseTime, bundle.Header.CloseTime)
+		tt.Assert.Equal(expected.Header.Data, bundle.Header.Data)
+		tt.Assert.Len(bundle.TransactionFees, len(expected.Transactions))
+
+		// transactions are in application order
+		if tt.Assert.Len(bundle.Transactions, len(expected.Transactions)) {
+			for i, tx := range bundle.Transactions {
+				tt.Assert.Equal(expected.Transactions[i].TransactionHash, tx.TransactionHash)
+				tt.Assert.Equal(expected.Transactions[i].Index, tx.Index)
+				tt.Assert.Equal(expected.Transactions[i].Envelope, tx.Envelope)
+				tt.Assert.Equal(expected.Transactions[i].Result, tx.Result)
+			}
+		}
+	}
+
+	// ledgers missing from the archive are reported
+	err = source.LoadLedger(&LedgerBundle{Sequence: 3})
+	tt.Assert.Error(err)
+}
+
+func TestArchiveSourceIngest(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("trades")
+	defer tt.Finish()
+
+	dir, err := ioutil.TempDir("", "horizon-archive")
+	tt.Require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	latest := ledger.CurrentState().CoreLatest
+	tt.Require.True(latest < 64, "the ledgers must fit in a single checkpoint")
+
+	var bundles []*LedgerBundle
+	for seq := int32(1); seq <= latest; seq++ {
+		lb := &LedgerBundle{Sequence: seq}
+		tt.Require.NoError(lb.Load(tt.CoreSession()))
+		bundles = append(bundles, lb)
+	}
+	writeArchiveCheckpoint(tt, dir, bundles...)
+
+	source, err := NewArchiveSource("file://"+dir, network.TestNetworkPassphrase)
+	tt.Require.NoError(err)
+
+	sys := sys(tt, false)
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, latest, sys)
+	s.Cursor.Source = source
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	q := &history.Q{Session: tt.HorizonSession()}
+
+	var ledgers []history.Ledger
+	tt.Require.NoError(q.Ledgers().Select(&ledgers))
+	tt.Require.Len(ledgers, int(latest))
+	for _, l := range ledgers {
+		tt.Assert.True(l.FromArchive)
+	}
+
+	// the meta of the transactions is unknown
+	var txs []history.Transaction
+	tt.Require.NoError(q.Transactions().Select(&txs))
+	tt.Require.NotEmpty(txs)
+	for _, tx := range txs {
+		tt.Assert.False(tx.TxMeta.Valid)
+		tt.Assert.False(tx.TxFeeMeta.Valid)
+	}
+
+	// so are the prices of the trades
+	var trades []history.Trade
+	tt.Require.NoError(q.Trades().Select(&trades))
+	tt.Require.NotEmpty(trades)
+	for _, trade := range trades {
+		tt.Assert.False(trade.HasPrice())
+	}
+
+	// and the effects derived from the meta are not ingested
+	var found int
+	err = tt.HorizonSession().GetRaw(&found,
+		"SELECT COUNT(*) FROM history_effects WHERE type IN (?, ?, ?)",
+		history.EffectTrustlineCreated,
+		history.EffectTrustlineUpdated,
+		history.EffectTrustlineRemoved,
+	)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(0, found)
+}
+
+// writeArchiveCheckpoint writes the ledger, transactions and results files of
+// the checkpoint holding `lbs`, which must all be part of the same checkpoint,
+// to the history archive at `dir`.
+func writeArchiveCheckpoint(tt *test.T, dir string, lbs ...*LedgerBundle) {
+	checkpoint := historyarchive.NextCheckpoint(uint32(lbs[0].Sequence))
+	entries := map[string][]interface{}{}
+
+	for _, lb := range lbs {
+		tt.Require.Equal(checkpoint, historyarchive.NextCheckpoint(uint32(lb.Sequence)))
+
+		var ledgerHash xdr.Hash
+		raw, err := hex.DecodeString(lb.Header.LedgerHash)
+		tt.Require.NoError(err)
+		copy(ledgerHash[:], raw)
+
+		txs := []xdr.TransactionEnvelope{}
+		results := []xdr.TransactionResultPair{}
+		for i := range lb.Transactions {
+			// transaction sets aren't in application order
+			txs = append([]xdr.TransactionEnvelope{lb.Transactions[i].Envelope}, txs...)
+			results = append(results, lb.Transactions[i].Result)
+		}
+
+		entries["ledger"] = append(entries["ledger"], xdr.LedgerHeaderHistoryEntry{
+			Hash:   ledgerHash,
+			Header: lb.Header.Data,
+		})
+		entries["transactions"] = append(entries["transactions"], xdr.TransactionHistoryEntry{
+			LedgerSeq: xdr.Uint32(lb.Sequence),
+			TxSet: xdr.TransactionSet{
+				PreviousLedgerHash: lb.Header.Data.PreviousLedgerHash,
+				Txs:                txs,
+			},
+		})
+		entries["results"] = append(entries["results"], xdr.TransactionHistoryResultEntry{
+			LedgerSeq:   xdr.Uint32(lb.Sequence),
+			TxResultSet: xdr.TransactionResultSet{Results: results},
+		})
+	}
+
+	for category, list := range entries {
+		path := filepath.Join(dir, historyarchive.CategoryCheckpointPath(category, checkpoint))
+		tt.Require.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+
+		file, err := os.Create(path)
+		tt.Require.NoError(err)
+
+		gz := gzip.NewWriter(file)
+		for _, entry := range list {
+			tt.Require.NoError(historyarchive.WriteFramedXdr(gz, entry))
+		}
+		tt.Require.NoError(gz.Close())
+		tt.Require.NoError(file.Close())
+	}
+}
@@ -198,7 +198,12 @@ func (assetStats *AssetStats) computeAssetStat(asset *xdr.Asset) (*history.Asset
 		return nil, nil
 	}
 
-	historyQ := &history.Q{Session: assetStats.HistorySession}
+	idSession := assetStats.IDSession
+	if idSession == nil {
+		idSession = assetStats.HistorySession
+	}
+
+	historyQ := &history.Q{Session: idSession}
 	assetID, err := historyQ.GetCreateAssetID(*asset)
 	if err != nil {
 		return nil, errors.Wrap(err, "historyQ.GetCreateAssetID error")
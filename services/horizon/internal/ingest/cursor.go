@@ -90,7 +90,11 @@ func (c *Cursor) NextLedger() bool {
 
 	c.data = &LedgerBundle{Sequence: c.lg}
 	start := time.Now()
-	c.Err = c.data.Load(c.CoreDB)
+	if c.Source != nil {
+		c.Err = c.Source.LoadLedger(c.data)
+	} else {
+		c.Err = c.data.Load(c.CoreDB)
+	}
 	if c.Err != nil {
 		return false
 	}
@@ -157,6 +161,12 @@ func (c *Cursor) Operation() *xdr.Operation {
 	return &c.data.Transactions[c.tx].Envelope.Tx.Operations[c.op]
 }
 
+// FromArchive returns true if the current ledger was loaded from a history
+// archive, in which case its transactions have no meta.
+func (c *Cursor) FromArchive() bool {
+	return c.data.FromArchive
+}
+
 // OperationChanges returns all of LedgerEntryChanges that occurred in the
 // course of applying the current operation.
 func (c *Cursor) OperationChanges() xdr.LedgerEntryChanges {
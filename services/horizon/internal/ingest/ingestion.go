@@ -47,6 +47,10 @@ func (ingest *Ingestion) Clear(start int64, end int64) error {
 	if err != nil {
 		return errors.Wrap(err, "Error clearing history_operation_participants")
 	}
+	err = clear(start, end, "history_operation_assets", "history_operation_id")
+	if err != nil {
+		return errors.Wrap(err, "Error clearing history_operation_assets")
+	}
 	err = clear(start, end, "history_operations", "id")
 	if err != nil {
 		return errors.Wrap(err, "Error clearing history_operations")
@@ -76,6 +80,15 @@ func (ingest *Ingestion) Close() error {
 	return ingest.commit()
 }
 
+// AccountsChanged notifies the subscribers of each of the accounts `aids` that
+// its state was changed by the current ingestion.
+func (ingest *Ingestion) AccountsChanged(aids []xdr.AccountId) {
+	for _, aid := range aids {
+		// Wait for data to be committed to database, then notify subscribers.
+		go ingest.publishOnDBCommit(ingest.subscribeToDBCommit(), aid.Address())
+	}
+}
+
 // Effect adds a new row into the `history_effects` table.
 func (ingest *Ingestion) Effect(address Address, opid int64, order int, typ history.EffectType, details interface{}) error {
 	djson, err := json.Marshal(details)
@@ -93,6 +106,7 @@ func (ingest *Ingestion) Flush() error {
 	tables := []TableName{
 		EffectsTableName,
 		LedgersTableName,
+		OperationAssetsTableName,
 		OperationParticipantsTableName,
 		OperationsTableName,
 		TradesTableName,
@@ -164,7 +178,7 @@ func (ingest *Ingestion) UpdateAccountIDs(tables []TableName) error {
 	if len(addresses) > 0 {
 		// TODO we should probably batch this too
 		dbAccounts = make([]history.Account, 0, len(addresses))
-		err = q.CreateAccounts(&dbAccounts, addresses)
+		err = ingest.idsQ().CreateAccounts(&dbAccounts, addresses)
 		if err != nil {
 			return errors.Wrap(err, "q.CreateAccounts error")
 		}
@@ -172,6 +186,20 @@ func (ingest *Ingestion) UpdateAccountIDs(tables []TableName) error {
 		for _, row := range dbAccounts {
 			accounts[Address(row.Address)] = row.ID
 		}
+
+		// Addresses created concurrently by another session (e.g. a parallel
+		// reingestion chunk) are not returned by CreateAccounts, load them.
+		if len(dbAccounts) < len(addresses) {
+			dbAccounts = make([]history.Account, 0, len(addresses)-len(dbAccounts))
+			err = q.AccountsByAddresses(&dbAccounts, addresses)
+			if err != nil {
+				return errors.Wrap(err, "q.AccountsByAddresses error")
+			}
+
+			for _, row := range dbAccounts {
+				accounts[Address(row.Address)] = row.ID
+			}
+		}
 	}
 
 	// Update IDs in objects
@@ -210,6 +238,7 @@ func (ingest *Ingestion) Ledger(
 	successTxsCount int,
 	failedTxsCount int,
 	ops int,
+	fromArchive bool,
 ) {
 
 	// Wait for data to be committed to database, then notify subscribers.
@@ -240,6 +269,7 @@ func (ingest *Ingestion) Ledger(
 		ops,
 		header.Data.LedgerVersion,
 		header.DataXDR(),
+		fromArchive,
 	)
 }
 
@@ -275,6 +305,35 @@ func (ingest *Ingestion) OperationParticipants(op int64, aids []xdr.AccountId) {
 	}
 }
 
+// OperationAssets ingests the provided `assets` as participants of operation
+// with id `op`, creating a new row in the `history_operation_assets` table.
+func (ingest *Ingestion) OperationAssets(op int64, assets []xdr.Asset) error {
+	q := ingest.idsQ()
+
+	for _, asset := range assets {
+		assetID, err := q.GetCreateAssetID(asset)
+		if err != nil {
+			return errors.Wrap(err, "failed to get asset id")
+		}
+
+		// Wait for data to be committed to database, then notify subscribes.
+		go ingest.publishOnDBCommit(ingest.subscribeToDBCommit(), AssetTopic(asset))
+
+		ingest.builders[OperationAssetsTableName].Values(op, assetID)
+	}
+
+	return nil
+}
+
+// OrderBook notifies the subscribers of the order book trading `selling`
+// against `buying`, and of the offers in general, that offers between these
+// assets were changed by the current ingestion.
+func (ingest *Ingestion) OrderBook(selling, buying xdr.Asset) {
+	// Wait for data to be committed to database, then notify subscribers.
+	go ingest.publishOnDBCommit(ingest.subscribeToDBCommit(), OrderBookTopic(selling, buying))
+	go ingest.publishOnDBCommit(ingest.subscribeToDBCommit(), "offers")
+}
+
 // Rollback aborts this ingestions transaction
 func (ingest *Ingestion) Rollback() (err error) {
 	err = ingest.DB.Rollback()
@@ -301,10 +360,7 @@ func (ingest *Ingestion) Trade(
 	trade xdr.ClaimOfferAtom,
 	ledgerClosedAt int64,
 ) error {
-	// Wait for data to be committed to database, then notify subscribes.
-	go ingest.publishOnDBCommit(ingest.subscribeToDBCommit(), "order_book")
-
-	q := history.Q{Session: ingest.DB}
+	q := ingest.idsQ()
 
 	sellerAccountId, err := q.GetCreateAccountID(trade.SellerId)
 	if err != nil {
@@ -354,11 +410,13 @@ func (ingest *Ingestion) Trade(
 }
 
 // Transaction ingests the provided transaction data into a new row in the
-// `history_transactions` table
+// `history_transactions` table.  The meta of transactions loaded from a
+// history archive is unknown and stored as NULL.
 func (ingest *Ingestion) Transaction(
 	id int64,
 	tx *core.Transaction,
 	fee *core.TransactionFee,
+	fromArchive bool,
 ) {
 	// Enquote empty signatures
 	signatures := tx.Base64Signatures()
@@ -377,14 +435,15 @@ func (ingest *Ingestion) Transaction(
 		len(tx.Envelope.Tx.Operations),
 		tx.EnvelopeXDR(),
 		tx.ResultXDR(),
-		tx.ResultMetaXDR(),
-		fee.ChangesXDR(),
+		null.NewString(tx.ResultMetaXDR(), !fromArchive),
+		null.NewString(fee.ChangesXDR(), !fromArchive),
 		sqx.StringArray(signatures),
 		ingest.formatTimeBounds(tx.Envelope.Tx.TimeBounds),
 		tx.MemoType(),
 		tx.Memo(),
 		time.Now().UTC(),
 		time.Now().UTC(),
+		tx.IsSuccessful(),
 	)
 }
 
@@ -424,6 +483,7 @@ func (ingest *Ingestion) createInsertBuilders() {
 			"operation_count",
 			"protocol_version",
 			"ledger_header",
+			"from_archive",
 		},
 	}
 
@@ -448,6 +508,7 @@ func (ingest *Ingestion) createInsertBuilders() {
 			"memo",
 			"created_at",
 			"updated_at",
+			"successful",
 		},
 	}
 
@@ -479,6 +540,14 @@ func (ingest *Ingestion) createInsertBuilders() {
 		},
 	}
 
+	ingest.builders[OperationAssetsTableName] = &BatchInsertBuilder{
+		TableName: OperationAssetsTableName,
+		Columns: []string{
+			"history_operation_id",
+			"history_asset_id",
+		},
+	}
+
 	ingest.builders[EffectsTableName] = &BatchInsertBuilder{
 		TableName: EffectsTableName,
 		Columns: []string{
@@ -508,6 +577,37 @@ func (ingest *Ingestion) createInsertBuilders() {
 	}
 }
 
+// createTradeIDs creates the history ids of the accounts and assets of
+// `trade`, so that inserting the trade within the ingestion transaction only
+// loads them.
+func (ingest *Ingestion) createTradeIDs(buyer xdr.AccountId, trade xdr.ClaimOfferAtom) error {
+	q := ingest.idsQ()
+
+	for _, aid := range []xdr.AccountId{trade.SellerId, buyer} {
+		_, err := q.GetCreateAccountID(aid)
+		if err != nil {
+			return errors.Wrap(err, "failed to get account id")
+		}
+	}
+
+	for _, asset := range []xdr.Asset{trade.AssetSold, trade.AssetBought} {
+		_, err := q.GetCreateAssetID(asset)
+		if err != nil {
+			return errors.Wrap(err, "failed to get asset id")
+		}
+	}
+
+	return nil
+}
+
+// idsQ returns the query helper creating history account and asset ids.
+func (ingest *Ingestion) idsQ() *history.Q {
+	if ingest.IDs == nil {
+		return &history.Q{Session: ingest.DB}
+	}
+	return &history.Q{Session: ingest.IDs}
+}
+
 func (ingest *Ingestion) commit() error {
 	err := ingest.DB.Commit()
 	if err != nil {
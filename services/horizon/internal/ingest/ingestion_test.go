@@ -2,6 +2,7 @@ package ingest
 
 import (
 	"testing"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
@@ -40,7 +41,7 @@ func TestEmptySignature(t *testing.T) {
 
 	transactionFee := &core.TransactionFee{}
 
-	ingestion.Transaction(1, transaction, transactionFee)
+	ingestion.Transaction(1, transaction, transactionFee, false)
 	assert.Equal(t, 1, len(ingestion.builders[TransactionsTableName].rows))
 
 	err := ingestion.Flush()
@@ -194,3 +195,91 @@ func TestTradeIngestTimestamp(t *testing.T) {
 
 	tt.Require.Equal(trades[len(trades)-1].LedgerCloseTime, ledgers[len(ledgers)-1].ClosedAt)
 }
+
+func TestConcurrentIngestionsCreateIDs(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	addresses := []string{
+		"GAXI33UCLQTCKM2NMRBS7XYBR535LLEVAHL5YBN4FTCB4HZHT7ZA5CVK",
+		"GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2",
+	}
+	issuer := "GB2QIYT2IAUFMRXKLSLLPRECC6OCOGJMADSPTRK7TGNT2SFR2YGWDARD"
+	assets := []xdr.Asset{
+		xdr.MustNewCreditAsset("USD", issuer),
+		xdr.MustNewCreditAsset("EUR", issuer),
+	}
+
+	aids := make([]xdr.AccountId, len(addresses))
+	for i, address := range addresses {
+		tt.Require.NoError(aids[i].SetAddress(address))
+	}
+
+	// both ingestions, like two reingestion chunks, create the same new
+	// accounts and assets in a different order before either of them commits
+	a := &Ingestion{DB: tt.HorizonSession(), IDs: tt.HorizonSession()}
+	b := &Ingestion{DB: tt.HorizonSession(), IDs: tt.HorizonSession()}
+	tt.Require.NoError(a.Start())
+	tt.Require.NoError(b.Start())
+
+	done := make(chan error, 1)
+	go func() {
+		a.OperationParticipants(1, []xdr.AccountId{aids[0], aids[1]})
+		b.OperationParticipants(2, []xdr.AccountId{aids[1], aids[0]})
+
+		err := a.createTradeIDs(aids[0], xdr.ClaimOfferAtom{
+			SellerId:    aids[1],
+			AssetSold:   assets[0],
+			AssetBought: assets[1],
+		})
+		if err == nil {
+			err = b.createTradeIDs(aids[1], xdr.ClaimOfferAtom{
+				SellerId:    aids[0],
+				AssetSold:   assets[1],
+				AssetBought: assets[0],
+			})
+		}
+		if err == nil {
+			err = a.OperationAssets(1, []xdr.Asset{assets[0], assets[1]})
+		}
+		if err == nil {
+			err = b.OperationAssets(2, []xdr.Asset{assets[1], assets[0]})
+		}
+		if err == nil {
+			err = a.UpdateAccountIDs([]TableName{OperationParticipantsTableName})
+		}
+		if err == nil {
+			err = b.UpdateAccountIDs([]TableName{OperationParticipantsTableName})
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		tt.Require.NoError(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("ingestions are blocked on each other's new ids")
+	}
+
+	tt.Require.NoError(a.Rollback())
+	tt.Require.NoError(b.Rollback())
+
+	// the ids are not part of the ingestion transactions
+	q := history.Q{Session: tt.HorizonSession()}
+	var accounts []history.Account
+	tt.Require.NoError(q.AccountsByAddresses(&accounts, addresses))
+	tt.Assert.Len(accounts, len(addresses))
+
+	ids, err := q.GetAssetIDs(assets)
+	tt.Require.NoError(err)
+	tt.Assert.Len(ids, len(assets))
+
+	for _, table := range []*BatchInsertBuilder{
+		a.builders[OperationParticipantsTableName],
+		b.builders[OperationParticipantsTableName],
+	} {
+		for _, row := range table.rows {
+			tt.Assert.NotZero(row[1])
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
 	"github.com/kinecosystem/go/support/db"
+	"github.com/kinecosystem/go/support/historyarchive"
 	ilog "github.com/kinecosystem/go/support/log"
 	"github.com/kinecosystem/go/xdr"
 )
@@ -38,6 +39,7 @@ const (
 	AssetStatsTableName              TableName = "asset_stats"
 	EffectsTableName                 TableName = "history_effects"
 	LedgersTableName                 TableName = "history_ledgers"
+	OperationAssetsTableName         TableName = "history_operation_assets"
 	OperationParticipantsTableName   TableName = "history_operation_participants"
 	OperationsTableName              TableName = "history_operations"
 	TradesTableName                  TableName = "history_trades"
@@ -56,6 +58,8 @@ type Cursor struct {
 
 	// CoreDB is the stellar-core db that data is ingested from.
 	CoreDB *db.Session
+	// Source, if set, replaces CoreDB as the source of the ledgers.
+	Source LedgerSource
 
 	Metrics    *IngesterMetrics
 	AssetStats *AssetStats
@@ -72,6 +76,31 @@ type Cursor struct {
 	data *LedgerBundle
 }
 
+// LedgerSource provides the ledgers iterated through by a Cursor when they
+// shouldn't be loaded from the stellar-core database.
+type LedgerSource interface {
+	// LoadLedger fills in the records of `lb` for the ledger at lb.Sequence.
+	LoadLedger(lb *LedgerBundle) error
+}
+
+// ArchiveSource is a LedgerSource reading ledgers, transactions and results
+// from the checkpoint files of a history archive.  History archives don't
+// hold transaction meta: the ledgers are flagged as `from_archive`, their
+// transactions are stored without meta, effects derived from the ledger
+// entries changed by an operation (trust line, data and signer effects,
+// sequence bumps) are not ingested and trades are stored without price.
+type ArchiveSource struct {
+	Archive *historyarchive.Archive
+	// Network is the passphrase of the network whose archive is read.
+	Network string
+
+	lock         sync.Mutex
+	checkpoint   uint32
+	ledgers      map[uint32]xdr.LedgerHeaderHistoryEntry
+	transactions map[uint32][]xdr.TransactionEnvelope
+	results      map[uint32][]xdr.TransactionResultPair
+}
+
 // Config allows passing some configuration values to System and Session.
 type Config struct {
 	// EnableAssetStats is a feature flag that determines whether to calculate
@@ -90,6 +119,45 @@ type EffectIngestion struct {
 	parent      *Ingestion
 }
 
+// ParallelReingest configures System.ReingestRangeParallel.
+type ParallelReingest struct {
+	// Workers is the number of sessions ingesting chunks concurrently.
+	Workers int
+	// ChunkSize is the number of ledgers ingested by a single session.  Each
+	// chunk is committed in its own database transaction.
+	ChunkSize int32
+	// Resume skips the chunks whose ledgers were all ingested by the
+	// CurrentVersion of the ingestion algorithm, allowing an interrupted
+	// reingestion to be restarted where it stopped.  Progress is only
+	// recorded per chunk: a chunk interrupted midway is rolled back and
+	// reingested whole.
+	Resume bool
+	// Progress, if set, is called each time a chunk is complete.  Calls are
+	// serialized.
+	Progress func(ReingestProgress)
+}
+
+// ReingestProgress reports the progress of a parallel reingestion.
+type ReingestProgress struct {
+	// First and Last are the bounds, inclusive, of the chunk that just
+	// completed.
+	First int32
+	Last  int32
+	// Err is the error the chunk failed with, if any.
+	Err error
+
+	// Chunks is the number of chunks the range was split into.
+	Chunks int
+	// Done is the number of chunks completed so far, including the skipped
+	// and failed ones.
+	Done int
+	// Skipped is the number of chunks skipped because they were already
+	// ingested.
+	Skipped int
+	// Ingested is the number of ledgers ingested so far.
+	Ingested int
+}
+
 // LedgerBundle represents a single ledger's worth of novelty created by one
 // ledger close
 type LedgerBundle struct {
@@ -97,6 +165,9 @@ type LedgerBundle struct {
 	Header          core.LedgerHeader
 	TransactionFees []core.TransactionFee
 	Transactions    []core.Transaction
+	// FromArchive is true when the ledger was loaded from a history archive,
+	// whose transactions have no meta.
+	FromArchive bool
 }
 
 // System represents the data ingestion subsystem of horizon.
@@ -127,6 +198,12 @@ type System struct {
 	// keep in the history database, working backwards from the latest core
 	// ledger.  0 represents "all ledgers".
 	HistoryRetentionCount uint
+	// BackfillSource, if set, is the source of the ledgers ingested by
+	// Backfill instead of the stellar-core database.
+	BackfillSource LedgerSource
+	// AfterIngest, if set, is called each time new ledgers were ingested by
+	// Tick, to refresh the state derived from them.
+	AfterIngest func()
 
 	lock    sync.Mutex
 	current *Session
@@ -154,6 +231,9 @@ type BatchInsertBuilder struct {
 type AssetStats struct {
 	CoreSession    *db.Session
 	HistorySession *db.Session
+	// IDSession creates the history asset ids outside of the ingestion
+	// transaction, see Ingestion.IDs. HistorySession is used when nil.
+	IDSession *db.Session
 
 	batchInsertBuilder *BatchInsertBuilder
 	toUpdate           map[string]xdr.Asset
@@ -164,7 +244,12 @@ type AssetStats struct {
 type Ingestion struct {
 	// DB is the sql connection to be used for writing any rows into the horizon
 	// database.
-	DB       *db.Session
+	DB *db.Session
+	// IDs is the sql connection used to create rows in `history_accounts` and
+	// `history_assets`. It is not part of the ingestion transaction, so new ids
+	// are committed right away and concurrent ingestions (e.g. parallel
+	// reingestion chunks) don't block each other on them. DB is used when nil.
+	IDs      *db.Session
 	builders map[TableName]*BatchInsertBuilder
 }
 
@@ -221,6 +306,23 @@ func New(network string, coreURL string, core, horizon *db.Session, cursorName s
 	return i
 }
 
+// AssetTopic returns the SSE topic published whenever an operation involving
+// `a` is ingested.
+func AssetTopic(a xdr.Asset) string {
+	return "asset:" + a.String()
+}
+
+// OrderBookTopic returns the SSE topic published whenever offers trading
+// between `a` and `b`, in either direction, change.
+func OrderBookTopic(a, b xdr.Asset) string {
+	as, bs := a.String(), b.String()
+	if bs < as {
+		as, bs = bs, as
+	}
+
+	return "order_book:" + as + ":" + bs
+}
+
 // NewCursor initializes a new ingestion cursor
 func NewCursor(first, last int32, i *System) *Cursor {
 	return &Cursor{
@@ -240,7 +342,8 @@ func NewSession(i *System) *Session {
 	return &Session{
 		Config: i.Config,
 		Ingestion: &Ingestion{
-			DB: hdb,
+			DB:  hdb,
+			IDs: i.HorizonDB.Clone(),
 		},
 		Network:          i.Network,
 		StellarCoreURL:   i.StellarCoreURL,
@@ -249,6 +352,7 @@ func NewSession(i *System) *Session {
 		AssetStats: &AssetStats{
 			CoreSession:    cdb,
 			HistorySession: hdb,
+			IDSession:      i.HorizonDB.Clone(),
 		},
 	}
 }
@@ -54,6 +54,55 @@ func ForOperation(
 	return
 }
 
+// AssetsForOperation returns all the assets that participate in the provided
+// operation: the assets it moves, trades or changes trust in.
+func AssetsForOperation(
+	tx *xdr.Transaction,
+	op *xdr.Operation,
+) (result []xdr.Asset, err error) {
+
+	source := tx.SourceAccount
+	if op.SourceAccount != nil {
+		source = *op.SourceAccount
+	}
+
+	switch op.Body.Type {
+	case xdr.OperationTypeCreateAccount:
+		result = append(result, xdr.MustNewNativeAsset())
+	case xdr.OperationTypePayment:
+		result = append(result, op.Body.MustPaymentOp().Asset)
+	case xdr.OperationTypePathPayment:
+		pp := op.Body.MustPathPaymentOp()
+		result = append(result, pp.SendAsset, pp.DestAsset)
+		result = append(result, pp.Path...)
+	case xdr.OperationTypeManageOffer:
+		mo := op.Body.MustManageOfferOp()
+		result = append(result, mo.Selling, mo.Buying)
+	case xdr.OperationTypeCreatePassiveOffer:
+		po := op.Body.MustCreatePassiveOfferOp()
+		result = append(result, po.Selling, po.Buying)
+	case xdr.OperationTypeSetOptions:
+		// no assets are involved
+	case xdr.OperationTypeChangeTrust:
+		result = append(result, op.Body.MustChangeTrustOp().Line)
+	case xdr.OperationTypeAllowTrust:
+		result = append(result, op.Body.MustAllowTrustOp().Asset.ToAsset(source))
+	case xdr.OperationTypeAccountMerge:
+		result = append(result, xdr.MustNewNativeAsset())
+	case xdr.OperationTypeInflation:
+		result = append(result, xdr.MustNewNativeAsset())
+	case xdr.OperationTypeManageData:
+		// no assets are involved
+	case xdr.OperationTypeBumpSequence:
+		// no assets are involved
+	default:
+		err = fmt.Errorf("Unknown operation type: %s", op.Body.Type)
+	}
+
+	result = dedupeAssets(result)
+	return
+}
+
 // ForTransaction returns all the participating accounts from the provided
 // transaction.
 func ForTransaction(
@@ -101,6 +150,19 @@ func dedupe(in []xdr.AccountId) (out []xdr.AccountId) {
 	return
 }
 
+// dedupeAssets remove any duplicate assets from `in`
+func dedupeAssets(in []xdr.Asset) (out []xdr.Asset) {
+	set := map[string]xdr.Asset{}
+	for _, asset := range in {
+		set[asset.String()] = asset
+	}
+
+	for _, asset := range set {
+		out = append(out, asset)
+	}
+	return
+}
+
 func forChanges(
 	changes *xdr.LedgerEntryChanges,
 ) (result []xdr.AccountId, err error) {
@@ -114,6 +114,88 @@ func TestForTransaction(t *testing.T) {
 	tt.Assert.Contains(p, aid("GAXI33UCLQTCKM2NMRBS7XYBR535LLEVAHL5YBN4FTCB4HZHT7ZA5CVK"))
 }
 
+func TestAssetsForOperation(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	issuer := "GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU"
+	native := xdr.MustNewNativeAsset()
+	usd := xdr.MustNewCreditAsset("USD", issuer)
+	eur := xdr.MustNewCreditAsset("EUR", issuer)
+	tx := xdr.Transaction{
+		SourceAccount: aid("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"),
+	}
+
+	load := func(typ xdr.OperationType, body interface{}) []xdr.Asset {
+		var op xdr.Operation
+		var err error
+		op.Body, err = xdr.NewOperationBody(typ, body)
+		tt.Require.NoError(err, "failed to build operation")
+		ret, err := AssetsForOperation(&tx, &op)
+		tt.Require.NoError(err, "AssetsForOperation() errored")
+		return ret
+	}
+
+	// test create account
+	p := load(xdr.OperationTypeCreateAccount, xdr.CreateAccountOp{
+		Destination: aid(issuer),
+	})
+	tt.Require.Len(p, 1)
+	tt.Assert.Contains(p, native)
+
+	// test payment
+	p = load(xdr.OperationTypePayment, xdr.PaymentOp{
+		Destination: aid(issuer),
+		Asset:       usd,
+	})
+	tt.Require.Len(p, 1)
+	tt.Assert.Contains(p, usd)
+
+	// test path payment, with duplicates removed
+	p = load(xdr.OperationTypePathPayment, xdr.PathPaymentOp{
+		SendAsset:   usd,
+		Destination: aid(issuer),
+		DestAsset:   eur,
+		Path:        []xdr.Asset{native, usd},
+	})
+	tt.Require.Len(p, 3)
+	tt.Assert.Contains(p, usd)
+	tt.Assert.Contains(p, eur)
+	tt.Assert.Contains(p, native)
+
+	// test manage offer
+	p = load(xdr.OperationTypeManageOffer, xdr.ManageOfferOp{
+		Selling: usd,
+		Buying:  native,
+	})
+	tt.Require.Len(p, 2)
+	tt.Assert.Contains(p, usd)
+	tt.Assert.Contains(p, native)
+
+	// test change trust
+	p = load(xdr.OperationTypeChangeTrust, xdr.ChangeTrustOp{
+		Line: eur,
+	})
+	tt.Require.Len(p, 1)
+	tt.Assert.Contains(p, eur)
+
+	// test allow trust, whose asset is issued by the source account
+	var code [4]byte
+	copy(code[:], "USD")
+	allowed, err := xdr.NewAllowTrustOpAsset(xdr.AssetTypeAssetTypeCreditAlphanum4, code)
+	tt.Require.NoError(err)
+	p = load(xdr.OperationTypeAllowTrust, xdr.AllowTrustOp{
+		Trustor: aid(issuer),
+		Asset:   allowed,
+	})
+	tt.Require.Len(p, 1)
+	tt.Assert.Contains(p, xdr.MustNewCreditAsset("USD", tx.SourceAccount.Address()))
+
+	// test set options
+	p = load(xdr.OperationTypeSetOptions, xdr.SetOptionsOp{})
+	tt.Assert.Len(p, 0)
+}
+
 // helper function to convert an address into an accountid
 func aid(addy string) (ret xdr.AccountId) {
 	err := ret.SetAddress(addy)
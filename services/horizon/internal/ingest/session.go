@@ -151,6 +151,11 @@ func (is *Session) ingestEffects() {
 	source := is.Cursor.OperationSourceAccount()
 	opbody := is.Cursor.Operation().Body
 
+	// Ledgers loaded from a history archive have no meta: the effects derived
+	// from the ledger entries changed by an operation can't be ingested, the
+	// ledger is flagged as `from_archive` instead.
+	fromArchive := is.Cursor.FromArchive()
+
 	switch is.Cursor.OperationType() {
 	case xdr.OperationTypeCreateAccount:
 		op := opbody.MustCreateAccountOp()
@@ -258,9 +263,15 @@ func (is *Session) ingestEffects() {
 			)
 		}
 
-		is.ingestSignerEffects(effects, op)
+		if !fromArchive {
+			is.ingestSignerEffects(effects, op)
+		}
 
 	case xdr.OperationTypeChangeTrust:
+		if fromArchive {
+			break
+		}
+
 		op := opbody.MustChangeTrustOp()
 		dets := map[string]interface{}{"limit": amount.String(op.Limit)}
 		key := xdr.LedgerKey{}
@@ -331,6 +342,10 @@ func (is *Session) ingestEffects() {
 			)
 		}
 	case xdr.OperationTypeManageData:
+		if fromArchive {
+			break
+		}
+
 		op := opbody.MustManageDataOp()
 		dets := map[string]interface{}{"name": op.DataName}
 		key := xdr.LedgerKey{}
@@ -363,6 +378,10 @@ func (is *Session) ingestEffects() {
 		effects.Add(source, effect, dets)
 
 	case xdr.OperationTypeBumpSequence:
+		if fromArchive {
+			break
+		}
+
 		opChanges := is.Cursor.OperationChanges()
 		if len(opChanges) > 0 {
 			op := opbody.MustBumpSequenceOp()
@@ -394,6 +413,7 @@ func (is *Session) ingestLedger() {
 		is.Cursor.SuccessfulTransactionCount(),
 		is.Cursor.FailedTransactionCount(),
 		is.Cursor.SuccessfulLedgerOperationCount(),
+		is.Cursor.FromArchive(),
 	)
 
 	for is.Cursor.NextTx() {
@@ -427,8 +447,17 @@ func (is *Session) ingestOperation() {
 	}
 
 	is.ingestOperationParticipants()
+	is.ingestOperationAssets()
+
+	// the operations of failed transactions were not applied, so they have no
+	// effects, trades nor impact on order books and assets.
+	if !is.Cursor.Transaction().IsSuccessful() {
+		return
+	}
+
 	is.ingestEffects()
 	is.ingestTrades()
+	is.ingestOrderBooks()
 	if is.Config.EnableAssetStats && is.Err == nil {
 		is.Err = is.AssetStats.IngestOperation(
 			is.Cursor.Operation(),
@@ -442,6 +471,28 @@ func (is *Session) ingestOperation() {
 	}
 }
 
+func (is *Session) ingestOperationAssets() {
+	if is.Err != nil {
+		return
+	}
+
+	// Find the assets
+	var assets []xdr.Asset
+	assets, is.Err = participants.AssetsForOperation(
+		&is.Cursor.Transaction().Envelope.Tx,
+		is.Cursor.Operation(),
+	)
+	if is.Err != nil {
+		is.Err = errors.Wrap(is.Err, "participants.AssetsForOperation error")
+		return
+	}
+
+	is.Err = is.Ingestion.OperationAssets(is.Cursor.OperationID(), assets)
+	if is.Err != nil {
+		is.Err = errors.Wrap(is.Err, "Ingestion.OperationAssets error")
+	}
+}
+
 func (is *Session) ingestOperationParticipants() {
 	if is.Err != nil {
 		return
@@ -567,15 +618,25 @@ func (is *Session) ingestTrades() {
 			continue
 		}
 
-		//extract original offer price
-		key := xdr.LedgerKey{}
-		key.SetOffer(trade.SellerId, uint64(trade.OfferId))
-		before, _, err := is.Cursor.BeforeAndAfter(key)
-		if err != nil {
-			is.Err = errors.Wrap(err, "Cursor.BeforeAndAfter error")
+		//extract original offer price, unknown for the ledgers loaded from a
+		//history archive as they have no meta
+		var sellOfferPrice *xdr.Price
+		if !is.Cursor.FromArchive() {
+			key := xdr.LedgerKey{}
+			key.SetOffer(trade.SellerId, uint64(trade.OfferId))
+			before, _, err := is.Cursor.BeforeAndAfter(key)
+			if err != nil {
+				is.Err = errors.Wrap(err, "Cursor.BeforeAndAfter error")
+				return
+			}
+			sellOfferPrice = &before.Data.Offer.Price
+		}
+
+		is.Err = is.Ingestion.createTradeIDs(buyer, trade)
+		if is.Err != nil {
+			is.Err = errors.Wrap(is.Err, "createTradeIDs error")
 			return
 		}
-		sellOfferPrice := before.Data.Offer.Price
 
 		is.Err = q.InsertTrade(
 			is.Cursor.OperationID(),
@@ -594,6 +655,31 @@ func (is *Session) ingestTrades() {
 	}
 }
 
+// ingestOrderBooks notifies the subscribers of the order books changed by the
+// current operation: the one it manages an offer in, if any, and the ones of
+// every offer it crossed.
+func (is *Session) ingestOrderBooks() {
+	if is.Err != nil {
+		return
+	}
+
+	cursor := is.Cursor
+
+	switch cursor.OperationType() {
+	case xdr.OperationTypePathPayment:
+		claims := cursor.OperationResult().MustPathPaymentResult().MustSuccess().Offers
+		for _, claim := range claims {
+			is.Ingestion.OrderBook(claim.AssetSold, claim.AssetBought)
+		}
+	case xdr.OperationTypeManageOffer:
+		op := cursor.Operation().Body.MustManageOfferOp()
+		is.Ingestion.OrderBook(op.Selling, op.Buying)
+	case xdr.OperationTypeCreatePassiveOffer:
+		op := cursor.Operation().Body.MustCreatePassiveOfferOp()
+		is.Ingestion.OrderBook(op.Selling, op.Buying)
+	}
+}
+
 func (is *Session) ingestTradeEffects(effects *EffectIngestion, buyer xdr.AccountId, claims []xdr.ClaimOfferAtom) {
 	if is.Err != nil {
 		return
@@ -638,14 +724,13 @@ func (is *Session) ingestTransaction() {
 		return
 	}
 
-	// skip ingesting failed transactions
-	if !is.Cursor.Transaction().IsSuccessful() {
-		return
-	}
+	is.Ingestion.AccountsChanged(is.Cursor.TransactionMetaBundle().ChangedAccounts())
+
 	is.Ingestion.Transaction(
 		is.Cursor.TransactionID(),
 		is.Cursor.Transaction(),
 		is.Cursor.TransactionFee(),
+		is.Cursor.FromArchive(),
 	)
 
 	for is.Cursor.NextOp() {
@@ -722,10 +807,13 @@ func (is *Session) operationDetails() map[string]interface{} {
 		details["from"] = source.Address()
 		details["to"] = op.Destination.Address()
 
-		result := c.OperationResult().MustPathPaymentResult()
-
 		details["amount"] = amount.String(op.DestAmount)
-		details["source_amount"] = amount.String(result.SendAmount())
+		// nothing was sent when the transaction failed
+		details["source_amount"] = amount.String(0)
+		if c.Transaction().IsSuccessful() {
+			result := c.OperationResult().MustPathPaymentResult()
+			details["source_amount"] = amount.String(result.SendAmount())
+		}
 		details["source_max"] = amount.String(op.SendMax)
 		is.assetDetails(details, op.DestAsset, "")
 		is.assetDetails(details, op.SendAsset, "source_")
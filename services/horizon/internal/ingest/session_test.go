@@ -84,6 +84,37 @@ func Test_ingestOperationEffects(t *testing.T) {
 	tt.Assert.Equal("10000.00000", ad.Amount)
 }
 
+func Test_ingestOperationAssets(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s := ingest(tt, false)
+	tt.Require.NoError(s.Err)
+
+	q := &history.Q{Session: tt.HorizonSession()}
+	pq, err := db2.NewPageQuery("", true, "asc", 200)
+	tt.Require.NoError(err)
+
+	// account creations move the native asset
+	var ops []history.Operation
+	err = q.Operations().ForAsset(xdr.MustNewNativeAsset()).Page(pq).Select(&ops)
+	tt.Require.NoError(err)
+	if tt.Assert.NotEmpty(ops) {
+		tt.Assert.Equal(xdr.OperationTypeCreateAccount, ops[0].Type)
+	}
+
+	// operations without assets, such as bump sequence, are never recorded
+	usd := xdr.MustNewCreditAsset("USD", "GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU")
+	err = q.Operations().ForAsset(usd).Page(pq).Select(&ops)
+	tt.Require.NoError(err)
+	if tt.Assert.NotEmpty(ops) {
+		for _, op := range ops {
+			tt.Assert.NotEqual(xdr.OperationTypeBumpSequence, op.Type)
+			tt.Assert.NotEqual(xdr.OperationTypeSetOptions, op.Type)
+		}
+	}
+}
+
 func Test_ingestBumpSeq(t *testing.T) {
 	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
 	defer tt.Finish()
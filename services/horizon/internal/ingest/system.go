@@ -1,6 +1,7 @@
 package ingest
 
 import (
+	"sync"
 	"time"
 
 	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
@@ -13,12 +14,14 @@ import (
 )
 
 // Backfill ingests history in reverse chronological order, from the current
-// horizon elder query for `n` ledgers
+// horizon elder query for `n` ledgers.  Ledgers are loaded from BackfillSource
+// when set.
 func (i *System) Backfill(n uint) error {
 	start := ledger.CurrentState().HistoryElder - 1
 	end := start - int32(n) + 1
 	is := NewSession(i)
 	is.Cursor = NewCursor(start, end, i)
+	is.Cursor.Source = i.BackfillSource
 
 	log.WithField("start", start).
 		WithField("end", end).
@@ -97,19 +100,9 @@ func (i *System) RebaseHistory() error {
 
 // ReingestAll re-ingests all ledgers
 func (i *System) ReingestAll() (int, error) {
-
-	var elder int32
-	var latest int32
-	q := history.Q{Session: i.HorizonDB}
-
-	err := q.ElderLedger(&elder)
-	if err != nil {
-		return 0, errors.Wrap(err, "load history elder ledger failed")
-	}
-
-	err = q.LatestLedger(&latest)
+	elder, latest, err := i.historyRange()
 	if err != nil {
-		return 0, errors.Wrap(err, "load history latest ledger failed")
+		return 0, err
 	}
 
 	log.
@@ -120,6 +113,23 @@ func (i *System) ReingestAll() (int, error) {
 	return i.ReingestRange(latest, elder)
 }
 
+// ReingestAllParallel re-ingests all ledgers using ReingestRangeParallel.
+func (i *System) ReingestAllParallel(opts ParallelReingest) (int, error) {
+	elder, latest, err := i.historyRange()
+	if err != nil {
+		return 0, err
+	}
+
+	log.
+		WithField("start", elder).
+		WithField("end", latest).
+		WithField("workers", opts.Workers).
+		WithField("chunk_size", opts.ChunkSize).
+		Info("reingest: all in parallel")
+
+	return i.ReingestRangeParallel(elder, latest, opts)
+}
+
 // ReingestOutdated finds old ledgers and reimports them.
 func (i *System) ReingestOutdated() (n int, err error) {
 
@@ -199,6 +209,103 @@ func (i *System) ReingestRange(start, end int32) (int, error) {
 	return is.Ingested, is.Err
 }
 
+// ReingestRangeParallel reingests the ledgers from `start` to `end`,
+// inclusive, split into chunks of `opts.ChunkSize` ledgers ingested
+// concurrently by `opts.Workers` sessions, each with its own core and horizon
+// database sessions.  Once a chunk fails no new chunk is started; the number
+// of ledgers ingested is returned along with the first error.
+func (i *System) ReingestRangeParallel(start, end int32, opts ParallelReingest) (int, error) {
+	if opts.ChunkSize <= 0 {
+		return 0, errors.New("chunk size must be positive")
+	}
+
+	if opts.Workers <= 0 {
+		opts.Workers = 1
+	}
+
+	if start > end {
+		start, end = end, start
+	}
+
+	type chunk struct {
+		first, last int32
+	}
+
+	var chunks []chunk
+	for first := start; first <= end; first += opts.ChunkSize {
+		last := first + opts.ChunkSize - 1
+		if last > end {
+			last = end
+		}
+		chunks = append(chunks, chunk{first, last})
+	}
+
+	var (
+		lock     sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		progress = ReingestProgress{Chunks: len(chunks)}
+		queue    = make(chan chunk)
+	)
+
+	report := func(c chunk, ingested int, skipped bool, err error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		progress.First, progress.Last, progress.Err = c.first, c.last, err
+		progress.Done++
+		progress.Ingested += ingested
+		if skipped {
+			progress.Skipped++
+		}
+
+		if err != nil && firstErr == nil {
+			firstErr = errors.Wrapf(err, "failed to reingest ledgers %d-%d", c.first, c.last)
+		}
+
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+	}
+
+	failed := func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return firstErr != nil
+	}
+
+	for w := 0; w < opts.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for c := range queue {
+				if opts.Resume {
+					ingested, err := i.rangeIngested(c.first, c.last)
+					if err != nil || ingested {
+						report(c, 0, ingested, err)
+						continue
+					}
+				}
+
+				n, err := i.ReingestRange(c.first, c.last)
+				report(c, n, false, err)
+			}
+		}()
+	}
+
+	for _, c := range chunks {
+		if failed() {
+			break
+		}
+		queue <- c
+	}
+	close(queue)
+	wg.Wait()
+
+	return progress.Ingested, firstErr
+}
+
 // ReingestSingle re-ingests a single ledger
 func (i *System) ReingestSingle(sequence int32) error {
 	_, err := i.ReingestRange(sequence, sequence)
@@ -314,9 +421,46 @@ func (i *System) runOnce() {
 	logFields["duration"] = time.Since(ingestStart).Seconds()
 	log.WithFields(logFields).Info("Finished ingesting ledgers")
 
+	if i.AfterIngest != nil {
+		i.AfterIngest()
+	}
+
 	return
 }
 
+// historyRange returns the elder and latest ledgers of the history database.
+func (i *System) historyRange() (elder, latest int32, err error) {
+	q := history.Q{Session: i.HorizonDB}
+
+	err = q.ElderLedger(&elder)
+	if err != nil {
+		err = errors.Wrap(err, "load history elder ledger failed")
+		return
+	}
+
+	err = q.LatestLedger(&latest)
+	if err != nil {
+		err = errors.Wrap(err, "load history latest ledger failed")
+		return
+	}
+
+	return
+}
+
+// rangeIngested returns true if every ledger from `first` to `last`,
+// inclusive, was ingested by the current version of the ingestion algorithm.
+func (i *System) rangeIngested(first, last int32) (bool, error) {
+	var count int32
+	q := history.Q{Session: i.HorizonDB.Clone()}
+
+	err := q.IngestedLedgerCount(&count, first, last, CurrentVersion)
+	if err != nil {
+		return false, errors.Wrap(err, "load ingested ledger count failed")
+	}
+
+	return count == last-first+1, nil
+}
+
 // trimAbandondedLedgers deletes all "abandonded" ledgers from the history
 // database. An abandonded ledger, in this context, means a ledger known to
 // horizon but is no longer present in the stellar-core database source.  The
@@ -1,6 +1,7 @@
 package ingest
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/kinecosystem/go/network"
@@ -31,6 +32,103 @@ func TestBackfill(t *testing.T) {
 	}
 }
 
+func TestReingestRangeParallel(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	is := sys(tt, false)
+
+	var reports []ReingestProgress
+	opts := ParallelReingest{
+		Workers:   3,
+		ChunkSize: 4,
+		Progress: func(p ReingestProgress) {
+			reports = append(reports, p)
+		},
+	}
+
+	// ledgers 2-20 are split into 5 chunks
+	n, err := is.ReingestRangeParallel(20, 2, opts)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(19, n)
+	if tt.Assert.Len(reports, 5) {
+		last := reports[4]
+		tt.Assert.Equal(5, last.Done)
+		tt.Assert.Equal(5, last.Chunks)
+		tt.Assert.Equal(0, last.Skipped)
+		tt.Assert.Equal(19, last.Ingested)
+	}
+
+	var found int
+	err = tt.HorizonSession().GetRaw(&found, "SELECT COUNT(*) FROM history_ledgers")
+	tt.Require.NoError(err)
+	tt.Assert.Equal(19, found)
+
+	// resuming skips the chunks that were completely ingested
+	_, err = tt.HorizonSession().ExecRaw(`DELETE FROM history_ledgers WHERE sequence = ?`, 7)
+	tt.Require.NoError(err)
+
+	reports = nil
+	opts.Resume = true
+	n, err = is.ReingestRangeParallel(2, 20, opts)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(4, n)
+	if tt.Assert.Len(reports, 5) {
+		tt.Assert.Equal(4, reports[4].Skipped)
+	}
+
+	err = tt.HorizonSession().GetRaw(&found, "SELECT COUNT(*) FROM history_ledgers")
+	tt.Require.NoError(err)
+	tt.Assert.Equal(19, found)
+}
+
+func TestReingestRangeConcurrentChunks(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	is := sys(tt, false)
+
+	// the later chunk references accounts and assets created in the earlier
+	// one, so both race to create the same history ids while the earlier
+	// chunk is not committed yet
+	chunks := [][2]int32{{2, 30}, {31, 57}}
+	errs := make([]error, len(chunks))
+
+	var wg sync.WaitGroup
+	for i, c := range chunks {
+		wg.Add(1)
+		go func(i int, first, last int32) {
+			defer wg.Done()
+			_, errs[i] = is.ReingestRange(first, last)
+		}(i, c[0], c[1])
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		tt.Require.NoError(err)
+	}
+
+	var found int
+	err := tt.HorizonSession().GetRaw(&found, "SELECT COUNT(*) FROM history_ledgers")
+	tt.Require.NoError(err)
+	tt.Assert.Equal(56, found)
+
+	var accounts, assets int
+	err = tt.HorizonSession().GetRaw(&accounts, "SELECT COUNT(*) FROM history_accounts")
+	tt.Require.NoError(err)
+	err = tt.HorizonSession().GetRaw(&assets, "SELECT COUNT(*) FROM history_assets")
+	tt.Require.NoError(err)
+
+	// reingesting the whole range in one chunk finds every id created above
+	_, err = is.ReingestRange(2, 57)
+	tt.Require.NoError(err)
+
+	err = tt.HorizonSession().GetRaw(&found, "SELECT COUNT(*) FROM history_accounts")
+	tt.Require.NoError(err)
+	tt.Assert.Equal(accounts, found)
+	err = tt.HorizonSession().GetRaw(&found, "SELECT COUNT(*) FROM history_assets")
+	tt.Require.NoError(err)
+	tt.Assert.Equal(assets, found)
+}
+
 func TestClearAll(t *testing.T) {
 	tt := test.Start(t).Scenario("kahuna")
 	defer tt.Finish()
@@ -1,11 +1,20 @@
 package horizon
 
 import (
+	"github.com/kinecosystem/go/services/horizon/internal/graphpath"
 	"github.com/kinecosystem/go/services/horizon/internal/simplepath"
+	"github.com/kinecosystem/go/support/log"
 )
 
 func initPathFinding(app *App) {
-	app.paths = &simplepath.Finder{app.CoreQ()}
+	switch app.config.PathFinder {
+	case "", "simple":
+		app.paths = &simplepath.Finder{app.CoreQ()}
+	case "graph":
+		app.paths = &graphpath.Finder{Q: app.CoreQ()}
+	default:
+		log.Panicf("unknown path finder: %s", app.config.PathFinder)
+	}
 }
 
 func init() {
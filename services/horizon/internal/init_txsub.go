@@ -3,6 +3,7 @@ package horizon
 import (
 	"net/http"
 
+	"github.com/kinecosystem/go/keypair"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
 	"github.com/kinecosystem/go/services/horizon/internal/txsub"
@@ -13,8 +14,13 @@ import (
 func initSubmissionSystem(app *App) {
 	cq := &core.Q{Session: app.CoreSession(nil)}
 
+	pending := txsub.NewDefaultSubmissionList()
+	if app.redis != nil && app.config.TxSubRedisKey != "" {
+		pending = txsub.NewRedisSubmissionList(app.redis, app.config.TxSubRedisKey)
+	}
+
 	app.submitter = &txsub.System{
-		Pending:         txsub.NewDefaultSubmissionList(),
+		Pending:         pending,
 		Submitter:       txsub.NewDefaultSubmitter(http.DefaultClient, app.config.StellarCoreURL),
 		SubmissionQueue: sequence.NewManager(),
 		Results: &results.DB{
@@ -23,9 +29,30 @@ func initSubmissionSystem(app *App) {
 		},
 		Sequences:         cq.SequenceProvider(),
 		NetworkPassphrase: app.config.NetworkPassphrase,
+		FeeBump:           feeBumpPolicy(app.config),
+	}
+}
+
+// feeBumpPolicy returns the fee bump policy configured by the fee-bump-*
+// options, or nil when no seed is configured.
+func feeBumpPolicy(config Config) *txsub.FeeBumpPolicy {
+	if len(config.FeeBumpSeeds) == 0 {
+		return nil
+	}
+
+	signers := map[string]string{}
+	for _, seed := range config.FeeBumpSeeds {
+		signers[keypair.MustParse(seed).Address()] = seed
+	}
+
+	return &txsub.FeeBumpPolicy{
+		Signers:     signers,
+		MaxAttempts: int(config.FeeBumpMaxAttempts),
+		MaxBaseFee:  uint32(config.FeeBumpMaxBaseFee),
+		Backoff:     config.FeeBumpBackoff,
 	}
 }
 
 func init() {
-	appInit.Add("txsub", initSubmissionSystem, "app-context", "log", "horizon-db", "core-db")
+	appInit.Add("txsub", initSubmissionSystem, "app-context", "log", "horizon-db", "core-db", "redis")
 }
@@ -11,7 +11,6 @@ import (
 	chimiddleware "github.com/go-chi/chi/middleware"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/rs/cors"
-	"github.com/sebest/xff"
 	"github.com/kinecosystem/go/services/horizon/internal/db2"
 	hProblem "github.com/kinecosystem/go/services/horizon/internal/render/problem"
 	"github.com/kinecosystem/go/services/horizon/internal/txsub/sequence"
@@ -58,7 +57,7 @@ func initWebMiddleware(app *App) {
 	r.Use(requestCacheHeadersMiddleware)
 	r.Use(chimiddleware.RequestID)
 	r.Use(contextMiddleware)
-	r.Use(xff.Handler)
+	r.Use(forwardedForMiddleware(app.config.TrustedProxies))
 	r.Use(loggerMiddleware)
 	r.Use(requestMetricsMiddleware)
 	r.Use(recoverMiddleware)
@@ -102,6 +101,7 @@ func initWebActions(app *App) {
 			r.Get("/effects", EffectIndexAction{}.Handle)
 			r.Get("/offers", OffersByAccountAction{}.Handle)
 			r.Get("/trades", TradeIndexAction{}.Handle)
+			r.Get("/balance_history", BalanceHistoryIndexAction{}.Handle)
 			r.Get("/data/{key}", DataShowAction{}.Handle)
 		})
 	})
@@ -134,14 +134,20 @@ func initWebActions(app *App) {
 	r.Get("/trades", TradeIndexAction{}.Handle)
 	r.Get("/trade_aggregations", TradeAggregateIndexAction{}.Handle)
 	r.Route("/offers", func(r chi.Router) {
-		r.Get("/{id}", NotImplementedAction{}.Handle)
+		r.Get("/", OfferIndexAction{}.Handle)
+		r.Get("/{id}", OfferShowAction{}.Handle)
 		r.Get("/{offer_id}/trades", TradeIndexAction{}.Handle)
 	})
 	r.Get("/order_book", OrderBookShowAction{}.Handle)
 
 	// Transaction submission API
 	r.Post("/transactions", TransactionCreateAction{}.Handle)
-	r.Get("/paths", PathIndexAction{}.Handle)
+	r.Post("/transactions_async", TransactionAsyncCreateAction{}.Handle)
+	r.Get("/transactions_async/{tx_id}", TransactionAsyncShowAction{}.Handle)
+	r.Route("/paths", func(r chi.Router) {
+		r.Get("/", PathIndexAction{}.Handle)
+		r.Get("/strict-send", StrictSendPathIndexAction{}.Handle)
+	})
 
 	if app.config.EnableAssetStats {
 		// Asset related endpoints
@@ -151,6 +157,22 @@ func initWebActions(app *App) {
 	// Network state related endpoints
 	r.Get("/operation_fee_stats", OperationFeeStatsAction{}.Handle)
 
+	// webhook subscriptions administration
+	if app.config.EnableWebhooks {
+		r.Route("/admin/webhooks", func(r chi.Router) {
+			r.Use(requireBearerToken(app.config.WebhookAdminToken))
+			r.Get("/", WebhookIndexAction{}.Handle)
+			r.Post("/", WebhookCreateAction{}.Handle)
+			r.Delete("/{id}", WebhookDeleteAction{}.Handle)
+		})
+	}
+
+	// GraphQL interface to the history database
+	if app.config.EnableGraphQL {
+		r.Get("/graphql", GraphQLAction{}.Handle)
+		r.Post("/graphql", GraphQLAction{}.Handle)
+	}
+
 	// friendbot
 	if app.config.FriendbotURL != nil {
 		redirectFriendbot := func(w http.ResponseWriter, r *http.Request) {
@@ -166,20 +188,20 @@ func initWebActions(app *App) {
 
 func initWebRateLimiter(app *App) {
 	// Disabled
-	if app.config.RateLimit == nil {
+	if app.config.RateLimit == nil && len(app.config.RateLimitTiers) == 0 {
 		return
 	}
 
-	rateLimiter, err := throttled.NewGCRARateLimiter(50000, *app.config.RateLimit)
+	rateLimiter, varyBy, err := newTieredRateLimiter(app.config.RateLimit, app.config.RateLimitTiers)
 	if err != nil {
-		panic(fmt.Errorf("unable to create RateLimiter"))
+		panic(fmt.Errorf("unable to create RateLimiter: %v", err))
 	}
 
 	httpRateLimiter := throttled.HTTPRateLimiter{
 		RateLimiter:   rateLimiter,
 		DeniedHandler: &RateLimitExceededAction{App: app, Action: Action{}},
 	}
-	httpRateLimiter.VaryBy = VaryByRemoteIP{}
+	httpRateLimiter.VaryBy = varyBy
 	app.web.rateLimiter = &httpRateLimiter
 }
 
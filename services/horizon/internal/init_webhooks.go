@@ -0,0 +1,30 @@
+package horizon
+
+import (
+	"log"
+
+	"github.com/kinecosystem/go/services/horizon/internal/resourceadapter"
+	"github.com/kinecosystem/go/services/horizon/internal/webhooks"
+)
+
+func initWebhooks(app *App) {
+	if !app.config.EnableWebhooks {
+		return
+	}
+
+	if app.config.WebhookAdminToken == "" {
+		log.Fatal("Cannot enable webhooks without a webhook admin token.")
+	}
+
+	app.webhooks = webhooks.New(app.HorizonSession(nil))
+	app.webhooks.NewEffect = resourceadapter.NewEffect
+
+	err := app.webhooks.Start()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func init() {
+	appInit.Add("webhooks", initWebhooks, "app-context", "log", "horizon-db")
+}
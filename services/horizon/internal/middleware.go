@@ -2,6 +2,8 @@ package horizon
 
 import (
 	"context"
+	"crypto/subtle"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/kinecosystem/go/services/horizon/internal/hchi"
 	"github.com/kinecosystem/go/services/horizon/internal/httpx"
 	"github.com/kinecosystem/go/services/horizon/internal/render"
+	hProblem "github.com/kinecosystem/go/services/horizon/internal/render/problem"
 	"github.com/kinecosystem/go/support/log"
 	"github.com/kinecosystem/go/support/render/problem"
 )
@@ -139,6 +142,78 @@ func (web *Web) RateLimitMiddleware(next http.Handler) http.Handler {
 	return web.rateLimiter.RateLimit(next)
 }
 
+// forwardedForMiddleware sets the remote address of the requests sent by the
+// `trusted` proxies to the address of the client they forwarded the request
+// for: walking X-Forwarded-For back from the last hop, the first address that
+// isn't a trusted proxy.  The remote address of requests from other peers is
+// left as is, so that clients can't pick the address they are logged, rate
+// limited and matched to rate limit tiers with.
+func forwardedForMiddleware(trusted []*net.IPNet) func(http.Handler) http.Handler {
+	isTrusted := func(addr string) bool {
+		ip := net.ParseIP(strings.Trim(addr, "[]"))
+		if ip == nil {
+			return false
+		}
+		for _, network := range trusted {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			host, port, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				// the remote address has no port
+				host, port = r.RemoteAddr, ""
+			}
+			if !isTrusted(host) {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			hops := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+			for i := len(hops) - 1; i >= 0; i-- {
+				hop := strings.TrimSpace(hops[i])
+				if net.ParseIP(hop) == nil {
+					// the hops before an invalid address can't be trusted
+					break
+				}
+				host = hop
+				if !isTrusted(hop) {
+					break
+				}
+			}
+
+			if port == "" {
+				r.RemoteAddr = host
+			} else {
+				r.RemoteAddr = net.JoinHostPort(host, port)
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requireBearerToken returns a middleware rejecting the requests that don't
+// send `token` in their Authorization header.
+func requireBearerToken(token string) func(http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			given := []byte(r.Header.Get("Authorization"))
+			if subtle.ConstantTimeCompare(given, expected) != 1 {
+				problem.Render(r.Context(), w, hProblem.Unauthorized)
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
 // recoverMiddleware helps the server recover from panics. It ensures that
 // no request can fully bring down the horizon server, and it also logs the
 // panics to the logging subsystem.
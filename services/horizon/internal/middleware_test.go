@@ -1,6 +1,9 @@
 package horizon
 
 import (
+	"net"
+	"net/http"
+	"net/url"
 	"strconv"
 	"testing"
 
@@ -28,6 +31,8 @@ func (suite *RateLimitMiddlewareTestSuite) SetupTest() {
 		MaxRate:  throttled.PerHour(10),
 		MaxBurst: 9,
 	}
+	_, localhost, _ := net.ParseCIDR("127.0.0.0/8")
+	suite.c.TrustedProxies = []*net.IPNet{localhost}
 	suite.app = NewApp(suite.c)
 	suite.rh = NewRequestHelper(suite.app)
 }
@@ -86,7 +91,7 @@ func (suite *RateLimitMiddlewareTestSuite) TestRateLimit_RemoteAddr() {
 	assert.Equal(suite.T(), 429, w.Code)
 }
 
-// Restrict based upon X-Forwarded-For correctly.
+// Restrict based upon the X-Forwarded-For of trusted proxies correctly.
 func (suite *RateLimitMiddlewareTestSuite) TestRateLimit_XForwardedFor() {
 	for i := 0; i < 10; i++ {
 		w := suite.rh.Get("/", test.RequestHelperXFF("4.4.4.4"))
@@ -100,19 +105,109 @@ func (suite *RateLimitMiddlewareTestSuite) TestRateLimit_XForwardedFor() {
 	w = suite.rh.Get("/", test.RequestHelperRemoteAddr("4.4.4.3"))
 	assert.Equal(suite.T(), 200, w.Code)
 
-	// Ignores leading private ips
+	// Ignores the addresses prepended by the client
 	w = suite.rh.Get("/", test.RequestHelperXFF("10.0.0.1, 4.4.4.4"))
 	assert.Equal(suite.T(), 429, w.Code)
+	w = suite.rh.Get("/", test.RequestHelperXFF("4.4.4.5, 4.4.4.4"))
+	assert.Equal(suite.T(), 429, w.Code)
 
-	// Ignores trailing ips
-	w = suite.rh.Get("/", test.RequestHelperXFF("4.4.4.4, 4.4.4.5, 127.0.0.1"))
+	// Skips the trusted proxies
+	w = suite.rh.Get("/", test.RequestHelperXFF("4.4.4.4, 127.0.0.2"))
 	assert.Equal(suite.T(), 429, w.Code)
+
+	// Ignores the header sent by untrusted peers
+	w = suite.rh.Get("/", test.RequestHelperRemoteAddr("4.4.4.3"), test.RequestHelperXFF("4.4.4.4"))
+	assert.Equal(suite.T(), 200, w.Code)
 }
 
 func TestRateLimitMiddlewareTestSuite(t *testing.T) {
 	suite.Run(t, new(RateLimitMiddlewareTestSuite))
 }
 
+// Clients of a rate limit tier get its limits, separately for each class of
+// requests.
+func TestRateLimit_Tiers(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+	c := NewTestConfig()
+	c.RateLimit = &throttled.RateQuota{
+		MaxRate:  throttled.PerHour(10),
+		MaxBurst: 9,
+	}
+	c.RateLimitTiers = []RateLimitTier{
+		{
+			Name:               "partners",
+			APIKeys:            []string{"partner-key"},
+			CIDRs:              []string{"10.1.0.0/16", "4.4.0.0/16"},
+			RequestsPerHour:    20,
+			SubmissionsPerHour: 5,
+		},
+	}
+	app := NewApp(c)
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	apiKey := func(key string) func(r *http.Request) {
+		return func(r *http.Request) {
+			r.Header.Set(apiKeyHeader, key)
+		}
+	}
+
+	// clients sending a known API key get the tier's limits
+	w := rh.Get("/", apiKey("partner-key"))
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "20", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "19", w.Header().Get("X-RateLimit-Remaining"))
+
+	// submissions are limited separately
+	w = rh.Post("/transactions", url.Values{}, apiKey("partner-key"))
+	assert.Equal(t, "5", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "4", w.Header().Get("X-RateLimit-Remaining"))
+
+	// streams are limited separately, here not at all
+	r, _ := http.NewRequest("GET", "/ledgers", nil)
+	apiKey("partner-key")(r)
+	test.RequestHelperStreaming(r)
+	limiter := app.GetRateLimiter()
+	limited, result, err := limiter.RateLimiter.RateLimit(limiter.VaryBy.Key(r), 1)
+	assert.NoError(t, err)
+	assert.False(t, limited)
+	assert.Equal(t, -1, result.Limit)
+
+	// clients in the tier's networks get its limits
+	w = rh.Get("/", test.RequestHelperRemoteAddr("10.1.2.3"))
+	assert.Equal(t, "20", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "19", w.Header().Get("X-RateLimit-Remaining"))
+
+	// other clients get the default limits
+	w = rh.Get("/", apiKey("unknown-key"))
+	assert.Equal(t, "10", w.Header().Get("X-RateLimit-Limit"))
+	w = rh.Get("/", test.RequestHelperRemoteAddr("10.2.0.1"))
+	assert.Equal(t, "10", w.Header().Get("X-RateLimit-Limit"))
+
+	// the X-Forwarded-For header of peers that aren't trusted proxies doesn't
+	// match the tier's networks
+	w = rh.Get("/", test.RequestHelperXFF("4.4.1.1"))
+	assert.Equal(t, "10", w.Header().Get("X-RateLimit-Limit"))
+	w = rh.Get("/", test.RequestHelperRemoteAddr("4.4.1.1"))
+	assert.Equal(t, "20", w.Header().Get("X-RateLimit-Limit"))
+}
+
+// Rate limit tiers need a name that can't be confused with the separators of
+// the limiter keys.
+func TestRateLimit_TierNames(t *testing.T) {
+	quota := &throttled.RateQuota{MaxRate: throttled.PerHour(10), MaxBurst: 9}
+
+	_, _, err := newTieredRateLimiter(quota, []RateLimitTier{{RequestsPerHour: 20}})
+	assert.Error(t, err)
+
+	_, _, err = newTieredRateLimiter(quota, []RateLimitTier{{Name: "a|b", RequestsPerHour: 20}})
+	assert.Error(t, err)
+
+	_, _, err = newTieredRateLimiter(quota, []RateLimitTier{{Name: "partners", RequestsPerHour: 20}})
+	assert.NoError(t, err)
+}
+
 // Rate Limiting works with redis
 func TestRateLimit_Redis(t *testing.T) {
 	ht := StartHTTPTest(t, "base")
@@ -16,6 +16,35 @@ type State struct {
 	Mode        int64
 	LastBaseFee int64
 	LastLedger  int64
+
+	// Max and the percentiles below are the fees per operation accepted in
+	// the recent ledgers.
+	Max int64
+	P10 int64
+	P20 int64
+	P30 int64
+	P40 int64
+	P50 int64
+	P60 int64
+	P70 int64
+	P80 int64
+	P90 int64
+	P95 int64
+	P99 int64
+
+	// LedgerCapacityUsage is the share of the maximum transaction set size
+	// used by the recent ledgers, between 0 and 1.
+	LedgerCapacityUsage float64
+
+	// LedgerMaxFees are the max fees per operation accepted in each of the
+	// recent ledgers, the latest first.
+	LedgerMaxFees []LedgerMaxFee
+}
+
+// LedgerMaxFee is the max fee per operation accepted in a ledger.
+type LedgerMaxFee struct {
+	Ledger int64
+	MaxFee int64
 }
 
 // CurrentState returns the cached snapshot of operation fee state
@@ -0,0 +1,92 @@
+package paths
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertToBuyingUnits(t *testing.T) {
+	testCases := []struct {
+		sellingOfferAmount int64
+		sellingUnitsNeeded int64
+		pricen             int64
+		priced             int64
+		wantBuyingUnits    int64
+		wantSellingUnits   int64
+	}{
+		{7, 2, 3, 7, 1, 2},
+		{math.MaxInt64, 2, 3, 7, 1, 2},
+		{20, 20, 1, 4, 5, 20},
+		{20, 100, 1, 4, 5, 20},
+		{20, 20, 7, 11, 13, 19},
+		{20, 20, 11, 7, 32, 20},
+		{20, 100, 7, 11, 13, 19},
+		{20, 100, 11, 7, 32, 20},
+		{1, 0, 3, 7, 0, 0},
+		{1, 0, 7, 3, 0, 0},
+		{math.MaxInt64, 0, 3, 7, 0, 0},
+	}
+	for _, kase := range testCases {
+		t.Run(t.Name(), func(t *testing.T) {
+			buyingUnits, sellingUnits, e := ConvertToBuyingUnits(kase.sellingOfferAmount, kase.sellingUnitsNeeded, kase.pricen, kase.priced)
+			if !assert.Nil(t, e) {
+				return
+			}
+			assert.Equal(t, kase.wantBuyingUnits, buyingUnits)
+			assert.Equal(t, kase.wantSellingUnits, sellingUnits)
+		})
+	}
+}
+
+func TestWillAddOverflow(t *testing.T) {
+	testCases := []struct {
+		a                int64
+		b                int64
+		wantWillOverflow bool
+	}{
+		{1, 2, false},
+		{0, 1, false},
+		{math.MaxInt64, 0, false},
+		{math.MaxInt64 - 1, 1, false},
+		{math.MaxInt64, 1, true},
+		{math.MaxInt64 - 1, 2, true},
+		{math.MaxInt64 - 1, math.MaxInt64, true},
+		{math.MaxInt64, math.MaxInt64, true},
+	}
+	for _, kase := range testCases {
+		t.Run(t.Name(), func(t *testing.T) {
+			r := WillAddOverflow(kase.a, kase.b)
+			assert.Equal(t, kase.wantWillOverflow, r)
+		})
+	}
+}
+
+func TestConvertToSellingUnits(t *testing.T) {
+	testCases := []struct {
+		sellingOfferAmount   int64
+		buyingUnitsAvailable int64
+		pricen               int64
+		priced               int64
+		wantSellingUnits     int64
+		wantBuyingUnits      int64
+	}{
+		{100, 100, 1, 2, 100, 50},
+		{100, 20, 1, 2, 40, 20},
+		{20, 20, 11, 7, 12, 19},
+		{20, 100, 11, 7, 20, 32},
+		{7, 2, 3, 7, 4, 2},
+		{1, 0, 3, 7, 0, 0},
+	}
+	for _, kase := range testCases {
+		t.Run(t.Name(), func(t *testing.T) {
+			sellingUnits, buyingUnits, e := ConvertToSellingUnits(kase.sellingOfferAmount, kase.buyingUnitsAvailable, kase.pricen, kase.priced)
+			if !assert.Nil(t, e) {
+				return
+			}
+			assert.Equal(t, kase.wantSellingUnits, sellingUnits)
+			assert.Equal(t, kase.wantBuyingUnits, buyingUnits)
+		})
+	}
+}
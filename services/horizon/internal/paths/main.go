@@ -4,6 +4,10 @@ import (
 	"github.com/kinecosystem/go/xdr"
 )
 
+// MaxPathLength is a maximum path length as defined in XDR file (includes source and
+// destination assets).
+const MaxPathLength uint = 7
+
 // Query is a query for paths
 type Query struct {
 	DestinationAddress string
@@ -12,6 +16,14 @@ type Query struct {
 	SourceAssets       []xdr.Asset
 }
 
+// StrictSendQuery is a query for paths that send a fixed amount of a source
+// asset and deliver as much as possible of one of the destination assets
+type StrictSendQuery struct {
+	SourceAsset       xdr.Asset
+	SourceAmount      xdr.Int64
+	DestinationAssets []xdr.Asset
+}
+
 // Path is the result returned by a path finder and is tied to the DestinationAmount used in the input query
 type Path struct {
 	Path        []xdr.Asset
@@ -19,10 +31,16 @@ type Path struct {
 	Destination xdr.Asset
 	// represents the source assets to be used as `sendMax` field for a `PathPaymentOp` struct
 	Cost xdr.Int64
+	// represents the destination assets received when sending `Cost` of the
+	// source asset. It is only set for paths found by `FindStrictSend`, where
+	// `Cost` is the SourceAmount used in the input query.
+	DestinationAmount xdr.Int64
 }
 
 // Finder finds paths.
 type Finder interface {
 	// Returns path for a Query of a maximum length `maxLength`
 	Find(q Query, maxLength uint) ([]Path, error)
+	// Returns path for a StrictSendQuery of a maximum length `maxLength`
+	FindStrictSend(q StrictSendQuery, maxLength uint) ([]Path, error)
 }
@@ -0,0 +1,218 @@
+package horizon
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/kinecosystem/go/services/horizon/internal/render"
+	"github.com/throttled/throttled"
+)
+
+// apiKeyHeader is the request header clients of a rate limit tier send their
+// API key in.
+const apiKeyHeader = "X-Api-Key"
+
+// RateLimitTier is a set of rate limits applied to the clients sending one of
+// its API keys, or whose IP address belongs to one of its networks, instead of
+// the default per IP `per-hour-rate-limit`.  Each client of a tier, identified
+// by its API key or IP address, is limited separately.  A limit of 0 means no
+// limit.
+type RateLimitTier struct {
+	Name    string   `json:"name"`
+	APIKeys []string `json:"api_keys"`
+	CIDRs   []string `json:"cidrs"`
+
+	// RequestsPerHour limits the requests that are neither streams nor
+	// transaction submissions.
+	RequestsPerHour int `json:"requests_per_hour"`
+	// StreamsPerHour limits streaming requests and the events sent on them.
+	StreamsPerHour int `json:"streams_per_hour"`
+	// SubmissionsPerHour limits transaction submissions.
+	SubmissionsPerHour int `json:"submissions_per_hour"`
+}
+
+// LoadRateLimitTiers reads the JSON array of tiers in the file at `path`.
+func LoadRateLimitTiers(path string) ([]RateLimitTier, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var tiers []RateLimitTier
+	err = json.Unmarshal(raw, &tiers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limit tiers in %s: %v", path, err)
+	}
+
+	return tiers, nil
+}
+
+// requestClass separates the requests that are rate limited with different
+// quotas.
+type requestClass string
+
+const (
+	requestClassDefault    requestClass = "request"
+	requestClassStream     requestClass = "stream"
+	requestClassSubmission requestClass = "submission"
+)
+
+func classifyRequest(r *http.Request) requestClass {
+	if r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/transactions") {
+		return requestClassSubmission
+	}
+
+	if strings.Contains(r.Header.Get("Accept"), render.MimeEventStream) {
+		return requestClassStream
+	}
+
+	return requestClassDefault
+}
+
+// rateLimitTier is a RateLimitTier ready to match requests.
+type rateLimitTier struct {
+	name     string
+	apiKeys  map[string]bool
+	networks []*net.IPNet
+}
+
+// VaryByRateLimitTier keys requests by the tier their client belongs to, the
+// class of the request and the client's identity: its API key when it sent a
+// known one, its IP address otherwise.  Clients belonging to no tier get the
+// default tier, named "", which doesn't separate classes of requests.
+type VaryByRateLimitTier struct {
+	tiers []rateLimitTier
+}
+
+// Key implements throttled.VaryBy.
+func (v VaryByRateLimitTier) Key(r *http.Request) string {
+	class := classifyRequest(r)
+
+	if key := r.Header.Get(apiKeyHeader); key != "" {
+		for _, tier := range v.tiers {
+			if tier.apiKeys[key] {
+				return rateLimitKey(tier.name, class, "key:"+key)
+			}
+		}
+	}
+
+	ip := remoteAddrIP(r)
+	parsed := net.ParseIP(strings.Trim(ip, "[]"))
+	if parsed != nil {
+		for _, tier := range v.tiers {
+			for _, network := range tier.networks {
+				if network.Contains(parsed) {
+					return rateLimitKey(tier.name, class, "ip:"+ip)
+				}
+			}
+		}
+	}
+
+	// the default tier limits every class of requests together
+	return rateLimitKey("", requestClassDefault, "ip:"+ip)
+}
+
+func rateLimitKey(tier string, class requestClass, client string) string {
+	return tier + "|" + string(class) + "|" + client
+}
+
+// tieredRateLimiter implements throttled.RateLimiter, limiting each key built
+// by VaryByRateLimitTier with the limiter of its tier and request class.  Keys
+// of classes with no limiter are never limited.
+type tieredRateLimiter struct {
+	limiters map[string]throttled.RateLimiter
+}
+
+// RateLimit implements throttled.RateLimiter.
+func (l *tieredRateLimiter) RateLimit(key string, quantity int) (bool, throttled.RateLimitResult, error) {
+	parts := strings.SplitN(key, "|", 3)
+	if len(parts) == 3 {
+		if limiter, ok := l.limiters[parts[0]+"|"+parts[1]]; ok {
+			return limiter.RateLimit(key, quantity)
+		}
+	}
+
+	return false, throttled.RateLimitResult{Limit: -1, Remaining: -1, ResetAfter: -1, RetryAfter: -1}, nil
+}
+
+func (l *tieredRateLimiter) add(tier string, class requestClass, quota *throttled.RateQuota) error {
+	if quota == nil {
+		return nil
+	}
+
+	limiter, err := throttled.NewGCRARateLimiter(50000, *quota)
+	if err != nil {
+		return err
+	}
+
+	l.limiters[tier+"|"+string(class)] = limiter
+	return nil
+}
+
+// newTieredRateLimiter builds the limiters of `tiers` and of the default tier,
+// limited by `defaultQuota`.
+func newTieredRateLimiter(
+	defaultQuota *throttled.RateQuota,
+	tiers []RateLimitTier,
+) (*tieredRateLimiter, VaryByRateLimitTier, error) {
+	limiter := &tieredRateLimiter{limiters: map[string]throttled.RateLimiter{}}
+	varyBy := VaryByRateLimitTier{}
+
+	err := limiter.add("", requestClassDefault, defaultQuota)
+	if err != nil {
+		return nil, varyBy, err
+	}
+
+	for _, tier := range tiers {
+		if tier.Name == "" {
+			return nil, varyBy, fmt.Errorf("rate limit tier without a name")
+		}
+
+		// the name is part of the limiter keys, which are separated by "|"
+		if strings.Contains(tier.Name, "|") {
+			return nil, varyBy, fmt.Errorf("rate limit tier %s: name must not contain \"|\"", tier.Name)
+		}
+
+		t := rateLimitTier{name: tier.Name, apiKeys: map[string]bool{}}
+		for _, key := range tier.APIKeys {
+			t.apiKeys[key] = true
+		}
+
+		for _, cidr := range tier.CIDRs {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return nil, varyBy, fmt.Errorf("rate limit tier %s: %v", tier.Name, err)
+			}
+			t.networks = append(t.networks, network)
+		}
+
+		quotas := map[requestClass]int{
+			requestClassDefault:    tier.RequestsPerHour,
+			requestClassStream:     tier.StreamsPerHour,
+			requestClassSubmission: tier.SubmissionsPerHour,
+		}
+		for class, perHour := range quotas {
+			if perHour == 0 {
+				continue
+			}
+
+			// the whole hourly quota can be used at once, so that
+			// X-RateLimit-Limit reports the tier's quota
+			err := limiter.add(tier.Name, class, &throttled.RateQuota{
+				MaxRate:  throttled.PerHour(perHour),
+				MaxBurst: perHour - 1,
+			})
+			if err != nil {
+				return nil, varyBy, err
+			}
+		}
+
+		varyBy.tiers = append(varyBy.tiers, t)
+	}
+
+	return limiter, varyBy, nil
+}
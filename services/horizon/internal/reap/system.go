@@ -77,6 +77,10 @@ func (r *System) clearBefore(seq int32) error {
 	if err != nil {
 		return err
 	}
+	err = clear(0, end, "history_operation_assets", "history_operation_id")
+	if err != nil {
+		return err
+	}
 	err = clear(0, end, "history_operations", "id")
 	if err != nil {
 		return err
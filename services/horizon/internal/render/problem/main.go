@@ -92,6 +92,16 @@ var (
 			"this horizon instance.",
 	}
 
+	// Unauthorized is a well-known problem type.  Use it as a shortcut
+	// in your actions.
+	Unauthorized = problem.P{
+		Type:   "unauthorized",
+		Title:  "Unauthorized",
+		Status: http.StatusUnauthorized,
+		Detail: "The request lacks valid credentials for this resource.  Please " +
+			"send the expected token in the Authorization header.",
+	}
+
 	// StaleHistory is a well-known problem type.  Use it as a shortcut
 	// in your actions.
 	StaleHistory = problem.P{
@@ -0,0 +1,24 @@
+package resourceadapter
+
+import (
+	"context"
+
+	"github.com/kinecosystem/go/amount"
+	. "github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+)
+
+// PopulateBalanceHistory fills out the details of a bucket of balance history
+// using a row of the balance history query.
+func PopulateBalanceHistory(
+	ctx context.Context,
+	dest *BalanceHistory,
+	row history.BalanceHistory,
+) (err error) {
+	dest.Timestamp = row.Timestamp
+	dest.ChangeCount = row.ChangeCount
+	dest.Credited = amount.StringFromInt64(row.Credited)
+	dest.Debited = amount.StringFromInt64(row.Debited)
+	dest.Balance = amount.StringFromInt64(row.Balance)
+	return
+}
@@ -93,6 +93,9 @@ func PopulateBaseOperation(
 	populateOperationType(dest, row)
 	dest.LedgerCloseTime = ledger.ClosedAt
 	dest.TransactionHash = row.TransactionHash
+	dest.TransactionSuccessful = row.IsTransactionSuccessful()
+	dest.TransactionMemoType = row.TransactionMemoType
+	dest.TransactionMemo = row.TransactionMemo.String
 
 	lb := hal.LinkBuilder{Base: httpx.BaseURL(ctx)}
 	self := fmt.Sprintf("/operations/%d", row.ID)
@@ -13,6 +13,19 @@ func PopulatePath(ctx context.Context, dest *horizon.Path, q paths.Query, p path
 	dest.DestinationAmount = amount.String(q.DestinationAmount)
 	dest.SourceAmount = amount.String(p.Cost)
 
+	return populatePathAssets(dest, p)
+}
+
+// PopulateStrictSendPath converts the paths.Path found for a
+// paths.StrictSendQuery into a Path
+func PopulateStrictSendPath(ctx context.Context, dest *horizon.Path, p paths.Path) (err error) {
+	dest.DestinationAmount = amount.String(p.DestinationAmount)
+	dest.SourceAmount = amount.String(p.Cost)
+
+	return populatePathAssets(dest, p)
+}
+
+func populatePathAssets(dest *horizon.Path, p paths.Path) (err error) {
 	err = p.Source.Extract(
 		&dest.SourceAssetType,
 		&dest.SourceAssetCode,
@@ -0,0 +1,31 @@
+package resourceadapter
+
+import (
+	"context"
+
+	. "github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/services/horizon/internal/txsub"
+)
+
+// PopulateSubmissionAttempts fills out the details of every attempt made to
+// submit a transaction resubmitted with a higher fee.
+func PopulateSubmissionAttempts(ctx context.Context, attempts []txsub.SubmissionAttempt) []SubmissionAttempt {
+	if len(attempts) == 0 {
+		return nil
+	}
+
+	dest := make([]SubmissionAttempt, len(attempts))
+	for i, attempt := range attempts {
+		dest[i].Hash = attempt.Hash
+		dest[i].Env = attempt.EnvelopeXDR
+		dest[i].FeePaid = int32(attempt.Fee)
+
+		if fail, ok := attempt.Err.(*txsub.FailedTransactionError); ok {
+			var codes TransactionResultCodes
+			if PopulateTransactionResultCodes(ctx, &codes, fail) == nil {
+				dest[i].ResultCodes = &codes
+			}
+		}
+	}
+	return dest
+}
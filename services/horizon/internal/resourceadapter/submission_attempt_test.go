@@ -0,0 +1,40 @@
+package resourceadapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kinecosystem/go/services/horizon/internal/txsub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopulateSubmissionAttempts(t *testing.T) {
+	assert.Nil(t, PopulateSubmissionAttempts(context.Background(), nil))
+
+	attempts := PopulateSubmissionAttempts(context.Background(), []txsub.SubmissionAttempt{
+		{
+			Hash:        "first",
+			EnvelopeXDR: "first envelope",
+			Fee:         100,
+			Err:         &txsub.FailedTransactionError{ResultXDR: "AAAAAAAAAAD////3AAAAAA=="},
+		},
+		{
+			Hash:        "second",
+			EnvelopeXDR: "second envelope",
+			Fee:         200,
+		},
+	})
+
+	if assert.Len(t, attempts, 2) {
+		assert.Equal(t, "first", attempts[0].Hash)
+		assert.Equal(t, "first envelope", attempts[0].Env)
+		assert.Equal(t, int32(100), attempts[0].FeePaid)
+		if assert.NotNil(t, attempts[0].ResultCodes) {
+			assert.Equal(t, "tx_insufficient_fee", attempts[0].ResultCodes.TransactionCode)
+		}
+
+		assert.Equal(t, "second", attempts[1].Hash)
+		assert.Equal(t, int32(200), attempts[1].FeePaid)
+		assert.Nil(t, attempts[1].ResultCodes)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	. "github.com/kinecosystem/go/protocols/horizon"
 	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
 	"github.com/kinecosystem/go/services/horizon/internal/httpx"
+	"github.com/kinecosystem/go/services/horizon/internal/txsub"
 	"github.com/kinecosystem/go/support/render/hal"
 )
 
@@ -30,14 +31,23 @@ func PopulateTransaction(
 	dest.OperationCount = row.OperationCount
 	dest.EnvelopeXdr = row.TxEnvelope
 	dest.ResultXdr = row.TxResult
-	dest.ResultMetaXdr = row.TxMeta
-	dest.FeeMetaXdr = row.TxFeeMeta
+	dest.ResultMetaXdr = row.TxMeta.String
+	dest.FeeMetaXdr = row.TxFeeMeta.String
 	dest.MemoType = row.MemoType
 	dest.Memo = row.Memo.String
 	dest.Signatures = strings.Split(row.SignatureString, ",")
 	dest.ValidBefore = timeString(dest, row.ValidBefore)
 	dest.ValidAfter = timeString(dest, row.ValidAfter)
 
+	dest.Successful = row.IsSuccessful()
+	if !dest.Successful {
+		codes := &TransactionResultCodes{}
+		fail := &txsub.FailedTransactionError{ResultXDR: row.TxResult}
+		if PopulateTransactionResultCodes(ctx, codes, fail) == nil {
+			dest.ResultCodes = codes
+		}
+	}
+
 	lb := hal.LinkBuilder{Base: httpx.BaseURL(ctx)}
 	dest.Links.Account = lb.Link("/accounts", dest.Account)
 	dest.Links.Ledger = lb.Link("/ledgers", fmt.Sprintf("%d", dest.Ledger))
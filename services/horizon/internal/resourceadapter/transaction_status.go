@@ -0,0 +1,53 @@
+package resourceadapter
+
+import (
+	"context"
+
+	. "github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/services/horizon/internal/httpx"
+	"github.com/kinecosystem/go/services/horizon/internal/txsub"
+	"github.com/kinecosystem/go/support/render/hal"
+)
+
+// PopulateAsyncTransactionSubmission fills out the details of an asynchronous
+// submission of the transaction `hash`.
+func PopulateAsyncTransactionSubmission(
+	ctx context.Context,
+	dest *AsyncTransactionSubmission,
+	hash string,
+	result txsub.SubmissionResult,
+) {
+	dest.Hash = hash
+	dest.TxStatus = result.Status
+
+	if fte, ok := result.Err.(*txsub.FailedTransactionError); ok {
+		dest.ErrorResultXDR = fte.ResultXDR
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	dest.Links.Status = lb.Link("/transactions_async", hash)
+}
+
+// PopulateTransactionStatus fills out the details of the status of the
+// asynchronously submitted transaction `hash`.  `result` must be either
+// pending, successful or failed.
+func PopulateTransactionStatus(ctx context.Context, dest *TransactionStatus, hash string, result txsub.Result) {
+	dest.Hash = hash
+
+	switch result.Err.(type) {
+	case nil:
+		dest.Status = "success"
+	case *txsub.FailedTransactionError:
+		dest.Status = "failed"
+	default:
+		dest.Status = "pending"
+	}
+
+	dest.Ledger = result.LedgerSequence
+	dest.Env = result.EnvelopeXDR
+	dest.Result = result.ResultXDR
+	dest.Meta = result.ResultMetaXDR
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	dest.Links.Transaction = lb.Link("/transactions", hash)
+}
@@ -16,6 +16,7 @@ func PopulateTransactionSuccess(ctx context.Context, dest *TransactionSuccess, r
 	dest.Env = result.EnvelopeXDR
 	dest.Result = result.ResultXDR
 	dest.Meta = result.ResultMetaXDR
+	dest.Attempts = PopulateSubmissionAttempts(ctx, result.Attempts)
 
 	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
 	dest.Links.Transaction = lb.Link("/transactions", result.Hash)
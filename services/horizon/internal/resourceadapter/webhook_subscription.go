@@ -0,0 +1,36 @@
+package resourceadapter
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/kinecosystem/go/protocols/horizon"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/httpx"
+	"github.com/kinecosystem/go/services/horizon/internal/webhooks"
+	"github.com/kinecosystem/go/support/render/hal"
+)
+
+// PopulateWebhookSubscription fills out the details of a webhook subscription
+// using a row from the webhook_subscriptions table.  The subscription's
+// secret is left out.
+func PopulateWebhookSubscription(
+	ctx context.Context,
+	dest *WebhookSubscription,
+	row webhooks.Subscription,
+) {
+	dest.ID = fmt.Sprintf("%d", row.ID)
+	dest.PT = row.PagingToken()
+	dest.URL = row.URL
+	dest.Account = row.Account.String
+	dest.AssetType = row.AssetType.String
+	dest.AssetCode = row.AssetCode.String
+	dest.AssetIssuer = row.AssetIssuer.String
+	if row.EffectType.Valid {
+		dest.EffectType = EffectTypeNames[history.EffectType(row.EffectType.Int64)]
+	}
+	dest.CreatedAt = row.CreatedAt
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	dest.Links.Self = lb.Linkf("/admin/webhooks/%s", dest.ID)
+}
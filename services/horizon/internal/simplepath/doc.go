@@ -35,4 +35,9 @@
 // 2. We start with the last asset (pop the stack), calculate it's cost (if not
 //    cached) and continue towards the source asset (bottom of the stack).
 // 3. We return the final cost.
+//
+// Strict send queries (`FindStrictSend`) use a separate `sendSearch` that walks
+// the other way: it starts with `SourceAmount` of the source asset, extends
+// paths with the assets sold by offers buying the head of the path and tracks
+// the amount received along the way with `orderBook.AmountReceived`.
 package simplepath
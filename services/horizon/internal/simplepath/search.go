@@ -8,7 +8,7 @@ import (
 
 // MaxPathLength is a maximum path length as defined in XDR file (includes source and
 // destination assets).
-const MaxPathLength uint = 7
+const MaxPathLength = paths.MaxPathLength
 
 // search represents a single query against the simple finder.  It provides
 // a place to store the results of the query, mostly for the purposes of code
@@ -94,30 +94,42 @@ func (s *search) Run() {
 		return
 	}
 
-	s.Err = s.Q.Begin()
+	s.Err = beginSnapshot(s.Q)
 	if s.Err != nil {
 		return
 	}
 
 	defer s.Q.Rollback()
 
-	// We need REPEATABLE READ here to have a stable view of the offers
-	// table. Without it, it's possible that search started in ledger X
-	// and finished in ledger X+1 would give invalid results.
-	//
-	// https://www.postgresql.org/docs/9.1/static/transaction-iso.html
-	// > Note that only updating transactions might need to be retried;
-	// > read-only transactions will never have serialization conflicts.
-	_, s.Err = s.Q.ExecRaw("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY")
-	if s.Err != nil {
-		return
-	}
-
 	for s.hasMore() {
 		s.runOnce()
 	}
 }
 
+// beginSnapshot starts a read only transaction on q.
+//
+// We need REPEATABLE READ here to have a stable view of the offers
+// table. Without it, it's possible that search started in ledger X
+// and finished in ledger X+1 would give invalid results.
+//
+// https://www.postgresql.org/docs/9.1/static/transaction-iso.html
+// > Note that only updating transactions might need to be retried;
+// > read-only transactions will never have serialization conflicts.
+func beginSnapshot(q *core.Q) error {
+	err := q.Begin()
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecRaw("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY")
+	if err != nil {
+		q.Rollback()
+		return err
+	}
+
+	return nil
+}
+
 // pop removes the head from the search queue, returning it to the caller
 func (s *search) pop() computedNode {
 	next := s.queue[0]
@@ -0,0 +1,183 @@
+package simplepath
+
+import (
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
+	"github.com/kinecosystem/go/services/horizon/internal/paths"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// sendSearch represents a single strict send query against the simple
+// finder. Unlike search, it starts at the source asset and extends paths
+// towards the destination assets, tracking the amount received so far.
+//
+// The sendSearch struct is used as follows:
+//
+// 1.  Create an instance, ensuring the Query, Q and MaxLength fields are set
+// 2.  Call Init() to populate dependent fields in the struct with their initial values
+// 3.  Call Run() to perform the search.
+type sendSearch struct {
+	Query     paths.StrictSendQuery
+	Q         *core.Q
+	MaxLength uint
+
+	// Fields below are initialized by a call to Init() after
+	// setting the fields above
+	queue   []*sendNode
+	targets map[string]bool
+
+	//This fields below are initialized after the search is run
+	Err     error
+	Results []paths.Path
+}
+
+// sendNode represents a path as a linked list pointing from the last asset
+// reached back to the source asset
+type sendNode struct {
+	Asset xdr.Asset
+	Prev  *sendNode
+	// Amount is the amount of Asset received when sending the source amount
+	// through this path
+	Amount xdr.Int64
+	Depth  uint
+}
+
+// IsOnPath returns true if a given asset is in the path.
+func (n *sendNode) IsOnPath(asset xdr.Asset) bool {
+	for cur := n; cur != nil; cur = cur.Prev {
+		if asset.Equals(cur.Asset) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *sendNode) asPath(sourceAmount xdr.Int64) paths.Path {
+	var assets []xdr.Asset
+	for cur := n; cur != nil; cur = cur.Prev {
+		assets = append([]xdr.Asset{cur.Asset}, assets...)
+	}
+
+	result := paths.Path{
+		Source:            assets[0],
+		Destination:       assets[len(assets)-1],
+		Cost:              sourceAmount,
+		DestinationAmount: n.Amount,
+	}
+
+	// exclude the source and the destination assets
+	if len(assets) > 2 {
+		result.Path = assets[1 : len(assets)-1]
+	}
+
+	return result
+}
+
+// Init initialized the search, setting fields on the struct used to
+// hold state needed during the actual search.
+func (s *sendSearch) Init() {
+	s.queue = []*sendNode{
+		&sendNode{
+			Asset:  s.Query.SourceAsset,
+			Amount: s.Query.SourceAmount,
+			Depth:  1,
+		},
+	}
+
+	s.targets = map[string]bool{}
+	for _, a := range s.Query.DestinationAssets {
+		s.targets[a.String()] = true
+	}
+
+	s.Err = nil
+	s.Results = nil
+}
+
+// Run triggers the search, which will populate the Results and Err
+// field for the search after completion.
+func (s *sendSearch) Run() {
+	s.Err = beginSnapshot(s.Q)
+	if s.Err != nil {
+		return
+	}
+
+	defer s.Q.Rollback()
+
+	for s.hasMore() {
+		s.runOnce()
+	}
+}
+
+// returns false if the search should stop.
+func (s *sendSearch) hasMore() bool {
+	if s.Err != nil {
+		return false
+	}
+
+	if len(s.Results) >= maxResults {
+		return false
+	}
+
+	return len(s.queue) > 0
+}
+
+// runOnce processes the head of the search queue, findings results
+// and extending the search as necessary.
+func (s *sendSearch) runOnce() {
+	cur := s.queue[0]
+	s.queue = s.queue[1:]
+
+	if s.targets[cur.Asset.String()] {
+		s.Results = append(s.Results, cur.asPath(s.Query.SourceAmount))
+	}
+
+	if cur.Depth == s.MaxLength {
+		return
+	}
+
+	s.extendSearch(cur)
+}
+
+func (s *sendSearch) extendSearch(cur *sendNode) {
+	// find assets sold by offers buying the current asset
+	var connected []xdr.Asset
+	s.Err = s.Q.ConnectedSellingAssets(&connected, cur.Asset)
+	if s.Err != nil {
+		return
+	}
+
+	for _, a := range connected {
+		// We don't want the same asset on the path twice.
+		if cur.IsOnPath(a) {
+			continue
+		}
+
+		// If the connected asset is not our target and the current length
+		// of the path is MaxLength-1 then it does not make sense to extend
+		// such path.
+		if cur.Depth == s.MaxLength-1 && !s.targets[a.String()] {
+			continue
+		}
+
+		ob := orderBook{
+			Selling: a,         // offer is selling this asset
+			Buying:  cur.Asset, // offer is buying this asset
+			Q:       s.Q,
+		}
+
+		received, err := ob.AmountReceived(cur.Amount)
+		if err == ErrNotEnough {
+			continue
+		}
+		if err != nil {
+			s.Err = err
+			return
+		}
+
+		s.queue = append(s.queue, &sendNode{
+			Asset:  a,
+			Prev:   cur,
+			Amount: received,
+			Depth:  cur.Depth + 1,
+		})
+	}
+}
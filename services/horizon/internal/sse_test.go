@@ -7,10 +7,12 @@ import (
 	"time"
 
 	"github.com/kinecosystem/go/network"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/core"
 	"github.com/kinecosystem/go/services/horizon/internal/ingest"
 	"github.com/kinecosystem/go/services/horizon/internal/ledger"
 	"github.com/kinecosystem/go/services/horizon/internal/render/sse"
 	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/kinecosystem/go/xdr"
 )
 
 // Test 2 subscriptions to different topics. Make sure that one topic doesnt
@@ -169,6 +171,42 @@ func TestSSEPubsubTransactionsImplicit(t *testing.T) {
 	wg.Wait()
 }
 
+// Test SSE subscription for an order book gets message when ingest to Horizon
+// happens, whichever way round the pair is given.
+func TestSSEPubsubOrderBook(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("trades")
+	defer tt.Finish()
+
+	usd, err := core.AssetFromDB(xdr.AssetTypeAssetTypeCreditAlphanum4, "USD", "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	tt.Require.NoError(err)
+	native, err := core.AssetFromDB(xdr.AssetTypeAssetTypeNative, "", "")
+	tt.Require.NoError(err)
+
+	topic := ingest.OrderBookTopic(native, usd)
+	tt.Assert.Equal(topic, ingest.OrderBookTopic(usd, native))
+
+	subscription := sse.Subscribe(topic)
+	defer sse.Unsubscribe(subscription, topic)
+
+	// t.Fatal can't be called from the receiving goroutine, so the outcome is
+	// reported back to the test
+	triggered := make(chan bool, 1)
+	go func(subscription chan interface{}) {
+		select {
+		case <-subscription:
+			triggered <- true
+		case <-time.After(10 * time.Second):
+			triggered <- false
+		}
+	}(subscription)
+
+	ingestHorizon(tt)
+
+	if !<-triggered {
+		t.Fatal("subscription did not trigger fast enough")
+	}
+}
+
 // Helpers from ingest/main_test.go
 
 func ingestHorizon(tt *test.T) *ingest.Session {
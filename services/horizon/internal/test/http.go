@@ -12,6 +12,7 @@ import (
 type RequestHelper interface {
 	Get(string, ...func(*http.Request)) *httptest.ResponseRecorder
 	Post(string, url.Values, ...func(*http.Request)) *httptest.ResponseRecorder
+	Delete(string, ...func(*http.Request)) *httptest.ResponseRecorder
 }
 
 type requestHelper struct {
@@ -63,6 +64,15 @@ func (rh *requestHelper) Post(
 	return rh.Execute(req, mods)
 }
 
+func (rh *requestHelper) Delete(
+	path string,
+	mods ...func(*http.Request),
+) *httptest.ResponseRecorder {
+
+	req, _ := http.NewRequest("DELETE", path, nil)
+	return rh.Execute(req, mods)
+}
+
 func (rh *requestHelper) Execute(
 	req *http.Request,
 	requestModFns []func(*http.Request),
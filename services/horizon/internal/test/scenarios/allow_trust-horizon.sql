@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -491,6 +556,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -550,15 +621,15 @@ INSERT INTO history_effects VALUES (2, 8589946881, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (9, '6e43806989c07f5868415123dbc06e1fd46311fea07747d509e3ab24eb472aa6', '44d91f2e09e023b77901b3a0aa16e387a9686c6e04ddd9ed5a0eca3dc9cf7fb1', 0, 0, '2019-01-31 17:29:23', '2019-01-31 17:29:19.388412', '2019-01-31 17:29:19.388412', 38654705664, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACkTZHy4J4CO3eQGzoKoW44epaGxuBN3Z7VoOyj3Jz3+xhaNqBdRk3tjZUpVw8g6/yuPPseUiJnPPKEnbVVqSK2QAAAAAXFMwcwAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERnJ0UB+tvbRvL/mrwur6TALgWmrDL++FfpvoRIeYzaQSQAAAAkN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
-INSERT INTO history_ledgers VALUES (8, '44d91f2e09e023b77901b3a0aa16e387a9686c6e04ddd9ed5a0eca3dc9cf7fb1', 'd46040b48979ba18673cbc335e7fccc1bf90a42d287e32428b95c39b1af579d6', 1, 1, '2019-01-31 17:29:22', '2019-01-31 17:29:19.402714', '2019-01-31 17:29:19.402715', 34359738368, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACtRgQLSJeboYZzy8M15/zMG/kKQtKH4yQouVw5sa9XnWduq2v+oIqzbn6XJNsma/pJnfzQeELDl52+dQHkuKhHYAAAAAXFMwcgAAAAAAAAAA17tytOKBxgu8yeo5X/akyYnyYlyrUb7gJWE+zfCgC2DJ0UB+tvbRvL/mrwur6TALgWmrDL++FfpvoRIeYzaQSQAAAAgN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (7, 'd46040b48979ba18673cbc335e7fccc1bf90a42d287e32428b95c39b1af579d6', '4fb9c53469b451a3991628f2966333dd441ae13d1fde2bfdcb9e5d38b59f135e', 1, 1, '2019-01-31 17:29:21', '2019-01-31 17:29:19.418667', '2019-01-31 17:29:19.418667', 30064771072, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACk+5xTRptFGjmRYo8pZjM91EGuE9H94r/cueXTi1nxNerncPhfToLOmJb1DnZCMjzCok4nbKILgzWcl9R3N3fCYAAAAAXFMwcQAAAAAAAAAA2F0xW7K6lNYrXRzu7d5HyBUnPlumNFQipNBwSQNAx1KY9T/J8l8ZRgJ2nvHPgsOBLXiUt/80obCIuNYQ+5k+HQAAAAcN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (6, '4fb9c53469b451a3991628f2966333dd441ae13d1fde2bfdcb9e5d38b59f135e', '3800f6f032db19d6276194bbd89b62c9d9fed68c8cb229ee09012067df931f65', 1, 1, '2019-01-31 17:29:20', '2019-01-31 17:29:19.431695', '2019-01-31 17:29:19.431695', 25769803776, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACjgA9vAy2xnWJ2GUu9ibYsnZ/taMjLIp7gkBIGffkx9lAsNK1HxqHTKoQ9PbsGDsUguZXND3BXyZI9v0FmJuvtAAAAAAXFMwcAAAAAAAAAAAFp2fvkMO7OW+Jq1zFYvyIp0pN6KM6DetMmxOjZVOCKfoyFYxVtbS0co1o7PrUg/DT9HsoN2CLnKzvfeIDgIYHwAAAAYN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (5, '3800f6f032db19d6276194bbd89b62c9d9fed68c8cb229ee09012067df931f65', '8596ad535e51953ac7516fc4c1b5efa4f16b2a5216707bf6c0ce1c6deaff921f', 1, 1, '2019-01-31 17:29:19', '2019-01-31 17:29:19.447707', '2019-01-31 17:29:19.447708', 21474836480, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAACoWWrVNeUZU6x1FvxMG176TxaypSFnB79sDOHG3q/5IfKIXwwqdGCzxPUgNGeO+XOGhEkZ42A22gMP9Yc/QXMZwAAAAAXFMwbwAAAAAAAAAAxlJuyWie0d1LzUCR1K4LD2g+0KuAHvbJ+SgmGHyguLWcAPLVj90z692aFMM/sJBmR2rEymWR0FIHd/eYrDLddQAAAAUN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (4, '8596ad535e51953ac7516fc4c1b5efa4f16b2a5216707bf6c0ce1c6deaff921f', '621a195835816a07fe55d46ae9c85d6e69b66f7cbd208017073b656603885f6b', 1, 1, '2019-01-31 17:29:18', '2019-01-31 17:29:19.460334', '2019-01-31 17:29:19.460335', 17179869184, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACmIaGVg1gWoH/lXUaunIXW5ptm98vSCAFwc7ZWYDiF9r6oCA7kx+NFp6rqd8B3viXV5naZ2YobczK5YWygGfHYoAAAAAXFMwbgAAAAAAAAAALLKPbMojH+RR+TSBDKGB/tufH2mL12ccCHr1Jn27yPAAzhHgSYj7mh+ttsmZF5JyJF0AFI8fe/vuWvbh9VtRVQAAAAQN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (3, '621a195835816a07fe55d46ae9c85d6e69b66f7cbd208017073b656603885f6b', '3a39ffa81e63596ad00a6fc05a696d6e17cb8a4d7f29affb20414c6229d13048', 2, 2, '2019-01-31 17:29:17', '2019-01-31 17:29:19.475264', '2019-01-31 17:29:19.475264', 12884901888, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAACjo5/6geY1lq0ApvwFppbW4Xy4pNfymv+yBBTGIp0TBIIlEqno5o8G5GNvBbqe1qPIPgkacn3aA/C5CORdfrFmEAAAAAXFMwbQAAAAAAAAAATlvjBWe7MinR9n7UosajCKxUpgQMBBbhmF6bHAvPHGU5iEq/Klwbk/MFu942nmZIzt+cfpQQRvWvK6hLgVJ3nwAAAAMN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (2, '3a39ffa81e63596ad00a6fc05a696d6e17cb8a4d7f29affb20414c6229d13048', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 3, 3, '2019-01-31 17:29:16', '2019-01-31 17:29:19.491717', '2019-01-31 17:29:19.491718', 8589934592, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZU7nPOoI7SirHEO1Sa67WumwKQ62PpQGlvJvyyQ8QGEYAAAAAXFMwbAAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAj6+p+aI7JvbSfHisaUUAXhzcG+/2YWJE2bnf4zuV8i4BtzPP3p+X+41ZDhuvbqd9gBPhMAa04T+FGTRtk9yHtAAAAAIN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:29:19.504666', '2019-01-31 17:29:19.504667', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (9, '6e43806989c07f5868415123dbc06e1fd46311fea07747d509e3ab24eb472aa6', '44d91f2e09e023b77901b3a0aa16e387a9686c6e04ddd9ed5a0eca3dc9cf7fb1', 0, 0, '2019-01-31 17:29:23', '2019-01-31 17:29:19.388412', '2019-01-31 17:29:19.388412', 38654705664, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACkTZHy4J4CO3eQGzoKoW44epaGxuBN3Z7VoOyj3Jz3+xhaNqBdRk3tjZUpVw8g6/yuPPseUiJnPPKEnbVVqSK2QAAAAAXFMwcwAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERnJ0UB+tvbRvL/mrwur6TALgWmrDL++FfpvoRIeYzaQSQAAAAkN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
+INSERT INTO history_ledgers VALUES (8, '44d91f2e09e023b77901b3a0aa16e387a9686c6e04ddd9ed5a0eca3dc9cf7fb1', 'd46040b48979ba18673cbc335e7fccc1bf90a42d287e32428b95c39b1af579d6', 1, 1, '2019-01-31 17:29:22', '2019-01-31 17:29:19.402714', '2019-01-31 17:29:19.402715', 34359738368, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACtRgQLSJeboYZzy8M15/zMG/kKQtKH4yQouVw5sa9XnWduq2v+oIqzbn6XJNsma/pJnfzQeELDl52+dQHkuKhHYAAAAAXFMwcgAAAAAAAAAA17tytOKBxgu8yeo5X/akyYnyYlyrUb7gJWE+zfCgC2DJ0UB+tvbRvL/mrwur6TALgWmrDL++FfpvoRIeYzaQSQAAAAgN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (7, 'd46040b48979ba18673cbc335e7fccc1bf90a42d287e32428b95c39b1af579d6', '4fb9c53469b451a3991628f2966333dd441ae13d1fde2bfdcb9e5d38b59f135e', 1, 1, '2019-01-31 17:29:21', '2019-01-31 17:29:19.418667', '2019-01-31 17:29:19.418667', 30064771072, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACk+5xTRptFGjmRYo8pZjM91EGuE9H94r/cueXTi1nxNerncPhfToLOmJb1DnZCMjzCok4nbKILgzWcl9R3N3fCYAAAAAXFMwcQAAAAAAAAAA2F0xW7K6lNYrXRzu7d5HyBUnPlumNFQipNBwSQNAx1KY9T/J8l8ZRgJ2nvHPgsOBLXiUt/80obCIuNYQ+5k+HQAAAAcN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (6, '4fb9c53469b451a3991628f2966333dd441ae13d1fde2bfdcb9e5d38b59f135e', '3800f6f032db19d6276194bbd89b62c9d9fed68c8cb229ee09012067df931f65', 1, 1, '2019-01-31 17:29:20', '2019-01-31 17:29:19.431695', '2019-01-31 17:29:19.431695', 25769803776, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACjgA9vAy2xnWJ2GUu9ibYsnZ/taMjLIp7gkBIGffkx9lAsNK1HxqHTKoQ9PbsGDsUguZXND3BXyZI9v0FmJuvtAAAAAAXFMwcAAAAAAAAAAAFp2fvkMO7OW+Jq1zFYvyIp0pN6KM6DetMmxOjZVOCKfoyFYxVtbS0co1o7PrUg/DT9HsoN2CLnKzvfeIDgIYHwAAAAYN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (5, '3800f6f032db19d6276194bbd89b62c9d9fed68c8cb229ee09012067df931f65', '8596ad535e51953ac7516fc4c1b5efa4f16b2a5216707bf6c0ce1c6deaff921f', 1, 1, '2019-01-31 17:29:19', '2019-01-31 17:29:19.447707', '2019-01-31 17:29:19.447708', 21474836480, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAACoWWrVNeUZU6x1FvxMG176TxaypSFnB79sDOHG3q/5IfKIXwwqdGCzxPUgNGeO+XOGhEkZ42A22gMP9Yc/QXMZwAAAAAXFMwbwAAAAAAAAAAxlJuyWie0d1LzUCR1K4LD2g+0KuAHvbJ+SgmGHyguLWcAPLVj90z692aFMM/sJBmR2rEymWR0FIHd/eYrDLddQAAAAUN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (4, '8596ad535e51953ac7516fc4c1b5efa4f16b2a5216707bf6c0ce1c6deaff921f', '621a195835816a07fe55d46ae9c85d6e69b66f7cbd208017073b656603885f6b', 1, 1, '2019-01-31 17:29:18', '2019-01-31 17:29:19.460334', '2019-01-31 17:29:19.460335', 17179869184, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACmIaGVg1gWoH/lXUaunIXW5ptm98vSCAFwc7ZWYDiF9r6oCA7kx+NFp6rqd8B3viXV5naZ2YobczK5YWygGfHYoAAAAAXFMwbgAAAAAAAAAALLKPbMojH+RR+TSBDKGB/tufH2mL12ccCHr1Jn27yPAAzhHgSYj7mh+ttsmZF5JyJF0AFI8fe/vuWvbh9VtRVQAAAAQN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (3, '621a195835816a07fe55d46ae9c85d6e69b66f7cbd208017073b656603885f6b', '3a39ffa81e63596ad00a6fc05a696d6e17cb8a4d7f29affb20414c6229d13048', 2, 2, '2019-01-31 17:29:17', '2019-01-31 17:29:19.475264', '2019-01-31 17:29:19.475264', 12884901888, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAACjo5/6geY1lq0ApvwFppbW4Xy4pNfymv+yBBTGIp0TBIIlEqno5o8G5GNvBbqe1qPIPgkacn3aA/C5CORdfrFmEAAAAAXFMwbQAAAAAAAAAATlvjBWe7MinR9n7UosajCKxUpgQMBBbhmF6bHAvPHGU5iEq/Klwbk/MFu942nmZIzt+cfpQQRvWvK6hLgVJ3nwAAAAMN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (2, '3a39ffa81e63596ad00a6fc05a696d6e17cb8a4d7f29affb20414c6229d13048', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 3, 3, '2019-01-31 17:29:16', '2019-01-31 17:29:19.491717', '2019-01-31 17:29:19.491718', 8589934592, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZU7nPOoI7SirHEO1Sa67WumwKQ62PpQGlvJvyyQ8QGEYAAAAAXFMwbAAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAj6+p+aI7JvbSfHisaUUAXhzcG+/2YWJE2bnf4zuV8i4BtzPP3p+X+41ZDhuvbqd9gBPhMAa04T+FGTRtk9yHtAAAAAIN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:29:19.504666', '2019-01-31 17:29:19.504667', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -740,6 +811,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -775,6 +853,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
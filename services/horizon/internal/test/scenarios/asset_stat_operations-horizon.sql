@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -492,6 +557,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -551,10 +622,10 @@ INSERT INTO history_effects VALUES (4, 8589950977, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (4, 'f45fce564e8da69a02641e78e552775377d00f610a5c2cbe5d4f174ab6aa4660', '88e2abf0a1bc50e4b7b1f394b8c8dca46d735444a571b6feedc321992bb2a8b7', 0, 0, '2019-01-31 17:28:17', '2019-01-31 17:28:18.071753', '2019-01-31 17:28:18.071753', 17179869184, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACojiq/ChvFDkt7HzlLjI3KRtc1REpXG2/u3DIZkrsqi3LxvTjQNXP4bGKzjBH3ip0IbuaEdazYx+RTwe35ITCRgAAAAAXFMwMQAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERnBHtcBzRlqZOutr3G+B4zRtUEdZR8Om7/0n5bMhf39SgAAAAQN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
-INSERT INTO history_ledgers VALUES (3, '88e2abf0a1bc50e4b7b1f394b8c8dca46d735444a571b6feedc321992bb2a8b7', 'f638af4393174914952425a8a9d9c8ea331dcc797982849f93a020431465e36c', 2, 2, '2019-01-31 17:28:16', '2019-01-31 17:28:18.083858', '2019-01-31 17:28:18.083858', 12884901888, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACvY4r0OTF0kUlSQlqKnZyOozHcx5eYKEn5OgIEMUZeNsRdNQYQiFP2FkdcOQTVXRiUMnTXUcOZDtH9oXHeTCVTMAAAAAXFMwMAAAAAAAAAAAhSD66Lhzb7ZNorUyzTQqB11nd0srMHPv8kaiQLT5hij5yp0SP8IVWzhAtNowMdG2zQtT+zQC28kLZGWahabqEQAAAAMN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (2, 'f638af4393174914952425a8a9d9c8ea331dcc797982849f93a020431465e36c', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 4, 4, '2019-01-31 17:28:15', '2019-01-31 17:28:18.110453', '2019-01-31 17:28:18.110453', 8589934592, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZAl4xVs7p/dHViPjkvi2jwT71A96KVxBFTiVfkaPKPPMAAAAAXFMwLwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAi4Ue20vNo0cmNykBLoF1m6yPEvTdv3MfCquWXwc7NpxWLHKRZQAelocBsOHhuZ7tLga+y1raFsJJBcnyY7XitgAAAAIN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:28:18.127456', '2019-01-31 17:28:18.127456', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (4, 'f45fce564e8da69a02641e78e552775377d00f610a5c2cbe5d4f174ab6aa4660', '88e2abf0a1bc50e4b7b1f394b8c8dca46d735444a571b6feedc321992bb2a8b7', 0, 0, '2019-01-31 17:28:17', '2019-01-31 17:28:18.071753', '2019-01-31 17:28:18.071753', 17179869184, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACojiq/ChvFDkt7HzlLjI3KRtc1REpXG2/u3DIZkrsqi3LxvTjQNXP4bGKzjBH3ip0IbuaEdazYx+RTwe35ITCRgAAAAAXFMwMQAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERnBHtcBzRlqZOutr3G+B4zRtUEdZR8Om7/0n5bMhf39SgAAAAQN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
+INSERT INTO history_ledgers VALUES (3, '88e2abf0a1bc50e4b7b1f394b8c8dca46d735444a571b6feedc321992bb2a8b7', 'f638af4393174914952425a8a9d9c8ea331dcc797982849f93a020431465e36c', 2, 2, '2019-01-31 17:28:16', '2019-01-31 17:28:18.083858', '2019-01-31 17:28:18.083858', 12884901888, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACvY4r0OTF0kUlSQlqKnZyOozHcx5eYKEn5OgIEMUZeNsRdNQYQiFP2FkdcOQTVXRiUMnTXUcOZDtH9oXHeTCVTMAAAAAXFMwMAAAAAAAAAAAhSD66Lhzb7ZNorUyzTQqB11nd0srMHPv8kaiQLT5hij5yp0SP8IVWzhAtNowMdG2zQtT+zQC28kLZGWahabqEQAAAAMN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (2, 'f638af4393174914952425a8a9d9c8ea331dcc797982849f93a020431465e36c', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 4, 4, '2019-01-31 17:28:15', '2019-01-31 17:28:18.110453', '2019-01-31 17:28:18.110453', 8589934592, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZAl4xVs7p/dHViPjkvi2jwT71A96KVxBFTiVfkaPKPPMAAAAAXFMwLwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAi4Ue20vNo0cmNykBLoF1m6yPEvTdv3MfCquWXwc7NpxWLHKRZQAelocBsOHhuZ7tLga+y1raFsJJBcnyY7XitgAAAAIN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:28:18.127456', '2019-01-31 17:28:18.127456', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -716,6 +787,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -751,6 +829,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
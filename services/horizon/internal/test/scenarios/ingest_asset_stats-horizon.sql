@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -493,6 +558,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -572,15 +643,15 @@ INSERT INTO history_effects VALUES (1, 8589946881, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (9, 'fb5207b951cfff681b3e5eef2e63ec767cb009a4e4ec2502b7155c18074bca61', 'd2dbfc046d567c112851d69e16df86462bfc223ee3c9fddf0ec191f43491e4aa', 0, 0, '2019-01-31 17:31:12', '2019-01-31 17:31:09.030594', '2019-01-31 17:31:09.030594', 38654705664, 15, 1000000000000000000, 2100, 100, 100000000, 10000, 10, 'AAAACtLb/ARtVnwRKFHWnhbfhkYr/CI+48n93w7BkfQ0keSqtvEsj48eZMSqyQAtmIRyrrlDQ3Gyto587XYZlp/PnWwAAAAAXFMw4AAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERnVg7ETUj+cmbsqHUBMQAxZYLoIN3r2GvHvfaqNkLyKawAAAAkN4Lazp2QAAAAAAAAAAAg0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
-INSERT INTO history_ledgers VALUES (8, 'd2dbfc046d567c112851d69e16df86462bfc223ee3c9fddf0ec191f43491e4aa', '492cd987df7d8330a208485b72a9f689a5d38799fbd813da3b53703efb65139e', 2, 2, '2019-01-31 17:31:11', '2019-01-31 17:31:09.039475', '2019-01-31 17:31:09.039475', 34359738368, 15, 1000000000000000000, 2100, 100, 100000000, 10000, 10, 'AAAACkks2YfffYMwoghIW3Kp9oml04eZ+9gT2jtTcD77ZROe7u4UaZfkKQCzRgHN6/1fBnK/DDDPV0EwiUm6JyvBlWcAAAAAXFMw3wAAAAAAAAAAIFcEvXLqTKqnn4ZU/O/25YWxOdUJ85OkZ+D/6OUKuebVg7ETUj+cmbsqHUBMQAxZYLoIN3r2GvHvfaqNkLyKawAAAAgN4Lazp2QAAAAAAAAAAAg0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (7, '492cd987df7d8330a208485b72a9f689a5d38799fbd813da3b53703efb65139e', 'cf0eee9c48ecbbd513dfe15dd0217e587310483b58a5a2d8606dbf631d2beca6', 2, 2, '2019-01-31 17:31:10', '2019-01-31 17:31:09.066286', '2019-01-31 17:31:09.066286', 30064771072, 15, 1000000000000000000, 1900, 100, 100000000, 10000, 10, 'AAAACs8O7pxI7LvVE9/hXdAhflhzEEg7WKWi2GBtv2MdK+ymLkDcz8qvMmAU5Xn9THO/3w4rmu80QMbGJ2yJXwFh5xcAAAAAXFMw3gAAAAAAAAAAp6X4a1fQWRN1fT4Oha1VAb23rxjrafhg/8wjyOPQ4el4N31ge+2KodcF5a59vYuRjQEuoeCFDASBG31UpAKCyQAAAAcN4Lazp2QAAAAAAAAAAAdsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (6, 'cf0eee9c48ecbbd513dfe15dd0217e587310483b58a5a2d8606dbf631d2beca6', '577acb98a43bfaf17365edc9bca1a4a3b9f62119729c278445a4542ea538493e', 4, 4, '2019-01-31 17:31:09', '2019-01-31 17:31:09.078505', '2019-01-31 17:31:09.078505', 25769803776, 15, 1000000000000000000, 1700, 100, 100000000, 10000, 10, 'AAAACld6y5ikO/rxc2XtybyhpKO59iEZcpwnhEWkVC6lOEk+diGe+Hqc+/58hxmJs9GmnxNHkEUomTilix12AhKsWxIAAAAAXFMw3QAAAAAAAAAABfxQX88Y3BtlGniNGV3IwuGKHnvuXPcncSX+LhVbCbKzETnjomxnM5VVggq5b1St/qfoDymXNP0fCnwxn2XJ7wAAAAYN4Lazp2QAAAAAAAAAAAakAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (5, '577acb98a43bfaf17365edc9bca1a4a3b9f62119729c278445a4542ea538493e', 'cce5705ed44b70e65afcacbd19fa88626fdc47a5424def46a3abd41caf791ea2', 3, 3, '2019-01-31 17:31:08', '2019-01-31 17:31:09.093685', '2019-01-31 17:31:09.093685', 21474836480, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACszlcF7US3DmWvysvRn6iGJv3EelQk3vRqOr1ByveR6izmaDnqa3qHoH57coP0GackmyTCU8h4GVoGqeLx6SUGcAAAAAXFMw3AAAAAAAAAAAAvTWoAZ8sb3n9zINDA1hqoanXiN/pcjfEtKLDrzVvDPbpmBSNgpvamJJTRCorTK25NOHSHs5jMHMrm9HCdj1+QAAAAUN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (4, 'cce5705ed44b70e65afcacbd19fa88626fdc47a5424def46a3abd41caf791ea2', 'cf4741459c2de71471da66e60d8fb02aa6f104b68e527cf811e0ad0a431eae87', 4, 4, '2019-01-31 17:31:07', '2019-01-31 17:31:09.109237', '2019-01-31 17:31:09.109237', 17179869184, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACs9HQUWcLecUcdpm5g2PsCqm8QS2jlJ8+BHgrQpDHq6HTioA1lbEvCNwo5qDNpxko2HZ8wDONeW7YAu2b1bBNPsAAAAAXFMw2wAAAAAAAAAAatfPJ9QT3rB/dYPn9PCnBx/3L+i6/hPHmNhSU5EW/mndAELFQ/T5G+cw7BCn1PJwi31BZgH7MuejEgdQxxJuvQAAAAQN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (3, 'cf4741459c2de71471da66e60d8fb02aa6f104b68e527cf811e0ad0a431eae87', '100e6e8bad7f66467db7f2f3aa072aed45f789550d6ac33c335063d6862a48fe', 3, 3, '2019-01-31 17:31:06', '2019-01-31 17:31:09.123005', '2019-01-31 17:31:09.123005', 12884901888, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAAChAOboutf2ZGfbfy86oHKu1F94lVDWrDPDNQY9aGKkj+nlwnddHa7/38u31PxWf/JgsUUdzTF1uOj/OmhY1gWjcAAAAAXFMw2gAAAAAAAAAAz4e0cNoSrSyJbiU9ww4Xz/C47nFVIBEHkR11kgme+Dy76GAUV5Afh+w64l628RxE4K9UyT5wbks55EP9g1kMvQAAAAMN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (2, '100e6e8bad7f66467db7f2f3aa072aed45f789550d6ac33c335063d6862a48fe', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 3, 3, '2019-01-31 17:31:05', '2019-01-31 17:31:09.134281', '2019-01-31 17:31:09.134281', 8589934592, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76Z9GwX6q8KzxXUDk3+fdZshszCWX2SbuAnJNMiSoulCykAAAAAXFMw2QAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAACyk/eWGAYEOhla9SxZHjkxGIQ61pIijmPF9hVp1Qv5rM3994NzVGUEstpSkpCIvCg0ArBNhvBZ2713Y2Pc8QigAAAAIN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:31:09.148226', '2019-01-31 17:31:09.148226', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (9, 'fb5207b951cfff681b3e5eef2e63ec767cb009a4e4ec2502b7155c18074bca61', 'd2dbfc046d567c112851d69e16df86462bfc223ee3c9fddf0ec191f43491e4aa', 0, 0, '2019-01-31 17:31:12', '2019-01-31 17:31:09.030594', '2019-01-31 17:31:09.030594', 38654705664, 15, 1000000000000000000, 2100, 100, 100000000, 10000, 10, 'AAAACtLb/ARtVnwRKFHWnhbfhkYr/CI+48n93w7BkfQ0keSqtvEsj48eZMSqyQAtmIRyrrlDQ3Gyto587XYZlp/PnWwAAAAAXFMw4AAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERnVg7ETUj+cmbsqHUBMQAxZYLoIN3r2GvHvfaqNkLyKawAAAAkN4Lazp2QAAAAAAAAAAAg0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
+INSERT INTO history_ledgers VALUES (8, 'd2dbfc046d567c112851d69e16df86462bfc223ee3c9fddf0ec191f43491e4aa', '492cd987df7d8330a208485b72a9f689a5d38799fbd813da3b53703efb65139e', 2, 2, '2019-01-31 17:31:11', '2019-01-31 17:31:09.039475', '2019-01-31 17:31:09.039475', 34359738368, 15, 1000000000000000000, 2100, 100, 100000000, 10000, 10, 'AAAACkks2YfffYMwoghIW3Kp9oml04eZ+9gT2jtTcD77ZROe7u4UaZfkKQCzRgHN6/1fBnK/DDDPV0EwiUm6JyvBlWcAAAAAXFMw3wAAAAAAAAAAIFcEvXLqTKqnn4ZU/O/25YWxOdUJ85OkZ+D/6OUKuebVg7ETUj+cmbsqHUBMQAxZYLoIN3r2GvHvfaqNkLyKawAAAAgN4Lazp2QAAAAAAAAAAAg0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (7, '492cd987df7d8330a208485b72a9f689a5d38799fbd813da3b53703efb65139e', 'cf0eee9c48ecbbd513dfe15dd0217e587310483b58a5a2d8606dbf631d2beca6', 2, 2, '2019-01-31 17:31:10', '2019-01-31 17:31:09.066286', '2019-01-31 17:31:09.066286', 30064771072, 15, 1000000000000000000, 1900, 100, 100000000, 10000, 10, 'AAAACs8O7pxI7LvVE9/hXdAhflhzEEg7WKWi2GBtv2MdK+ymLkDcz8qvMmAU5Xn9THO/3w4rmu80QMbGJ2yJXwFh5xcAAAAAXFMw3gAAAAAAAAAAp6X4a1fQWRN1fT4Oha1VAb23rxjrafhg/8wjyOPQ4el4N31ge+2KodcF5a59vYuRjQEuoeCFDASBG31UpAKCyQAAAAcN4Lazp2QAAAAAAAAAAAdsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (6, 'cf0eee9c48ecbbd513dfe15dd0217e587310483b58a5a2d8606dbf631d2beca6', '577acb98a43bfaf17365edc9bca1a4a3b9f62119729c278445a4542ea538493e', 4, 4, '2019-01-31 17:31:09', '2019-01-31 17:31:09.078505', '2019-01-31 17:31:09.078505', 25769803776, 15, 1000000000000000000, 1700, 100, 100000000, 10000, 10, 'AAAACld6y5ikO/rxc2XtybyhpKO59iEZcpwnhEWkVC6lOEk+diGe+Hqc+/58hxmJs9GmnxNHkEUomTilix12AhKsWxIAAAAAXFMw3QAAAAAAAAAABfxQX88Y3BtlGniNGV3IwuGKHnvuXPcncSX+LhVbCbKzETnjomxnM5VVggq5b1St/qfoDymXNP0fCnwxn2XJ7wAAAAYN4Lazp2QAAAAAAAAAAAakAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (5, '577acb98a43bfaf17365edc9bca1a4a3b9f62119729c278445a4542ea538493e', 'cce5705ed44b70e65afcacbd19fa88626fdc47a5424def46a3abd41caf791ea2', 3, 3, '2019-01-31 17:31:08', '2019-01-31 17:31:09.093685', '2019-01-31 17:31:09.093685', 21474836480, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACszlcF7US3DmWvysvRn6iGJv3EelQk3vRqOr1ByveR6izmaDnqa3qHoH57coP0GackmyTCU8h4GVoGqeLx6SUGcAAAAAXFMw3AAAAAAAAAAAAvTWoAZ8sb3n9zINDA1hqoanXiN/pcjfEtKLDrzVvDPbpmBSNgpvamJJTRCorTK25NOHSHs5jMHMrm9HCdj1+QAAAAUN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (4, 'cce5705ed44b70e65afcacbd19fa88626fdc47a5424def46a3abd41caf791ea2', 'cf4741459c2de71471da66e60d8fb02aa6f104b68e527cf811e0ad0a431eae87', 4, 4, '2019-01-31 17:31:07', '2019-01-31 17:31:09.109237', '2019-01-31 17:31:09.109237', 17179869184, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACs9HQUWcLecUcdpm5g2PsCqm8QS2jlJ8+BHgrQpDHq6HTioA1lbEvCNwo5qDNpxko2HZ8wDONeW7YAu2b1bBNPsAAAAAXFMw2wAAAAAAAAAAatfPJ9QT3rB/dYPn9PCnBx/3L+i6/hPHmNhSU5EW/mndAELFQ/T5G+cw7BCn1PJwi31BZgH7MuejEgdQxxJuvQAAAAQN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (3, 'cf4741459c2de71471da66e60d8fb02aa6f104b68e527cf811e0ad0a431eae87', '100e6e8bad7f66467db7f2f3aa072aed45f789550d6ac33c335063d6862a48fe', 3, 3, '2019-01-31 17:31:06', '2019-01-31 17:31:09.123005', '2019-01-31 17:31:09.123005', 12884901888, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAAChAOboutf2ZGfbfy86oHKu1F94lVDWrDPDNQY9aGKkj+nlwnddHa7/38u31PxWf/JgsUUdzTF1uOj/OmhY1gWjcAAAAAXFMw2gAAAAAAAAAAz4e0cNoSrSyJbiU9ww4Xz/C47nFVIBEHkR11kgme+Dy76GAUV5Afh+w64l628RxE4K9UyT5wbks55EP9g1kMvQAAAAMN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (2, '100e6e8bad7f66467db7f2f3aa072aed45f789550d6ac33c335063d6862a48fe', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 3, 3, '2019-01-31 17:31:05', '2019-01-31 17:31:09.134281', '2019-01-31 17:31:09.134281', 8589934592, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76Z9GwX6q8KzxXUDk3+fdZshszCWX2SbuAnJNMiSoulCykAAAAAXFMw2QAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAACyk/eWGAYEOhla9SxZHjkxGIQ61pIijmPF9hVp1Qv5rM3994NzVGUEstpSkpCIvCg0ArBNhvBZ2713Y2Pc8QigAAAAIN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:31:09.148226', '2019-01-31 17:31:09.148226', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -820,6 +891,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -855,6 +933,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -496,6 +561,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -720,68 +791,68 @@ INSERT INTO history_effects VALUES (25, 12884905985, 3, 10, '{"weight": 1, "publ
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (62, 'e29dfb3e9072491f31a63c557005e74223f48d04e9f09ab5427abbb2ef81ecc5', 'bb330f4e1d2febc493b814acd97b4de49e8ac3bda0279c9c556796da3233adf0', 0, 0, '2019-01-31 17:29:59', '2019-01-31 17:29:03.983776', '2019-01-31 17:29:03.983776', 266287972352, 15, 1000190721000000000, 30471289, 100, 100000000, 10000, 10, 'AAAACrszD04dL+vEk7gUrNl7TeSeisO9oCecnFVnltoyM63weR4XYqRkgZLjmSy2sAf6GPspIdxz+SdxTCZJl86yM6QAAAAAXFMwlwAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERmoBG7eJMmtrTKZ11cdP127KU3UMOMft2S27C5Rlu7Y3gAAAD4N4WQpWNjKAAAAAAAB0PR5AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
-INSERT INTO history_ledgers VALUES (61, 'bb330f4e1d2febc493b814acd97b4de49e8ac3bda0279c9c556796da3233adf0', 'eaf81958d1cf626bfbbb5955facf7a3349fed12f9344089646a4e358923840c5', 1, 1, '2019-01-31 17:29:58', '2019-01-31 17:29:03.995506', '2019-01-31 17:29:03.995506', 261993005056, 15, 1000190721000000000, 30471289, 100, 100000000, 10000, 10, 'AAAACur4GVjRz2Jr+7tZVfrPejNJ/tEvk0QIlkak41iSOEDFw02HFhuj7+f9o4JyPo26OjVHSKDr1hbrQ2XmNZ8ALQQAAAAAXFMwlgAAAAAAAAAAosEhvRTCvadI/J9r+8kB+lYA6OphTjHH/5o2+iklI4MG5NmpFMJup2tGIwI7GKSL9DdZSuW+qCQfnhcHHfkxVQAAAD0N4WQpWNjKAAAAAAAB0PR5AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (60, 'eaf81958d1cf626bfbbb5955facf7a3349fed12f9344089646a4e358923840c5', '30cb871673e5fb38797225811f201be5ca6e50e471b1346827ff9d211a18945d', 1, 1, '2019-01-31 17:29:57', '2019-01-31 17:29:04.010898', '2019-01-31 17:29:04.010899', 257698037760, 15, 1000190721000000000, 30471189, 100, 100000000, 10000, 10, 'AAAACjDLhxZz5fs4eXIlgR8gG+XKblDkcbE0aCf/nSEaGJRdBZr0MrbfEIJUbFOdp/DcGDUQvU8k18Yr8SJr0tikdlwAAAAAXFMwlQAAAAAAAAAAZB2X7lcCb772lP7QltIwf7Af+ESisKsoFpU1cBZvSc6sPFkpiB0n83mzfSTbeHCA4XTel4zA7bb43cmk5lnPXAAAADwN4WQpWNjKAAAAAAAB0PQVAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (59, '30cb871673e5fb38797225811f201be5ca6e50e471b1346827ff9d211a18945d', '1b927d32f311a6e6936c92c82a1d2440f98ccd1d083387b3365139b30f3c38e8', 1, 1, '2019-01-31 17:29:56', '2019-01-31 17:29:04.023113', '2019-01-31 17:29:04.023114', 253403070464, 15, 1000190721000000000, 30471089, 100, 100000000, 10000, 10, 'AAAAChuSfTLzEabmk2ySyCodJED5jM0dCDOHszZRObMPPDjoik41HURLjfvKZWPEVjiLtLV9FAYUopWbgSrG3gGfonUAAAAAXFMwlAAAAAAAAAAAlNMwBDUEqtiETmU+aulkMrDuVtBffL8sVeqm0xm+yFx7mCSFDxZZ+1KrGdi5iJyH5u3H149+kkPM/PYG6SXLHQAAADsN4WQpWNjKAAAAAAAB0POxAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (58, '1b927d32f311a6e6936c92c82a1d2440f98ccd1d083387b3365139b30f3c38e8', 'b3f0831670b56e7897ace9ddd3aa402d387c5ae05d393b104bfc2fadf8b227af', 1, 1, '2019-01-31 17:29:55', '2019-01-31 17:29:04.031567', '2019-01-31 17:29:04.031567', 249108103168, 15, 1000190721000000000, 30470989, 100, 100000000, 10000, 10, 'AAAACrPwgxZwtW54l6zp3dOqQC04fFrgXTk7EEv8L634sievNsH23Xq5NzZsutYb3znlI0cJSb7Ik80vwTZLY5z38wsAAAAAXFMwkwAAAAAAAAAAGMfQBF/YqYedEUNnQuilzukzLJPeVrR9I6q3baNG83n79OYYOIpTtcWPRv4spitKYNMp88WLKO0IjcjzhWjqAwAAADoN4WQpWNjKAAAAAAAB0PNNAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (57, 'b3f0831670b56e7897ace9ddd3aa402d387c5ae05d393b104bfc2fadf8b227af', 'fb695cbeb52bec7d68bdf2bf4b8f2846d80e9898b62652e20f10658cc555a72a', 1, 1, '2019-01-31 17:29:54', '2019-01-31 17:29:04.043719', '2019-01-31 17:29:04.043719', 244813135872, 15, 1000190721000000000, 30470889, 100, 100000000, 10000, 10, 'AAAACvtpXL61K+x9aL3yv0uPKEbYDpiYtiZS4g8QZYzFVacqWYtYn2ILmdky+PaDnTCEPeurM6E3KE6uCan4S9BSXrMAAAAAXFMwkgAAAAAAAAAA14sFIRujWwg9KpW3xcPTBAGeuB6CsnSMTpP8SjSsd9rnlsVmPmb0ZDYXMm3UrYn0yfRg33mtpYgCPlictMjExwAAADkN4WQpWNjKAAAAAAAB0PLpAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (56, 'fb695cbeb52bec7d68bdf2bf4b8f2846d80e9898b62652e20f10658cc555a72a', '91445371cdbbcf6813c2b37de1e110e1cba08187d763038a7d23dbc226752f86', 1, 1, '2019-01-31 17:29:53', '2019-01-31 17:29:04.053573', '2019-01-31 17:29:04.053573', 240518168576, 15, 1000190721000000000, 30470789, 100, 100000000, 10000, 10, 'AAAACpFEU3HNu89oE8KzfeHhEOHLoIGH12MDin0j28ImdS+GA6rlBKjDTqRHyGKF6uTLMTO1TAsUWvQY0Mh4hUMDS10AAAAAXFMwkQAAAAAAAAAAudvIKbdFQO6cnqBlfAQ0qwiv8T/bow/WPTIANo/ERRHkvt5RuqU9ipoz05wEjENdl9eCNlE1PqnKTY1N6LCd1AAAADgN4WQpWNjKAAAAAAAB0PKFAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (55, '91445371cdbbcf6813c2b37de1e110e1cba08187d763038a7d23dbc226752f86', '944626c10bd9ad9794790f1b3da7c6317347e9555a7fe86bb0a832bfc05bd336', 1, 1, '2019-01-31 17:29:52', '2019-01-31 17:29:04.065435', '2019-01-31 17:29:04.065435', 236223201280, 15, 1000190721000000000, 30470689, 100, 100000000, 10000, 10, 'AAAACpRGJsEL2a2XlHkPGz2nxjFzR+lVWn/oa7CoMr/AW9M2h8P5Ipwneu4dYxd6RMiC1pJvJEV9Mmf4zCodSCIJm1EAAAAAXFMwkAAAAAAAAAAAHOu0BR+25TDycY7dcNSyVck1nyZBCkABFZXwjkic52SWsZzHw8QzlzYVnaMtZMEPp+f3DvUFqiRU2sMj29djZQAAADcN4WQpWNjKAAAAAAAB0PIhAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (54, '944626c10bd9ad9794790f1b3da7c6317347e9555a7fe86bb0a832bfc05bd336', '82e0716d816279bd461d62bfdc2c2360f3eecd4bcace6b75a802e2b3d6ce81f3', 1, 2, '2019-01-31 17:29:51', '2019-01-31 17:29:04.077098', '2019-01-31 17:29:04.077098', 231928233984, 15, 1000190721000000000, 30470589, 100, 100000000, 10000, 10, 'AAAACoLgcW2BYnm9Rh1iv9wsI2Dz7s1Lys5rdagC4rPWzoHzGDOE/g/jhJgmcq6uoMzNiX2k13lRYuJiOo0meZKXgTsAAAAAXFMwjwAAAAAAAAAAfXVfPS9jBqhTfz4LivYYP9btnu+qwf/qpVYL7HgbAM3wAf2MRXw7MGr0Cu+W9gryrmpuDh6Hif0lFBuL3VpVigAAADYN4WQpWNjKAAAAAAAB0PG9AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (53, '82e0716d816279bd461d62bfdc2c2360f3eecd4bcace6b75a802e2b3d6ce81f3', 'e97d1788364ded66561abb2fea08facf0a5f79605f631910d8a23c8303bcfcad', 1, 1, '2019-01-31 17:29:50', '2019-01-31 17:29:04.092374', '2019-01-31 17:29:04.092374', 227633266688, 15, 1000190721000000000, 30470389, 100, 100000000, 10000, 10, 'AAAACul9F4g2Te1mVhq7L+oI+s8KX3lgX2MZENiiPIMDvPytqcMqLH/T2xLT4Dz6UBRA7xjhXfuc9c7Gt9QaGCYR7JEAAAAAXFMwjgAAAAAAAAAAY80asxoHVId70xk95LhsRNki3uSYhgJrvMpz6Qe35wTJ8zZ352f/ae5OMZIs/0zd2qRk3wPhJ1M/2L6jaPRyFwAAADUN4WQpWNjKAAAAAAAB0PD1AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (52, 'e97d1788364ded66561abb2fea08facf0a5f79605f631910d8a23c8303bcfcad', 'ee8902403c7ffe1e80058040479153d3fcbfc1a434cb96fb8d64ee468b977447', 1, 1, '2019-01-31 17:29:49', '2019-01-31 17:29:04.102817', '2019-01-31 17:29:04.102817', 223338299392, 15, 1000190721000000000, 30470289, 100, 100000000, 10000, 10, 'AAAACu6JAkA8f/4egAWAQEeRU9P8v8GkNMuW+41k7kaLl3RHplYjoVEASCJMrSSsZpxI0BM47kEncFGrZ/ZeKtT6Uw8AAAAAXFMwjQAAAAAAAAAAdFs0uvdku2e14ZLZNY/inkD0IgG9m+9h08GbknZs9Ew9Dz367+ylzQdtWqZQqKJyEYjKN3TFlYfWANTtLDafeQAAADQN4WQpWNjKAAAAAAAB0PCRAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (51, 'ee8902403c7ffe1e80058040479153d3fcbfc1a434cb96fb8d64ee468b977447', '9b45adcf0c693010f1dd631501f37b553f8b7b06931c2c812cfb60b6e09dd524', 1, 1, '2019-01-31 17:29:48', '2019-01-31 17:29:04.116068', '2019-01-31 17:29:04.116068', 219043332096, 15, 1000190721000000000, 30470189, 100, 100000000, 10000, 10, 'AAAACptFrc8MaTAQ8d1jFQHze1U/i3sGkxwsgSz7YLbgndUkBwCTazY3htEXt/s4YCc/xQZNsFn04txBwXc1J1eLg5AAAAAAXFMwjAAAAAAAAAAAG9Lveo8KOMBsuTXxxarafYQzHKlqwhXWtcRjpmTOc+NtnsnOMq3xzPWYnt2RlzLlSpTmte9gOc71p1KTFk8seQAAADMN4WQpWNjKAAAAAAAB0PAtAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (50, '9b45adcf0c693010f1dd631501f37b553f8b7b06931c2c812cfb60b6e09dd524', 'f2be8be845deac1e6a3585e42e39e24d2904e4128ce2f2e0d8af483c81201491', 1, 1, '2019-01-31 17:29:47', '2019-01-31 17:29:04.126783', '2019-01-31 17:29:04.126783', 214748364800, 15, 1000190721000000000, 30470089, 100, 100000000, 10000, 10, 'AAAACvK+i+hF3qweajWF5C454k0pBOQSjOLy4NivSDyBIBSRwqpei8jPs8iLeqfNMllYgaEr0Q8MNjFoS4G/9qrjwwIAAAAAXFMwiwAAAAAAAAAAe2zMUWV2ofxxSJUd0hgEpW+dk+M503UNhsqXLKL0TMiQZRGarp+57CD0ZZrJa2AvqpuvqOQEpJgdy7W42NYKowAAADIN4WQpWNjKAAAAAAAB0O/JAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (49, 'f2be8be845deac1e6a3585e42e39e24d2904e4128ce2f2e0d8af483c81201491', '4289421b2c60c4c8a3fc23ecada51df135b765c804749dd3a6ad6dc8ed3c6838', 3, 3, '2019-01-31 17:29:46', '2019-01-31 17:29:04.138663', '2019-01-31 17:29:04.138663', 210453397504, 15, 1000190721000000000, 30469989, 100, 100000000, 10000, 10, 'AAAACkKJQhssYMTIo/wj7K2lHfE1t2XIBHSd06atbcjtPGg4Iu2Ka8xk/8QgNAOBBLuIe+GOWVMOKLyTbiyPshCfzCwAAAAAXFMwigAAAAAAAAAAsSOLB+Fh7PI1BdmA+nlx5DdeXSAZY7OnyHcuyeMEmJBKqB8ytIVSPolkkQQGJnlbtyIJAVeJ+69yi/nTzAXkmQAAADEN4WQpWNjKAAAAAAAB0O9lAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (48, '4289421b2c60c4c8a3fc23ecada51df135b765c804749dd3a6ad6dc8ed3c6838', '69cf4f93a5819ccb94cd4b109f98267f55a4bdbeef59a295e5a51b30dec4ee63', 1, 1, '2019-01-31 17:29:45', '2019-01-31 17:29:04.150214', '2019-01-31 17:29:04.150214', 206158430208, 15, 1000190721000000000, 30469689, 100, 100000000, 10000, 10, 'AAAACmnPT5OlgZzLlM1LEJ+YJn9VpL2+71mileWlGzDexO5jf8BepAzjPQZy4Bw5Sh6dtVObs2tyAAx3kN/NbKElX5oAAAAAXFMwiQAAAAAAAAAAQNg4cr4Do7quX33o4rbaXa7+BDdHc8PbTEXBcuQETP9o1ioM58np0brN2QdhJyiPsLyzcRbj2LfI3Ku15PCowgAAADAN4WQpWNjKAAAAAAAB0O45AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (47, '69cf4f93a5819ccb94cd4b109f98267f55a4bdbeef59a295e5a51b30dec4ee63', '89b05992c4a46617e18ad8d24c48b5fdf57e8eb204d5dba28e35455347f7753d', 1, 1, '2019-01-31 17:29:44', '2019-01-31 17:29:04.161881', '2019-01-31 17:29:04.161882', 201863462912, 15, 1000190721000000000, 30469589, 100, 100000000, 10000, 10, 'AAAAComwWZLEpGYX4YrY0kxItf31fo6yBNXboo41RVNH93U95XqBirfNR5HfmsP2PS7+5Zo0JPehiE7L+Jeo6I6ZqBQAAAAAXFMwiAAAAAAAAAAA8OM7CNjloxWqwlaanmBarRzmaEAjS1RTT7DMBUh/OHjVkKu4jTBeEWwnKkwlXY108mY/4lU1migZAqZ04S1bPQAAAC8N4WQpWNjKAAAAAAAB0O3VAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (46, '89b05992c4a46617e18ad8d24c48b5fdf57e8eb204d5dba28e35455347f7753d', '805ca55e4fbe96e2a75e9a4f25faf3da3f2815d78069527bfa4ab15cb4be248d', 2, 2, '2019-01-31 17:29:43', '2019-01-31 17:29:04.177521', '2019-01-31 17:29:04.177521', 197568495616, 15, 1000000000000000000, 7000, 100, 100000000, 10000, 10, 'AAAACoBcpV5Pvpbip16aTyX689o/KBXXgGlSe/pKsVy0viSNp+8SMomgo4PglMM3U4vQIQRMvceXX738nQlZt4JFe+QAAAAAXFMwhwAAAAAAAAAAknH58hxTmq2NI6laxFq7xN4R3r99jE86ydmH1AhFvM8zyXV+eC6WxOvCapZhe5+lry0wSB1tkukYPbH2Xr+21gAAAC4N4Lazp2QAAAAAAAAAABtYAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (45, '805ca55e4fbe96e2a75e9a4f25faf3da3f2815d78069527bfa4ab15cb4be248d', 'fee6a6acc255cb92b6ed0e3b23d91c8c150f6542360e59988ff007df5b91e3e4', 1, 1, '2019-01-31 17:29:42', '2019-01-31 17:29:04.190449', '2019-01-31 17:29:04.190449', 193273528320, 15, 1000000000000000000, 6800, 100, 100000000, 10000, 10, 'AAAACv7mpqzCVcuStu0OOyPZHIwVD2VCNg5ZmI/wB99bkePkcVHaP6Lds7hPJqFCLQ6LD7tXrY4VyckM9Y4XbnGWYxsAAAAAXFMwhgAAAAAAAAAA2s+Yd36GUBmFrCn8nxzR3G7G6BlWwDjWIvSuQxGMICZxKWV2XqoeGCMh5hPkRMVvlv2shKKe034dZR2M6VJW2QAAAC0N4Lazp2QAAAAAAAAAABqQAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (44, 'fee6a6acc255cb92b6ed0e3b23d91c8c150f6542360e59988ff007df5b91e3e4', '77fc20eff1585cfceda025315b8b7fdf66a5bc5ecaa1f9fd96a0f7728355ae21', 1, 1, '2019-01-31 17:29:41', '2019-01-31 17:29:04.201984', '2019-01-31 17:29:04.201984', 188978561024, 15, 1000000000000000000, 6700, 100, 100000000, 10000, 10, 'AAAACnf8IO/xWFz87aAlMVuLf99mpbxeyqH5/Zag93KDVa4hDF030boKIh4x7g+omRiWrQmMQKJWNsU9rvJlnkQvh3YAAAAAXFMwhQAAAAAAAAAAWWc1pYhVWRoEJa6GcHIsaj1ysxI4CQ+BcJqiGW3wjmKOGzUNaELfaejF7S25wfQfxGYrDS8a/wX+/cYnSiOhEgAAACwN4Lazp2QAAAAAAAAAABosAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (43, '77fc20eff1585cfceda025315b8b7fdf66a5bc5ecaa1f9fd96a0f7728355ae21', '0710c8986d0e9a2553c2e60a4ec22aa22962773730088c31d00c28f1d68732dc', 1, 1, '2019-01-31 17:29:40', '2019-01-31 17:29:04.214015', '2019-01-31 17:29:04.214015', 184683593728, 15, 1000000000000000000, 6600, 100, 100000000, 10000, 10, 'AAAACgcQyJhtDpolU8LmCk7CKqIpYnc3MAiMMdAMKPHWhzLc3CfPLrEUEHfssgU4S+MNUMNUQRc9kYYq0qaeOhQscCsAAAAAXFMwhAAAAAAAAAAA9L2wqBam8xx6EMlQ1e/R7JPhksJqFoZDmKFcFBwf3xBI/cfXfnwzJZ/HdRmbcVi6cYNJJ731n3uCAOzRTMEIoQAAACsN4Lazp2QAAAAAAAAAABnIAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (42, '0710c8986d0e9a2553c2e60a4ec22aa22962773730088c31d00c28f1d68732dc', '91c99e36622f0eee49dc223ef52d92f1fd748b0a4f8d6dd0a7e9ad08f9f702d2', 1, 1, '2019-01-31 17:29:39', '2019-01-31 17:29:04.225669', '2019-01-31 17:29:04.225669', 180388626432, 15, 1000000000000000000, 6500, 100, 100000000, 10000, 10, 'AAAACpHJnjZiLw7uSdwiPvUtkvH9dIsKT41t0KfprQj59wLScz5GVG1SLFrfBMb0CqmMEgQDMPYkTKJs4PS3W2cR94EAAAAAXFMwgwAAAAAAAAAA4mQxuIyGkMnUNprxo1u6b3NOKgS/CqRR0k9oMQPLWs9hxBMflYcolj/zpJChYZZE5y45FM0bYdE3S6roRU61egAAACoN4Lazp2QAAAAAAAAAABlkAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (41, '91c99e36622f0eee49dc223ef52d92f1fd748b0a4f8d6dd0a7e9ad08f9f702d2', '1be9a8c90eed75b05ecfe7f9a7dd79c762f5276bcd594f3a39e049be733dec56', 2, 2, '2019-01-31 17:29:38', '2019-01-31 17:29:04.242548', '2019-01-31 17:29:04.242548', 176093659136, 15, 1000000000000000000, 6400, 100, 100000000, 10000, 10, 'AAAAChvpqMkO7XWwXs/n+afdecdi9SdrzVlPOjngSb5zPexW833pVGBaiKEWHECVt/UkxYXp0VN9tmjlndkJv6YxwycAAAAAXFMwggAAAAAAAAAA3texHWkeXlsh8yl52JzE1M7p/8uzk36DdlbvwdqWw1b+t9dvaHiev5CwibvIX2SYyfy00bLcAI+FdiSA4sL4sAAAACkN4Lazp2QAAAAAAAAAABkAAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (40, '1be9a8c90eed75b05ecfe7f9a7dd79c762f5276bcd594f3a39e049be733dec56', 'f5f2d37f543e32a0193592f1e2484329f75e57ecc152d3c184b4421b6a8c4639', 2, 2, '2019-01-31 17:29:37', '2019-01-31 17:29:04.25614', '2019-01-31 17:29:04.25614', 171798691840, 15, 1000000000000000000, 6200, 100, 100000000, 10000, 10, 'AAAACvXy039UPjKgGTWS8eJIQyn3XlfswVLTwYS0QhtqjEY5PPBmjsNvdhy1MmFDyohJDPJFmpAu8dJ4OkdR7XYtLTsAAAAAXFMwgQAAAAAAAAAAWJlo97rfQjjINuAEkeCOb3Rwl6NYUk95Xa63Nc0UCv1Vt1SNwDz26UP/gSFhSv9UMghrHWKneuVfiFdm/HcncwAAACgN4Lazp2QAAAAAAAAAABg4AAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (39, 'f5f2d37f543e32a0193592f1e2484329f75e57ecc152d3c184b4421b6a8c4639', 'ed838fcd2e06b136f5bd1e89a14aeeb84a47095b75c8c71e09ef10737fe2ab48', 1, 1, '2019-01-31 17:29:36', '2019-01-31 17:29:04.267556', '2019-01-31 17:29:04.267556', 167503724544, 15, 1000000000000000000, 6000, 100, 100000000, 10000, 10, 'AAAACu2Dj80uBrE29b0eiaFK7rhKRwlbdcjHHgnvEHN/4qtItOoStYaQx/c7Oz9fg4z+cPzo3cp4ytW6NZ0vRb8sO0cAAAAAXFMwgAAAAAAAAAAASeHBruDgNPJb9kfcJVMyCg6meb0kov5dW9Ok8EEep2bEjKnO6zAb8Pq9Sf4xlwAaQlkD8LRRSlAP8QyFpqyJoQAAACcN4Lazp2QAAAAAAAAAABdwAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (38, 'ed838fcd2e06b136f5bd1e89a14aeeb84a47095b75c8c71e09ef10737fe2ab48', '1a9ed71ee00676d6c60df311458cab2cae11aa118b34189588c174b7ae3cb8e8', 2, 2, '2019-01-31 17:29:35', '2019-01-31 17:29:04.279058', '2019-01-31 17:29:04.279058', 163208757248, 15, 1000000000000000000, 5900, 100, 100000000, 10000, 10, 'AAAAChqe1x7gBnbWxg3zEUWMqyyuEaoRizQYlYjBdLeuPLjogdqx/sDySYQ/GPorcClQW4Yuu5nnF7WzblY3tHJ46aMAAAAAXFMwfwAAAAAAAAAAN/+dCsXSVcipJLX5fSVl/dtD8pFe+iZnfnNZ2g7vXj9q1x567ScbX5S8V7Ef/Ms9bZWcFUWxd7/I05YCwIdpMAAAACYN4Lazp2QAAAAAAAAAABcMAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (37, '1a9ed71ee00676d6c60df311458cab2cae11aa118b34189588c174b7ae3cb8e8', '8df58d423f2f90dbaf839229ff18a24012eeefbb761366629a4174802bdaf79d', 1, 1, '2019-01-31 17:29:34', '2019-01-31 17:29:04.292172', '2019-01-31 17:29:04.292172', 158913789952, 15, 1000000000000000000, 5700, 100, 100000000, 10000, 10, 'AAAACo31jUI/L5Dbr4OSKf8YokAS7u+7dhNmYppBdIAr2vedbRFToReVifFtAU8ySp373L/KDPjizLeo1c6UqQ6zM2QAAAAAXFMwfgAAAAAAAAAAPORbuvHNNFfsSSmddqmUP8Fk8iW9Jb1yQL/uEyYGc2spEfRjs6m3YMklXpOfc9bUS2zW9UfcNul2fnYu9c+xlAAAACUN4Lazp2QAAAAAAAAAABZEAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (36, '8df58d423f2f90dbaf839229ff18a24012eeefbb761366629a4174802bdaf79d', '3ae4e8dcad8943f51ead0967029267062c96816ce9d63be70cff5d56458524d7', 1, 1, '2019-01-31 17:29:33', '2019-01-31 17:29:04.305689', '2019-01-31 17:29:04.305689', 154618822656, 15, 1000000000000000000, 5600, 100, 100000000, 10000, 10, 'AAAACjrk6NytiUP1Hq0JZwKSZwYsloFs6dY75wz/XVZFhSTX0pTA6akc4evGMwzvRh8u/xVEB5OjOvGOe/LeipTAR1cAAAAAXFMwfQAAAAAAAAAADx7u3Tt890DDCAY2PUo3lKiU3M3nylcrqmvMlFLS+fFXYCqepXoTdFVWcEh5IasGUiHNmhX7tY7NjMNgL50VSwAAACQN4Lazp2QAAAAAAAAAABXgAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (35, '3ae4e8dcad8943f51ead0967029267062c96816ce9d63be70cff5d56458524d7', 'f0d24de03f40504e6a8879fe93b62dda9e071a8b9aaf72b8f914a0072d82a9ef', 1, 1, '2019-01-31 17:29:32', '2019-01-31 17:29:04.319305', '2019-01-31 17:29:04.319305', 150323855360, 15, 1000000000000000000, 5500, 100, 100000000, 10000, 10, 'AAAACvDSTeA/QFBOaoh5/pO2LdqeBxqLmq9yuPkUoActgqnvryw83fiT/KOXu+Of8pSPyNQ8jxTrtKsGNFKr5Y0msaAAAAAAXFMwfAAAAAAAAAAAU3lWP9fTSJmRnN7PpNBaZaYziGh9Xk2I2lyAgYUdNsyNVeh2Yrx0urNNdLi7Dphou5VkFQqEipk4/Pv2z3V9MQAAACMN4Lazp2QAAAAAAAAAABV8AAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (34, 'f0d24de03f40504e6a8879fe93b62dda9e071a8b9aaf72b8f914a0072d82a9ef', '88bd90567480b997883ec331989685d9cf22548ab05e953eeb260f39c6352ffd', 1, 1, '2019-01-31 17:29:31', '2019-01-31 17:29:04.330534', '2019-01-31 17:29:04.330534', 146028888064, 15, 1000000000000000000, 5400, 100, 100000000, 10000, 10, 'AAAACoi9kFZ0gLmXiD7DMZiWhdnPIlSKsF6VPusmDznGNS/9pPBv8wqmgIa84Np2QhUuE38X6074vi1bz5zQ3P/YzC0AAAAAXFMwewAAAAAAAAAA/bE+PeQRbcMvSIfQyaXSWXEgzB7abwreNVQeYYIhwiZrIuDJEkJdONeX0e/6N49lrKsBeaaad/yWn5+uOQDf3AAAACIN4Lazp2QAAAAAAAAAABUYAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (33, '88bd90567480b997883ec331989685d9cf22548ab05e953eeb260f39c6352ffd', 'b50907cdd0ba62e7131aceae6a8627d93e6f2af6cfed82b5806922df1246baed', 1, 1, '2019-01-31 17:29:30', '2019-01-31 17:29:04.341559', '2019-01-31 17:29:04.341559', 141733920768, 15, 1000000000000000000, 5300, 100, 100000000, 10000, 10, 'AAAACrUJB83QumLnExrOrmqGJ9k+byr2z+2CtYBpIt8SRrrtEZFxxkx/w1p2BZj5q/RB3jm/9lJY5exACa0VwoZIfoUAAAAAXFMwegAAAAAAAAAAHdP8tBBtpeyL899tLPnNdaqUoHRWmHKjK8mobr412wHoc7IPiJuKqoc6k71utc4gm+FmXIYBEAL9uXN8pioVggAAACEN4Lazp2QAAAAAAAAAABS0AAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (32, 'b50907cdd0ba62e7131aceae6a8627d93e6f2af6cfed82b5806922df1246baed', '62c27d096a031cf54558fe895c67b2f40b6f2cf76bf3fe23b4f48b3e3ad99008', 2, 2, '2019-01-31 17:29:29', '2019-01-31 17:29:04.352258', '2019-01-31 17:29:04.352258', 137438953472, 15, 1000000000000000000, 5200, 100, 100000000, 10000, 10, 'AAAACmLCfQlqAxz1RVj+iVxnsvQLbyz3a/P+I7T0iz462ZAI21EpOgFp07vwaEqnz3bfQM1mvmBRexRzM2TS6eCbj8YAAAAAXFMweQAAAAAAAAAA2zD57d2oUw2L6murtm/oe2Dj6FuzBXnVm7l7HoDsMUzQbg2iQtwlp5ptQc+D7wDHUUYl6HvdlHbtqdZ7TdAMIQAAACAN4Lazp2QAAAAAAAAAABRQAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (31, '62c27d096a031cf54558fe895c67b2f40b6f2cf76bf3fe23b4f48b3e3ad99008', '6a82f4b09ca89fc5c61bcaca9f21d2c9da91bc62808f413a3576f5dd861721ca', 1, 1, '2019-01-31 17:29:28', '2019-01-31 17:29:04.365383', '2019-01-31 17:29:04.365396', 133143986176, 15, 1000000000000000000, 5000, 100, 100000000, 10000, 10, 'AAAACmqC9LCcqJ/FxhvKyp8h0snakbxigI9BOjV29d2GFyHKCXOeWc+kkQCpcCWsbUL/NUjnWVGUtsfzsZncwgCROOYAAAAAXFMweAAAAAAAAAAA8GmZ2mhGWPCcWAd1pU9SBJXfejtD7FOEoLD6oFsTOv7w2mdOL4Pqeiq4/RSDbSISGZL7mQ0kh0gUxkz4E334lgAAAB8N4Lazp2QAAAAAAAAAABOIAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (30, '6a82f4b09ca89fc5c61bcaca9f21d2c9da91bc62808f413a3576f5dd861721ca', 'f14dec2d7e3f6e1691456c19fc6250e8429b0a3cb542a9ea53fdc9d88052ae1f', 1, 1, '2019-01-31 17:29:27', '2019-01-31 17:29:04.378653', '2019-01-31 17:29:04.378653', 128849018880, 15, 1000000000000000000, 4900, 100, 100000000, 10000, 10, 'AAAACvFN7C1+P24WkUVsGfxiUOhCmwo8tUKp6lP9ydiAUq4fmW8nbDaj+lBH0TcUAKVhLLPs77MjBKTK5MWmRZb1P0gAAAAAXFMwdwAAAAAAAAAA1jXLaoK/IOqKNVkib2cNLrjlETHXpZlcJ4BFYO8bM3kB0r3NIVxepWnij1maD1zfpfEaZfbR7CX+zCo1FkwSwwAAAB4N4Lazp2QAAAAAAAAAABMkAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (29, 'f14dec2d7e3f6e1691456c19fc6250e8429b0a3cb542a9ea53fdc9d88052ae1f', 'bc9484a9a5eec1bf62eec18b056f574b9829fa9fac741bd6aec882a599e9e579', 1, 1, '2019-01-31 17:29:26', '2019-01-31 17:29:04.39077', '2019-01-31 17:29:04.39077', 124554051584, 15, 1000000000000000000, 4800, 100, 100000000, 10000, 10, 'AAAACryUhKml7sG/Yu7BiwVvV0uYKfqfrHQb1q7IgqWZ6eV5+Qe/+rhl+r0yMSYdyTLWbMeZQWplJssiIJBTT9TkhN0AAAAAXFMwdgAAAAAAAAAAbaCD4RbfEqSrI+qpIyzzUU+bYYMJsRw3pHcmHwQrvaCvVPJLAqlYx+H0FROR9GFN/D32gyWcAl7YEeddO6UIWQAAAB0N4Lazp2QAAAAAAAAAABLAAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (28, 'bc9484a9a5eec1bf62eec18b056f574b9829fa9fac741bd6aec882a599e9e579', 'b22e58e9911640efafb2d567c68c6e34f238bc04e4e363e1276f48f2081fbe80', 7, 7, '2019-01-31 17:29:25', '2019-01-31 17:29:04.401189', '2019-01-31 17:29:04.40119', 120259084288, 15, 1000000000000000000, 4700, 100, 100000000, 10000, 10, 'AAAACrIuWOmRFkDvr7LVZ8aMbjTyOLwE5ONj4SdvSPIIH76A8LqYhuLgsMJnbcU9VVm8KWSphDfjn0pRkt7s5rE3VnAAAAAAXFMwdQAAAAAAAAAAdQ/T4yJNKn9hiJoTuxDsNbd9ibRWf2hNHJhsiqBKWD2XevldOglTO7mUsnfyxmceaVImkvr0m6z2Z1L9FBdkvgAAABwN4Lazp2QAAAAAAAAAABJcAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 7, 0);
-INSERT INTO history_ledgers VALUES (27, 'b22e58e9911640efafb2d567c68c6e34f238bc04e4e363e1276f48f2081fbe80', '0c929cc3fc329a8c8d2432037782eb347f5913b4c98557ccddc5bdcd68982556', 1, 1, '2019-01-31 17:29:24', '2019-01-31 17:29:04.413602', '2019-01-31 17:29:04.413603', 115964116992, 15, 1000000000000000000, 4000, 100, 100000000, 10000, 10, 'AAAACgySnMP8MpqMjSQyA3eC6zR/WRO0yYVXzN3Fvc1omCVWxcImbTTloVbrBZbrnn02GV5ro2RXH1XBfl3tX52+7ycAAAAAXFMwdAAAAAAAAAAAkpeXdNXfmh1EnUjCYIMebdzV8PlgCe6J6eJq8Mb4SXZ5sxnRTSAUOspIkLs7Ux9bUfgF0YpmkzDB5NX4FUUlbgAAABsN4Lazp2QAAAAAAAAAAA+gAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (26, '0c929cc3fc329a8c8d2432037782eb347f5913b4c98557ccddc5bdcd68982556', '56c7b20fa5c7d650992072bef0d1aa9825b35853a2153016464b55d183452476', 2, 2, '2019-01-31 17:29:23', '2019-01-31 17:29:04.42483', '2019-01-31 17:29:04.42483', 111669149696, 15, 1000000000000000000, 3900, 100, 100000000, 10000, 10, 'AAAAClbHsg+lx9ZQmSByvvDRqpgls1hTohUwFkZLVdGDRSR2DXfRAXVGP//txFOEYxRaywe6IB6iFYnWiE9xAnMhMK8AAAAAXFMwcwAAAAAAAAAAGTKcjd5tsclF3P+ptDiMdUWp83tuYF+zlADp+X+gt+f3OiR0qiyKHIVkljdbF3Nng3+X1XsZOBbdTRZgTpRcnwAAABoN4Lazp2QAAAAAAAAAAA88AAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (25, '56c7b20fa5c7d650992072bef0d1aa9825b35853a2153016464b55d183452476', '63d34b38f0fc54920bdbb8e76f3bd766a4eb9ec413d7f3aa8e44dc3b71bb8424', 1, 1, '2019-01-31 17:29:22', '2019-01-31 17:29:04.437222', '2019-01-31 17:29:04.437222', 107374182400, 15, 1000000000000000000, 3700, 100, 100000000, 10000, 10, 'AAAACmPTSzjw/FSSC9u4528712ak657EE9fzqo5E3Dtxu4QkQ+IdKnxOOyi5gFuhM0INafpmgU1YOXtSmdl8e2B44nQAAAAAXFMwcgAAAAAAAAAAM9gLVDvjwMuVZ+njRjvvMxlhodRg/VJpuapiCb0y9SAvFcBGeWlqHDItwSuwoDSwIsCjUGy65lgGrYtO0ttDEgAAABkN4Lazp2QAAAAAAAAAAA50AAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (24, '63d34b38f0fc54920bdbb8e76f3bd766a4eb9ec413d7f3aa8e44dc3b71bb8424', '95c903bcf12dd784595646cdee1ee4d7f22a17bf1165710fe4ecef90da13beb5', 1, 1, '2019-01-31 17:29:21', '2019-01-31 17:29:04.448212', '2019-01-31 17:29:04.448213', 103079215104, 15, 1000000000000000000, 3600, 100, 100000000, 10000, 10, 'AAAACpXJA7zxLdeEWVZGze4e5NfyKhe/EWVxD+Ts75DaE761r+pcUh2G76ycaOcTO6sy8Ia0Iv+iAKJ/Kwdbp1P7vjsAAAAAXFMwcQAAAAAAAAAA1ziHajubDrj1Iu5EQ8YZB24Czm29AJPdaJnsX3+A+fWbhUKafD7NeLtGcj8zHUucvsoVWHkCB5aSiuzQhKwWZgAAABgN4Lazp2QAAAAAAAAAAA4QAAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (23, '95c903bcf12dd784595646cdee1ee4d7f22a17bf1165710fe4ecef90da13beb5', '81b2a9c65c44006e87df8bbc001a10f92ec675189264b35fadb9fc348a738c42', 1, 1, '2019-01-31 17:29:20', '2019-01-31 17:29:04.466216', '2019-01-31 17:29:04.466216', 98784247808, 15, 1000000000000000000, 3500, 100, 100000000, 10000, 10, 'AAAACoGyqcZcRABuh9+LvAAaEPkuxnUYkmSzX625/DSKc4xCyNmUPvekwYdvguqOpRSIovSLopu40ugJI3W5ctUnsJUAAAAAXFMwcAAAAAAAAAAAeu6ZLz++eOyW3/HPY616v+oF2X756WCT8G93ApdHifqrwhvK7vXfbwAi0FVRArkI98wSgEQ2YdHxEvKzj5nljgAAABcN4Lazp2QAAAAAAAAAAA2sAAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (22, '81b2a9c65c44006e87df8bbc001a10f92ec675189264b35fadb9fc348a738c42', '8d0efb63d647408f9a0316f489baccb370c3bac19b3956b9f591dd2cbab0ff66', 1, 1, '2019-01-31 17:29:19', '2019-01-31 17:29:04.475204', '2019-01-31 17:29:04.475204', 94489280512, 15, 1000000000000000000, 3400, 100, 100000000, 10000, 10, 'AAAACo0O+2PWR0CPmgMW9Im6zLNww7rBmzlWufWR3Sy6sP9mwJitIO+K144Fdwp7PfLCLadhaqKuYGnPFHcqccPZL9oAAAAAXFMwbwAAAAAAAAAA0AMiyT4CyDLLvayS0AmP97hen9j1rkIsoYeN0iQrjuonYIKlor6Xdb06QM405D50Ip9qKTkFDNGw5iG+BOW2QAAAABYN4Lazp2QAAAAAAAAAAA1IAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (21, '8d0efb63d647408f9a0316f489baccb370c3bac19b3956b9f591dd2cbab0ff66', '7a8c4baeceecf5eb426cd32eba2a4cdef8bae5b86929af6d32ffbcaa1417179c', 2, 2, '2019-01-31 17:29:18', '2019-01-31 17:29:04.486026', '2019-01-31 17:29:04.486026', 90194313216, 15, 1000000000000000000, 3300, 100, 100000000, 10000, 10, 'AAAACnqMS67O7PXrQmzTLroqTN74uuW4aSmvbTL/vKoUFxecLZZvdO3s/3UJdTh50f5BBtH9sPUELRE22ZyuJSdDJbIAAAAAXFMwbgAAAAAAAAAA3LAnNjSwmLo474ojoHgysfkXmeeBIXdgWbyOJwbnsx/n/0KcqOjc4L+2Lf2Yuce1MdqhetM8pGLwmCdjHn1uYwAAABUN4Lazp2QAAAAAAAAAAAzkAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (20, '7a8c4baeceecf5eb426cd32eba2a4cdef8bae5b86929af6d32ffbcaa1417179c', 'f92d62b78a9ff6958f45b9d127685cc1739035220b90004ea6ea6049b5b6884b', 1, 1, '2019-01-31 17:29:17', '2019-01-31 17:29:04.498223', '2019-01-31 17:29:04.498223', 85899345920, 15, 1000000000000000000, 3100, 100, 100000000, 10000, 10, 'AAAACvktYreKn/aVj0W50SdoXMFzkDUiC5AATqbqYEm1tohLjqkOLAyOWWwrM+DAYlHI2x8dkV7cPQJ49T86uEC3NrMAAAAAXFMwbQAAAAAAAAAAx/hxaEW2XNJB3iDf23eYfGcwcQ862pSt7OJ6FF108IY7c81v/4T/c6lBZvLlsg/1fp6j5mLeB7FmEmVYCDq66AAAABQN4Lazp2QAAAAAAAAAAAwcAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (19, 'f92d62b78a9ff6958f45b9d127685cc1739035220b90004ea6ea6049b5b6884b', 'cfd3c9857bb27f0a9f212add9f6606ff2d58bfed5992c4ec648758bb653cb646', 1, 1, '2019-01-31 17:29:16', '2019-01-31 17:29:04.515313', '2019-01-31 17:29:04.515313', 81604378624, 15, 1000000000000000000, 3000, 100, 100000000, 10000, 10, 'AAAACs/TyYV7sn8KnyEq3Z9mBv8tWL/tWZLE7GSHWLtlPLZGmj39apih8WeL8GxrXPB8vWVSC6vYIsKR7SERgeaWOrEAAAAAXFMwbAAAAAAAAAAAYzC64fmWjZOtqE4JjsKqwerdnzN6ywnJSvdsDWFPcu04tESCEO43fHGUrWksnPnOmtk5wTEXI9IGwVa7PRguYwAAABMN4Lazp2QAAAAAAAAAAAu4AAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (18, 'cfd3c9857bb27f0a9f212add9f6606ff2d58bfed5992c4ec648758bb653cb646', 'd77ca83203978a9fb7a451f90dd4d83005ccaadcb7af55bd12a8f4e6176e98b8', 3, 3, '2019-01-31 17:29:15', '2019-01-31 17:29:04.532334', '2019-01-31 17:29:04.532334', 77309411328, 15, 1000000000000000000, 2900, 100, 100000000, 10000, 10, 'AAAACtd8qDIDl4qft6RR+Q3U2DAFzKrct69VvRKo9OYXbpi4WsLmcQ6q7yzhvC89kddyhhB2nq1Cqmlj3vTRAl3bI8cAAAAAXFMwawAAAAAAAAAANKxyAMoyM/gn59hBYzLxbU28Wbix3QHy7s086vpSY4eWePLnsINCvfVN5hUmVgPG+7xiiEFgx7DgedgJjedN2AAAABIN4Lazp2QAAAAAAAAAAAtUAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (17, 'd77ca83203978a9fb7a451f90dd4d83005ccaadcb7af55bd12a8f4e6176e98b8', '255e3e927ca815735905893e4edf2cb5939eaa8d8e25e6e2b023407bd8c4c5c8', 2, 2, '2019-01-31 17:29:14', '2019-01-31 17:29:04.543728', '2019-01-31 17:29:04.543728', 73014444032, 15, 1000000000000000000, 2600, 100, 100000000, 10000, 10, 'AAAACiVePpJ8qBVzWQWJPk7fLLWTnqqNjiXm4rAjQHvYxMXIkwKZb+vyuouemK5y2LffzV/7+eHOZZs3UEio7qsxWA4AAAAAXFMwagAAAAAAAAAA8821W9f/r6q/bLds+e3rCse8WUssMPs3M2xtYAWTt2VvgU7sNXGoKI8CE3q2bn7ugd3SLoc1HZZjDEhnapu/wgAAABEN4Lazp2QAAAAAAAAAAAooAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (16, '255e3e927ca815735905893e4edf2cb5939eaa8d8e25e6e2b023407bd8c4c5c8', '6553649aa0580ad17a7917173d9b6dfb877d666a76082d423d79652f0aefb26a', 3, 3, '2019-01-31 17:29:13', '2019-01-31 17:29:04.556803', '2019-01-31 17:29:04.556804', 68719476736, 15, 1000000000000000000, 2400, 100, 100000000, 10000, 10, 'AAAACmVTZJqgWArRenkXFz2bbfuHfWZqdggtQj15ZS8K77JqJmxadt7QpgVXvXWmrESjc+g9WhfFPul/Kr2a7NSrqWwAAAAAXFMwaQAAAAAAAAAAYKou7BkPtQtmD4O54/TToUgBAVUCmCl/O+9Tgq2hNHxFvqqfLYK9qlAZkLGFIhRljYambJOYbb5j6/Ipx9LZlQAAABAN4Lazp2QAAAAAAAAAAAlgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (15, '6553649aa0580ad17a7917173d9b6dfb877d666a76082d423d79652f0aefb26a', 'b581d45dde651f000f8feff4aabf65d52e4f485b7725b7eb9377deca91bc243f', 1, 1, '2019-01-31 17:29:12', '2019-01-31 17:29:04.570213', '2019-01-31 17:29:04.570213', 64424509440, 15, 1000000000000000000, 2100, 100, 100000000, 10000, 10, 'AAAACrWB1F3eZR8AD4/v9Kq/ZdUuT0hbdyW365N33sqRvCQ/pZb+YX5Qjv2KPpbYbjzW2HQJKNC0+7pcO/4e6dB9ZqEAAAAAXFMwaAAAAAAAAAAAUsjUlWJzXzXDGEu7ZX8NM4G3hXMJgTqm1o4qvEMfb6G//7dox0K18+TYWCROJcgQm42Um7IP2NnrYUWJbGcRJQAAAA8N4Lazp2QAAAAAAAAAAAg0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (14, 'b581d45dde651f000f8feff4aabf65d52e4f485b7725b7eb9377deca91bc243f', '46140b055f6174dfad8a779d0324954660dbef9aa4a85c82323e4f4329089e73', 2, 2, '2019-01-31 17:29:11', '2019-01-31 17:29:04.583429', '2019-01-31 17:29:04.583429', 60129542144, 15, 1000000000000000000, 2000, 100, 100000000, 10000, 10, 'AAAACkYUCwVfYXTfrYp3nQMklUZg2++apKhcgjI+T0MpCJ5zZ91/I0UwfYEZSoLsueI0smhOhNJLvBlOe/jvki6fFjQAAAAAXFMwZwAAAAAAAAAAkBsR4zZKGkuzmOMkFZMIwPdAxWuGy+ssPtEN7i6N7ekKhkwf2WmpSVEJvZDPX4aHatmY485wpMr60x7js8vTjAAAAA4N4Lazp2QAAAAAAAAAAAfQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (13, '46140b055f6174dfad8a779d0324954660dbef9aa4a85c82323e4f4329089e73', '54728858689e8ce308290a83885ddfd3d2f61cc5a80aedd9ce75a2bfd3e6c5c7', 2, 2, '2019-01-31 17:29:10', '2019-01-31 17:29:04.594242', '2019-01-31 17:29:04.594242', 55834574848, 15, 1000000000000000000, 1800, 100, 100000000, 10000, 10, 'AAAAClRyiFhonozjCCkKg4hd39PS9hzFqArt2c51or/T5sXH0eRkNy9xBJR6FQTmx54+QGxfCJMZ/MWfmMRPpy/eyGUAAAAAXFMwZgAAAAAAAAAAFkWUNyF+w67R5n+HgDNebl5snV2YlBsz3xLTqBMQsQGX/H1I0kCIIV/UScdNmwAcHxSLACPJz+wTtbR7RfzzqAAAAA0N4Lazp2QAAAAAAAAAAAcIAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (12, '54728858689e8ce308290a83885ddfd3d2f61cc5a80aedd9ce75a2bfd3e6c5c7', 'bd798b0cbbd707fcf04948ba71b424054f7f4b045babf0fe628e3b21e82f89ff', 1, 1, '2019-01-31 17:29:09', '2019-01-31 17:29:04.61007', '2019-01-31 17:29:04.61007', 51539607552, 15, 1000000000000000000, 1600, 100, 100000000, 10000, 10, 'AAAACr15iwy71wf88ElIunG0JAVPf0sEW6vw/mKOOyHoL4n/YgTpmjhWbDiSpmwuF0QBrxxWl6gbA2a6oCk6IAQubu4AAAAAXFMwZQAAAAAAAAAAc4P3o+BJiJvXXIfj/eVQPF1RRIrnE2nfX0a4J7WFySplspV80wPfY9q8zAMt73jRTTjLU26zAYzZHhlniMEEkgAAAAwN4Lazp2QAAAAAAAAAAAZAAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (11, 'bd798b0cbbd707fcf04948ba71b424054f7f4b045babf0fe628e3b21e82f89ff', '8e9dd6ec62bcf92dfabb9ba18c26427da3f7484118adae411e80ae5cb1c418d4', 1, 1, '2019-01-31 17:29:08', '2019-01-31 17:29:04.633964', '2019-01-31 17:29:04.633965', 47244640256, 15, 1000000000000000000, 1500, 100, 100000000, 10000, 10, 'AAAACo6d1uxivPkt+ruboYwmQn2j90hBGK2uQR6ArlyxxBjU4y+12eED6VGIw1NXylstLZ8WYBn0KzFMFJfi2hLErF0AAAAAXFMwZAAAAAAAAAAApIxpaTPANJS3XftGXFSYrEJ0MhfN6GN1Oe5+YEP6099fHVs66NmJiKZCrMFSgVbEs9hauAy9A0vajVvVIcVfiAAAAAsN4Lazp2QAAAAAAAAAAAXcAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (10, '8e9dd6ec62bcf92dfabb9ba18c26427da3f7484118adae411e80ae5cb1c418d4', 'b6a6f6771dd0247fe8653bec6a9a07c50ae0d261fedd4ccfc4d61a8860f8a914', 1, 2, '2019-01-31 17:29:07', '2019-01-31 17:29:04.648419', '2019-01-31 17:29:04.648419', 42949672960, 15, 1000000000000000000, 1400, 100, 100000000, 10000, 10, 'AAAACram9ncd0CR/6GU77GqaB8UK4NJh/t1Mz8TWGohg+KkUEbX4Rqf2+hk5b2y6zD1YKtv2VdiLJ9+s+ewx5R656EUAAAAAXFMwYwAAAAAAAAAA9+jjsWK6v6g0OYMFxTo1+Yogi2yDSjXhJ86N1AxJOvFjHO6xvdWBK7rm0fRHPEcyuZMV1s4CUJfiv/Oe84b59wAAAAoN4Lazp2QAAAAAAAAAAAV4AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (9, 'b6a6f6771dd0247fe8653bec6a9a07c50ae0d261fedd4ccfc4d61a8860f8a914', '1217f27a3fb86e283416a609423e7bbfe5c4549abe0ac812178f0da23735cd47', 1, 1, '2019-01-31 17:29:06', '2019-01-31 17:29:04.667523', '2019-01-31 17:29:04.667523', 38654705664, 15, 1000000000000000000, 1200, 100, 100000000, 10000, 10, 'AAAAChIX8no/uG4oNBamCUI+e7/lxFSavgrIEhePDaI3Nc1HYzJgkBjBVD4qf2gAtP/37iCEAgI4w05kL53B6jpa3z8AAAAAXFMwYgAAAAAAAAAAbXQLWug2IZ2G4RnwUrCTvIwO6TMpu21+S2UXhqLKWTHROlVoca9PYgUutaiHqQ8qb7UoluBPZoy7TvindGuhKAAAAAkN4Lazp2QAAAAAAAAAAASwAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (8, '1217f27a3fb86e283416a609423e7bbfe5c4549abe0ac812178f0da23735cd47', 'c216d2bf9a368696383346afe756796de3d71ecc1de09e5499755d2e32413519', 4, 4, '2019-01-31 17:29:05', '2019-01-31 17:29:04.680015', '2019-01-31 17:29:04.680015', 34359738368, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACsIW0r+aNoaWODNGr+dWeW3j1x7MHeCeVJl1XS4yQTUZPGFgKlEDbx6C5MpQHTB9E5inTHvHkxcDf+gxK+++vV4AAAAAXFMwYQAAAAAAAAAA5fh0DRZ+OfeA4iH3GTMbG6cqJGEx0qC57216HbTwQPWYa1fL1rgMCIzlsEYF3mb/tjVT2AHsDDCyUWvtNpf7bAAAAAgN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (7, 'c216d2bf9a368696383346afe756796de3d71ecc1de09e5499755d2e32413519', '15669e29e2ecdc0211ab6edfc4b04659d3ab40feb2c4f582eacd6a319b3c0074', 1, 1, '2019-01-31 17:29:04', '2019-01-31 17:29:04.694226', '2019-01-31 17:29:04.694226', 30064771072, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAAChVmnini7NwCEatu38SwRlnTq0D+ssT1gurNajGbPAB0VTUvD7BPzMxE1/CF+9yMAXQx6i7/fID5JG/Yv9g795wAAAAAXFMwYAAAAAAAAAAAk5HOQp3TCIyOUj8VmNGrhs2C+IXj942pn3qPVIAXXx7SYiQnuuOMXkYOu+Ls0tPBUGKgVbJvJIQkvgcN7or9jAAAAAcN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (6, '15669e29e2ecdc0211ab6edfc4b04659d3ab40feb2c4f582eacd6a319b3c0074', '60c2c8a5919821b5ada35bed8cf83eb738aec109ccd6b4ce8a3d0cf113f8f224', 1, 1, '2019-01-31 17:29:03', '2019-01-31 17:29:04.706009', '2019-01-31 17:29:04.706009', 25769803776, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACmDCyKWRmCG1raNb7Yz4Prc4rsEJzNa0zoo9DPET+PIkxkB+czlUJ479Zbj6f/GGZopuVwTMo+hdru2hBjoMYxsAAAAAXFMwXwAAAAAAAAAAN8Q2AtCCsel4HIsK4udqXyLQqcraGjcAHVSUN815grGo9iE9wro7xas5fV8vs97BvI6tRUROFkxYR5WicZaT5AAAAAYN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (5, '60c2c8a5919821b5ada35bed8cf83eb738aec109ccd6b4ce8a3d0cf113f8f224', 'dc73809069c425f8745ef4f13d8d5f7349ecd48781db9c0ebd047776b670fc6e', 3, 3, '2019-01-31 17:29:02', '2019-01-31 17:29:04.721303', '2019-01-31 17:29:04.721303', 21474836480, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAACtxzgJBpxCX4dF708T2NX3NJ7NSHgducDr0Ed3a2cPxuLqu+gM4gubmcYATUhsKT0R8kSmfYqgzwW1SkAMkkQMIAAAAAXFMwXgAAAAAAAAAAk6vO/KRxwZ0ynJMMrKUpOSf5WhbdkNk0LIO/zZxB+qdYx94ZQ5TeWL1QU/AlBLQ8iMv0FS72OW5B0/nU+96UAgAAAAUN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (4, 'dc73809069c425f8745ef4f13d8d5f7349ecd48781db9c0ebd047776b670fc6e', 'aca9ba842b964e13315fa6c8ed152fda59ea74b318fabe3df619728322a471c2', 1, 1, '2019-01-31 17:29:01', '2019-01-31 17:29:04.737755', '2019-01-31 17:29:04.737755', 17179869184, 15, 1000000000000000000, 200, 100, 100000000, 10000, 10, 'AAAACqypuoQrlk4TMV+myO0VL9pZ6nSzGPq+PfYZcoMipHHC04HrKJHsE4oNsGqGDFe/73Ppk5RlEWHOZyj/A1O8sl8AAAAAXFMwXQAAAAAAAAAAI8FeHEzJ0/4FO8/NG/43wTEny1CW/vcH2jcrQkzbbciL5dFDwycg7QMlpvNQ/jkf1N5EFLGCJpnvV5DuWj+4MAAAAAQN4Lazp2QAAAAAAAAAAADIAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (3, 'aca9ba842b964e13315fa6c8ed152fda59ea74b318fabe3df619728322a471c2', '9c4e2c0e69b26691db8bc99f6df67f6a709e45f2f3f2c1aca70ce01365a5cbb4', 1, 1, '2019-01-31 17:29:00', '2019-01-31 17:29:04.752406', '2019-01-31 17:29:04.752406', 12884901888, 15, 1000000000000000000, 100, 100, 100000000, 10000, 10, 'AAAACpxOLA5psmaR24vJn232f2pwnkXy8/LBrKcM4BNlpcu0a7RF9hzgHByzie7alVPsVSuBFihTzF2OAckECQ08r/gAAAAAXFMwXAAAAAAAAAAA5PnvEvMOE8kyDs3Gbu1hou8cmoww1I7xqYGObt0Zo0ogFSwM9WpNYFJUPrfG1APVqUslWvOmemnf5cN9eaG2MgAAAAMN4Lazp2QAAAAAAAAAAABkAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (2, '9c4e2c0e69b26691db8bc99f6df67f6a709e45f2f3f2c1aca70ce01365a5cbb4', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 0, 0, '2019-01-31 17:28:59', '2019-01-31 17:29:04.7652', '2019-01-31 17:29:04.7652', 8589934592, 15, 1000000000000000000, 0, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZuZRHr9UdXKbTKiclfOjy72YZFJUkJPVcKT5htvorm1QAAAAAXFMwWwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERlzUiftOYRhKRI3aHsIRGqiybCW4MmKRi2t2lafBd0khAAAAAIN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:29:04.771906', '2019-01-31 17:29:04.771906', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (62, 'e29dfb3e9072491f31a63c557005e74223f48d04e9f09ab5427abbb2ef81ecc5', 'bb330f4e1d2febc493b814acd97b4de49e8ac3bda0279c9c556796da3233adf0', 0, 0, '2019-01-31 17:29:59', '2019-01-31 17:29:03.983776', '2019-01-31 17:29:03.983776', 266287972352, 15, 1000190721000000000, 30471289, 100, 100000000, 10000, 10, 'AAAACrszD04dL+vEk7gUrNl7TeSeisO9oCecnFVnltoyM63weR4XYqRkgZLjmSy2sAf6GPspIdxz+SdxTCZJl86yM6QAAAAAXFMwlwAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERmoBG7eJMmtrTKZ11cdP127KU3UMOMft2S27C5Rlu7Y3gAAAD4N4WQpWNjKAAAAAAAB0PR5AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
+INSERT INTO history_ledgers VALUES (61, 'bb330f4e1d2febc493b814acd97b4de49e8ac3bda0279c9c556796da3233adf0', 'eaf81958d1cf626bfbbb5955facf7a3349fed12f9344089646a4e358923840c5', 1, 1, '2019-01-31 17:29:58', '2019-01-31 17:29:03.995506', '2019-01-31 17:29:03.995506', 261993005056, 15, 1000190721000000000, 30471289, 100, 100000000, 10000, 10, 'AAAACur4GVjRz2Jr+7tZVfrPejNJ/tEvk0QIlkak41iSOEDFw02HFhuj7+f9o4JyPo26OjVHSKDr1hbrQ2XmNZ8ALQQAAAAAXFMwlgAAAAAAAAAAosEhvRTCvadI/J9r+8kB+lYA6OphTjHH/5o2+iklI4MG5NmpFMJup2tGIwI7GKSL9DdZSuW+qCQfnhcHHfkxVQAAAD0N4WQpWNjKAAAAAAAB0PR5AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (60, 'eaf81958d1cf626bfbbb5955facf7a3349fed12f9344089646a4e358923840c5', '30cb871673e5fb38797225811f201be5ca6e50e471b1346827ff9d211a18945d', 1, 1, '2019-01-31 17:29:57', '2019-01-31 17:29:04.010898', '2019-01-31 17:29:04.010899', 257698037760, 15, 1000190721000000000, 30471189, 100, 100000000, 10000, 10, 'AAAACjDLhxZz5fs4eXIlgR8gG+XKblDkcbE0aCf/nSEaGJRdBZr0MrbfEIJUbFOdp/DcGDUQvU8k18Yr8SJr0tikdlwAAAAAXFMwlQAAAAAAAAAAZB2X7lcCb772lP7QltIwf7Af+ESisKsoFpU1cBZvSc6sPFkpiB0n83mzfSTbeHCA4XTel4zA7bb43cmk5lnPXAAAADwN4WQpWNjKAAAAAAAB0PQVAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (59, '30cb871673e5fb38797225811f201be5ca6e50e471b1346827ff9d211a18945d', '1b927d32f311a6e6936c92c82a1d2440f98ccd1d083387b3365139b30f3c38e8', 1, 1, '2019-01-31 17:29:56', '2019-01-31 17:29:04.023113', '2019-01-31 17:29:04.023114', 253403070464, 15, 1000190721000000000, 30471089, 100, 100000000, 10000, 10, 'AAAAChuSfTLzEabmk2ySyCodJED5jM0dCDOHszZRObMPPDjoik41HURLjfvKZWPEVjiLtLV9FAYUopWbgSrG3gGfonUAAAAAXFMwlAAAAAAAAAAAlNMwBDUEqtiETmU+aulkMrDuVtBffL8sVeqm0xm+yFx7mCSFDxZZ+1KrGdi5iJyH5u3H149+kkPM/PYG6SXLHQAAADsN4WQpWNjKAAAAAAAB0POxAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (58, '1b927d32f311a6e6936c92c82a1d2440f98ccd1d083387b3365139b30f3c38e8', 'b3f0831670b56e7897ace9ddd3aa402d387c5ae05d393b104bfc2fadf8b227af', 1, 1, '2019-01-31 17:29:55', '2019-01-31 17:29:04.031567', '2019-01-31 17:29:04.031567', 249108103168, 15, 1000190721000000000, 30470989, 100, 100000000, 10000, 10, 'AAAACrPwgxZwtW54l6zp3dOqQC04fFrgXTk7EEv8L634sievNsH23Xq5NzZsutYb3znlI0cJSb7Ik80vwTZLY5z38wsAAAAAXFMwkwAAAAAAAAAAGMfQBF/YqYedEUNnQuilzukzLJPeVrR9I6q3baNG83n79OYYOIpTtcWPRv4spitKYNMp88WLKO0IjcjzhWjqAwAAADoN4WQpWNjKAAAAAAAB0PNNAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (57, 'b3f0831670b56e7897ace9ddd3aa402d387c5ae05d393b104bfc2fadf8b227af', 'fb695cbeb52bec7d68bdf2bf4b8f2846d80e9898b62652e20f10658cc555a72a', 1, 1, '2019-01-31 17:29:54', '2019-01-31 17:29:04.043719', '2019-01-31 17:29:04.043719', 244813135872, 15, 1000190721000000000, 30470889, 100, 100000000, 10000, 10, 'AAAACvtpXL61K+x9aL3yv0uPKEbYDpiYtiZS4g8QZYzFVacqWYtYn2ILmdky+PaDnTCEPeurM6E3KE6uCan4S9BSXrMAAAAAXFMwkgAAAAAAAAAA14sFIRujWwg9KpW3xcPTBAGeuB6CsnSMTpP8SjSsd9rnlsVmPmb0ZDYXMm3UrYn0yfRg33mtpYgCPlictMjExwAAADkN4WQpWNjKAAAAAAAB0PLpAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (56, 'fb695cbeb52bec7d68bdf2bf4b8f2846d80e9898b62652e20f10658cc555a72a', '91445371cdbbcf6813c2b37de1e110e1cba08187d763038a7d23dbc226752f86', 1, 1, '2019-01-31 17:29:53', '2019-01-31 17:29:04.053573', '2019-01-31 17:29:04.053573', 240518168576, 15, 1000190721000000000, 30470789, 100, 100000000, 10000, 10, 'AAAACpFEU3HNu89oE8KzfeHhEOHLoIGH12MDin0j28ImdS+GA6rlBKjDTqRHyGKF6uTLMTO1TAsUWvQY0Mh4hUMDS10AAAAAXFMwkQAAAAAAAAAAudvIKbdFQO6cnqBlfAQ0qwiv8T/bow/WPTIANo/ERRHkvt5RuqU9ipoz05wEjENdl9eCNlE1PqnKTY1N6LCd1AAAADgN4WQpWNjKAAAAAAAB0PKFAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (55, '91445371cdbbcf6813c2b37de1e110e1cba08187d763038a7d23dbc226752f86', '944626c10bd9ad9794790f1b3da7c6317347e9555a7fe86bb0a832bfc05bd336', 1, 1, '2019-01-31 17:29:52', '2019-01-31 17:29:04.065435', '2019-01-31 17:29:04.065435', 236223201280, 15, 1000190721000000000, 30470689, 100, 100000000, 10000, 10, 'AAAACpRGJsEL2a2XlHkPGz2nxjFzR+lVWn/oa7CoMr/AW9M2h8P5Ipwneu4dYxd6RMiC1pJvJEV9Mmf4zCodSCIJm1EAAAAAXFMwkAAAAAAAAAAAHOu0BR+25TDycY7dcNSyVck1nyZBCkABFZXwjkic52SWsZzHw8QzlzYVnaMtZMEPp+f3DvUFqiRU2sMj29djZQAAADcN4WQpWNjKAAAAAAAB0PIhAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (54, '944626c10bd9ad9794790f1b3da7c6317347e9555a7fe86bb0a832bfc05bd336', '82e0716d816279bd461d62bfdc2c2360f3eecd4bcace6b75a802e2b3d6ce81f3', 1, 2, '2019-01-31 17:29:51', '2019-01-31 17:29:04.077098', '2019-01-31 17:29:04.077098', 231928233984, 15, 1000190721000000000, 30470589, 100, 100000000, 10000, 10, 'AAAACoLgcW2BYnm9Rh1iv9wsI2Dz7s1Lys5rdagC4rPWzoHzGDOE/g/jhJgmcq6uoMzNiX2k13lRYuJiOo0meZKXgTsAAAAAXFMwjwAAAAAAAAAAfXVfPS9jBqhTfz4LivYYP9btnu+qwf/qpVYL7HgbAM3wAf2MRXw7MGr0Cu+W9gryrmpuDh6Hif0lFBuL3VpVigAAADYN4WQpWNjKAAAAAAAB0PG9AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (53, '82e0716d816279bd461d62bfdc2c2360f3eecd4bcace6b75a802e2b3d6ce81f3', 'e97d1788364ded66561abb2fea08facf0a5f79605f631910d8a23c8303bcfcad', 1, 1, '2019-01-31 17:29:50', '2019-01-31 17:29:04.092374', '2019-01-31 17:29:04.092374', 227633266688, 15, 1000190721000000000, 30470389, 100, 100000000, 10000, 10, 'AAAACul9F4g2Te1mVhq7L+oI+s8KX3lgX2MZENiiPIMDvPytqcMqLH/T2xLT4Dz6UBRA7xjhXfuc9c7Gt9QaGCYR7JEAAAAAXFMwjgAAAAAAAAAAY80asxoHVId70xk95LhsRNki3uSYhgJrvMpz6Qe35wTJ8zZ352f/ae5OMZIs/0zd2qRk3wPhJ1M/2L6jaPRyFwAAADUN4WQpWNjKAAAAAAAB0PD1AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (52, 'e97d1788364ded66561abb2fea08facf0a5f79605f631910d8a23c8303bcfcad', 'ee8902403c7ffe1e80058040479153d3fcbfc1a434cb96fb8d64ee468b977447', 1, 1, '2019-01-31 17:29:49', '2019-01-31 17:29:04.102817', '2019-01-31 17:29:04.102817', 223338299392, 15, 1000190721000000000, 30470289, 100, 100000000, 10000, 10, 'AAAACu6JAkA8f/4egAWAQEeRU9P8v8GkNMuW+41k7kaLl3RHplYjoVEASCJMrSSsZpxI0BM47kEncFGrZ/ZeKtT6Uw8AAAAAXFMwjQAAAAAAAAAAdFs0uvdku2e14ZLZNY/inkD0IgG9m+9h08GbknZs9Ew9Dz367+ylzQdtWqZQqKJyEYjKN3TFlYfWANTtLDafeQAAADQN4WQpWNjKAAAAAAAB0PCRAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (51, 'ee8902403c7ffe1e80058040479153d3fcbfc1a434cb96fb8d64ee468b977447', '9b45adcf0c693010f1dd631501f37b553f8b7b06931c2c812cfb60b6e09dd524', 1, 1, '2019-01-31 17:29:48', '2019-01-31 17:29:04.116068', '2019-01-31 17:29:04.116068', 219043332096, 15, 1000190721000000000, 30470189, 100, 100000000, 10000, 10, 'AAAACptFrc8MaTAQ8d1jFQHze1U/i3sGkxwsgSz7YLbgndUkBwCTazY3htEXt/s4YCc/xQZNsFn04txBwXc1J1eLg5AAAAAAXFMwjAAAAAAAAAAAG9Lveo8KOMBsuTXxxarafYQzHKlqwhXWtcRjpmTOc+NtnsnOMq3xzPWYnt2RlzLlSpTmte9gOc71p1KTFk8seQAAADMN4WQpWNjKAAAAAAAB0PAtAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (50, '9b45adcf0c693010f1dd631501f37b553f8b7b06931c2c812cfb60b6e09dd524', 'f2be8be845deac1e6a3585e42e39e24d2904e4128ce2f2e0d8af483c81201491', 1, 1, '2019-01-31 17:29:47', '2019-01-31 17:29:04.126783', '2019-01-31 17:29:04.126783', 214748364800, 15, 1000190721000000000, 30470089, 100, 100000000, 10000, 10, 'AAAACvK+i+hF3qweajWF5C454k0pBOQSjOLy4NivSDyBIBSRwqpei8jPs8iLeqfNMllYgaEr0Q8MNjFoS4G/9qrjwwIAAAAAXFMwiwAAAAAAAAAAe2zMUWV2ofxxSJUd0hgEpW+dk+M503UNhsqXLKL0TMiQZRGarp+57CD0ZZrJa2AvqpuvqOQEpJgdy7W42NYKowAAADIN4WQpWNjKAAAAAAAB0O/JAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQkGURmq6fuewg9GWayWtgL6qbr6jkBKSYHcu1uNjWCqMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (49, 'f2be8be845deac1e6a3585e42e39e24d2904e4128ce2f2e0d8af483c81201491', '4289421b2c60c4c8a3fc23ecada51df135b765c804749dd3a6ad6dc8ed3c6838', 3, 3, '2019-01-31 17:29:46', '2019-01-31 17:29:04.138663', '2019-01-31 17:29:04.138663', 210453397504, 15, 1000190721000000000, 30469989, 100, 100000000, 10000, 10, 'AAAACkKJQhssYMTIo/wj7K2lHfE1t2XIBHSd06atbcjtPGg4Iu2Ka8xk/8QgNAOBBLuIe+GOWVMOKLyTbiyPshCfzCwAAAAAXFMwigAAAAAAAAAAsSOLB+Fh7PI1BdmA+nlx5DdeXSAZY7OnyHcuyeMEmJBKqB8ytIVSPolkkQQGJnlbtyIJAVeJ+69yi/nTzAXkmQAAADEN4WQpWNjKAAAAAAAB0O9lAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (48, '4289421b2c60c4c8a3fc23ecada51df135b765c804749dd3a6ad6dc8ed3c6838', '69cf4f93a5819ccb94cd4b109f98267f55a4bdbeef59a295e5a51b30dec4ee63', 1, 1, '2019-01-31 17:29:45', '2019-01-31 17:29:04.150214', '2019-01-31 17:29:04.150214', 206158430208, 15, 1000190721000000000, 30469689, 100, 100000000, 10000, 10, 'AAAACmnPT5OlgZzLlM1LEJ+YJn9VpL2+71mileWlGzDexO5jf8BepAzjPQZy4Bw5Sh6dtVObs2tyAAx3kN/NbKElX5oAAAAAXFMwiQAAAAAAAAAAQNg4cr4Do7quX33o4rbaXa7+BDdHc8PbTEXBcuQETP9o1ioM58np0brN2QdhJyiPsLyzcRbj2LfI3Ku15PCowgAAADAN4WQpWNjKAAAAAAAB0O45AAAAAQAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (47, '69cf4f93a5819ccb94cd4b109f98267f55a4bdbeef59a295e5a51b30dec4ee63', '89b05992c4a46617e18ad8d24c48b5fdf57e8eb204d5dba28e35455347f7753d', 1, 1, '2019-01-31 17:29:44', '2019-01-31 17:29:04.161881', '2019-01-31 17:29:04.161882', 201863462912, 15, 1000190721000000000, 30469589, 100, 100000000, 10000, 10, 'AAAAComwWZLEpGYX4YrY0kxItf31fo6yBNXboo41RVNH93U95XqBirfNR5HfmsP2PS7+5Zo0JPehiE7L+Jeo6I6ZqBQAAAAAXFMwiAAAAAAAAAAA8OM7CNjloxWqwlaanmBarRzmaEAjS1RTT7DMBUh/OHjVkKu4jTBeEWwnKkwlXY108mY/4lU1migZAqZ04S1bPQAAAC8N4WQpWNjKAAAAAAAB0O3VAAAAAQAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (46, '89b05992c4a46617e18ad8d24c48b5fdf57e8eb204d5dba28e35455347f7753d', '805ca55e4fbe96e2a75e9a4f25faf3da3f2815d78069527bfa4ab15cb4be248d', 2, 2, '2019-01-31 17:29:43', '2019-01-31 17:29:04.177521', '2019-01-31 17:29:04.177521', 197568495616, 15, 1000000000000000000, 7000, 100, 100000000, 10000, 10, 'AAAACoBcpV5Pvpbip16aTyX689o/KBXXgGlSe/pKsVy0viSNp+8SMomgo4PglMM3U4vQIQRMvceXX738nQlZt4JFe+QAAAAAXFMwhwAAAAAAAAAAknH58hxTmq2NI6laxFq7xN4R3r99jE86ydmH1AhFvM8zyXV+eC6WxOvCapZhe5+lry0wSB1tkukYPbH2Xr+21gAAAC4N4Lazp2QAAAAAAAAAABtYAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (45, '805ca55e4fbe96e2a75e9a4f25faf3da3f2815d78069527bfa4ab15cb4be248d', 'fee6a6acc255cb92b6ed0e3b23d91c8c150f6542360e59988ff007df5b91e3e4', 1, 1, '2019-01-31 17:29:42', '2019-01-31 17:29:04.190449', '2019-01-31 17:29:04.190449', 193273528320, 15, 1000000000000000000, 6800, 100, 100000000, 10000, 10, 'AAAACv7mpqzCVcuStu0OOyPZHIwVD2VCNg5ZmI/wB99bkePkcVHaP6Lds7hPJqFCLQ6LD7tXrY4VyckM9Y4XbnGWYxsAAAAAXFMwhgAAAAAAAAAA2s+Yd36GUBmFrCn8nxzR3G7G6BlWwDjWIvSuQxGMICZxKWV2XqoeGCMh5hPkRMVvlv2shKKe034dZR2M6VJW2QAAAC0N4Lazp2QAAAAAAAAAABqQAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (44, 'fee6a6acc255cb92b6ed0e3b23d91c8c150f6542360e59988ff007df5b91e3e4', '77fc20eff1585cfceda025315b8b7fdf66a5bc5ecaa1f9fd96a0f7728355ae21', 1, 1, '2019-01-31 17:29:41', '2019-01-31 17:29:04.201984', '2019-01-31 17:29:04.201984', 188978561024, 15, 1000000000000000000, 6700, 100, 100000000, 10000, 10, 'AAAACnf8IO/xWFz87aAlMVuLf99mpbxeyqH5/Zag93KDVa4hDF030boKIh4x7g+omRiWrQmMQKJWNsU9rvJlnkQvh3YAAAAAXFMwhQAAAAAAAAAAWWc1pYhVWRoEJa6GcHIsaj1ysxI4CQ+BcJqiGW3wjmKOGzUNaELfaejF7S25wfQfxGYrDS8a/wX+/cYnSiOhEgAAACwN4Lazp2QAAAAAAAAAABosAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (43, '77fc20eff1585cfceda025315b8b7fdf66a5bc5ecaa1f9fd96a0f7728355ae21', '0710c8986d0e9a2553c2e60a4ec22aa22962773730088c31d00c28f1d68732dc', 1, 1, '2019-01-31 17:29:40', '2019-01-31 17:29:04.214015', '2019-01-31 17:29:04.214015', 184683593728, 15, 1000000000000000000, 6600, 100, 100000000, 10000, 10, 'AAAACgcQyJhtDpolU8LmCk7CKqIpYnc3MAiMMdAMKPHWhzLc3CfPLrEUEHfssgU4S+MNUMNUQRc9kYYq0qaeOhQscCsAAAAAXFMwhAAAAAAAAAAA9L2wqBam8xx6EMlQ1e/R7JPhksJqFoZDmKFcFBwf3xBI/cfXfnwzJZ/HdRmbcVi6cYNJJ731n3uCAOzRTMEIoQAAACsN4Lazp2QAAAAAAAAAABnIAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (42, '0710c8986d0e9a2553c2e60a4ec22aa22962773730088c31d00c28f1d68732dc', '91c99e36622f0eee49dc223ef52d92f1fd748b0a4f8d6dd0a7e9ad08f9f702d2', 1, 1, '2019-01-31 17:29:39', '2019-01-31 17:29:04.225669', '2019-01-31 17:29:04.225669', 180388626432, 15, 1000000000000000000, 6500, 100, 100000000, 10000, 10, 'AAAACpHJnjZiLw7uSdwiPvUtkvH9dIsKT41t0KfprQj59wLScz5GVG1SLFrfBMb0CqmMEgQDMPYkTKJs4PS3W2cR94EAAAAAXFMwgwAAAAAAAAAA4mQxuIyGkMnUNprxo1u6b3NOKgS/CqRR0k9oMQPLWs9hxBMflYcolj/zpJChYZZE5y45FM0bYdE3S6roRU61egAAACoN4Lazp2QAAAAAAAAAABlkAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (41, '91c99e36622f0eee49dc223ef52d92f1fd748b0a4f8d6dd0a7e9ad08f9f702d2', '1be9a8c90eed75b05ecfe7f9a7dd79c762f5276bcd594f3a39e049be733dec56', 2, 2, '2019-01-31 17:29:38', '2019-01-31 17:29:04.242548', '2019-01-31 17:29:04.242548', 176093659136, 15, 1000000000000000000, 6400, 100, 100000000, 10000, 10, 'AAAAChvpqMkO7XWwXs/n+afdecdi9SdrzVlPOjngSb5zPexW833pVGBaiKEWHECVt/UkxYXp0VN9tmjlndkJv6YxwycAAAAAXFMwggAAAAAAAAAA3texHWkeXlsh8yl52JzE1M7p/8uzk36DdlbvwdqWw1b+t9dvaHiev5CwibvIX2SYyfy00bLcAI+FdiSA4sL4sAAAACkN4Lazp2QAAAAAAAAAABkAAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (40, '1be9a8c90eed75b05ecfe7f9a7dd79c762f5276bcd594f3a39e049be733dec56', 'f5f2d37f543e32a0193592f1e2484329f75e57ecc152d3c184b4421b6a8c4639', 2, 2, '2019-01-31 17:29:37', '2019-01-31 17:29:04.25614', '2019-01-31 17:29:04.25614', 171798691840, 15, 1000000000000000000, 6200, 100, 100000000, 10000, 10, 'AAAACvXy039UPjKgGTWS8eJIQyn3XlfswVLTwYS0QhtqjEY5PPBmjsNvdhy1MmFDyohJDPJFmpAu8dJ4OkdR7XYtLTsAAAAAXFMwgQAAAAAAAAAAWJlo97rfQjjINuAEkeCOb3Rwl6NYUk95Xa63Nc0UCv1Vt1SNwDz26UP/gSFhSv9UMghrHWKneuVfiFdm/HcncwAAACgN4Lazp2QAAAAAAAAAABg4AAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (39, 'f5f2d37f543e32a0193592f1e2484329f75e57ecc152d3c184b4421b6a8c4639', 'ed838fcd2e06b136f5bd1e89a14aeeb84a47095b75c8c71e09ef10737fe2ab48', 1, 1, '2019-01-31 17:29:36', '2019-01-31 17:29:04.267556', '2019-01-31 17:29:04.267556', 167503724544, 15, 1000000000000000000, 6000, 100, 100000000, 10000, 10, 'AAAACu2Dj80uBrE29b0eiaFK7rhKRwlbdcjHHgnvEHN/4qtItOoStYaQx/c7Oz9fg4z+cPzo3cp4ytW6NZ0vRb8sO0cAAAAAXFMwgAAAAAAAAAAASeHBruDgNPJb9kfcJVMyCg6meb0kov5dW9Ok8EEep2bEjKnO6zAb8Pq9Sf4xlwAaQlkD8LRRSlAP8QyFpqyJoQAAACcN4Lazp2QAAAAAAAAAABdwAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (38, 'ed838fcd2e06b136f5bd1e89a14aeeb84a47095b75c8c71e09ef10737fe2ab48', '1a9ed71ee00676d6c60df311458cab2cae11aa118b34189588c174b7ae3cb8e8', 2, 2, '2019-01-31 17:29:35', '2019-01-31 17:29:04.279058', '2019-01-31 17:29:04.279058', 163208757248, 15, 1000000000000000000, 5900, 100, 100000000, 10000, 10, 'AAAAChqe1x7gBnbWxg3zEUWMqyyuEaoRizQYlYjBdLeuPLjogdqx/sDySYQ/GPorcClQW4Yuu5nnF7WzblY3tHJ46aMAAAAAXFMwfwAAAAAAAAAAN/+dCsXSVcipJLX5fSVl/dtD8pFe+iZnfnNZ2g7vXj9q1x567ScbX5S8V7Ef/Ms9bZWcFUWxd7/I05YCwIdpMAAAACYN4Lazp2QAAAAAAAAAABcMAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (37, '1a9ed71ee00676d6c60df311458cab2cae11aa118b34189588c174b7ae3cb8e8', '8df58d423f2f90dbaf839229ff18a24012eeefbb761366629a4174802bdaf79d', 1, 1, '2019-01-31 17:29:34', '2019-01-31 17:29:04.292172', '2019-01-31 17:29:04.292172', 158913789952, 15, 1000000000000000000, 5700, 100, 100000000, 10000, 10, 'AAAACo31jUI/L5Dbr4OSKf8YokAS7u+7dhNmYppBdIAr2vedbRFToReVifFtAU8ySp373L/KDPjizLeo1c6UqQ6zM2QAAAAAXFMwfgAAAAAAAAAAPORbuvHNNFfsSSmddqmUP8Fk8iW9Jb1yQL/uEyYGc2spEfRjs6m3YMklXpOfc9bUS2zW9UfcNul2fnYu9c+xlAAAACUN4Lazp2QAAAAAAAAAABZEAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (36, '8df58d423f2f90dbaf839229ff18a24012eeefbb761366629a4174802bdaf79d', '3ae4e8dcad8943f51ead0967029267062c96816ce9d63be70cff5d56458524d7', 1, 1, '2019-01-31 17:29:33', '2019-01-31 17:29:04.305689', '2019-01-31 17:29:04.305689', 154618822656, 15, 1000000000000000000, 5600, 100, 100000000, 10000, 10, 'AAAACjrk6NytiUP1Hq0JZwKSZwYsloFs6dY75wz/XVZFhSTX0pTA6akc4evGMwzvRh8u/xVEB5OjOvGOe/LeipTAR1cAAAAAXFMwfQAAAAAAAAAADx7u3Tt890DDCAY2PUo3lKiU3M3nylcrqmvMlFLS+fFXYCqepXoTdFVWcEh5IasGUiHNmhX7tY7NjMNgL50VSwAAACQN4Lazp2QAAAAAAAAAABXgAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (35, '3ae4e8dcad8943f51ead0967029267062c96816ce9d63be70cff5d56458524d7', 'f0d24de03f40504e6a8879fe93b62dda9e071a8b9aaf72b8f914a0072d82a9ef', 1, 1, '2019-01-31 17:29:32', '2019-01-31 17:29:04.319305', '2019-01-31 17:29:04.319305', 150323855360, 15, 1000000000000000000, 5500, 100, 100000000, 10000, 10, 'AAAACvDSTeA/QFBOaoh5/pO2LdqeBxqLmq9yuPkUoActgqnvryw83fiT/KOXu+Of8pSPyNQ8jxTrtKsGNFKr5Y0msaAAAAAAXFMwfAAAAAAAAAAAU3lWP9fTSJmRnN7PpNBaZaYziGh9Xk2I2lyAgYUdNsyNVeh2Yrx0urNNdLi7Dphou5VkFQqEipk4/Pv2z3V9MQAAACMN4Lazp2QAAAAAAAAAABV8AAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (34, 'f0d24de03f40504e6a8879fe93b62dda9e071a8b9aaf72b8f914a0072d82a9ef', '88bd90567480b997883ec331989685d9cf22548ab05e953eeb260f39c6352ffd', 1, 1, '2019-01-31 17:29:31', '2019-01-31 17:29:04.330534', '2019-01-31 17:29:04.330534', 146028888064, 15, 1000000000000000000, 5400, 100, 100000000, 10000, 10, 'AAAACoi9kFZ0gLmXiD7DMZiWhdnPIlSKsF6VPusmDznGNS/9pPBv8wqmgIa84Np2QhUuE38X6074vi1bz5zQ3P/YzC0AAAAAXFMwewAAAAAAAAAA/bE+PeQRbcMvSIfQyaXSWXEgzB7abwreNVQeYYIhwiZrIuDJEkJdONeX0e/6N49lrKsBeaaad/yWn5+uOQDf3AAAACIN4Lazp2QAAAAAAAAAABUYAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (33, '88bd90567480b997883ec331989685d9cf22548ab05e953eeb260f39c6352ffd', 'b50907cdd0ba62e7131aceae6a8627d93e6f2af6cfed82b5806922df1246baed', 1, 1, '2019-01-31 17:29:30', '2019-01-31 17:29:04.341559', '2019-01-31 17:29:04.341559', 141733920768, 15, 1000000000000000000, 5300, 100, 100000000, 10000, 10, 'AAAACrUJB83QumLnExrOrmqGJ9k+byr2z+2CtYBpIt8SRrrtEZFxxkx/w1p2BZj5q/RB3jm/9lJY5exACa0VwoZIfoUAAAAAXFMwegAAAAAAAAAAHdP8tBBtpeyL899tLPnNdaqUoHRWmHKjK8mobr412wHoc7IPiJuKqoc6k71utc4gm+FmXIYBEAL9uXN8pioVggAAACEN4Lazp2QAAAAAAAAAABS0AAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (32, 'b50907cdd0ba62e7131aceae6a8627d93e6f2af6cfed82b5806922df1246baed', '62c27d096a031cf54558fe895c67b2f40b6f2cf76bf3fe23b4f48b3e3ad99008', 2, 2, '2019-01-31 17:29:29', '2019-01-31 17:29:04.352258', '2019-01-31 17:29:04.352258', 137438953472, 15, 1000000000000000000, 5200, 100, 100000000, 10000, 10, 'AAAACmLCfQlqAxz1RVj+iVxnsvQLbyz3a/P+I7T0iz462ZAI21EpOgFp07vwaEqnz3bfQM1mvmBRexRzM2TS6eCbj8YAAAAAXFMweQAAAAAAAAAA2zD57d2oUw2L6murtm/oe2Dj6FuzBXnVm7l7HoDsMUzQbg2iQtwlp5ptQc+D7wDHUUYl6HvdlHbtqdZ7TdAMIQAAACAN4Lazp2QAAAAAAAAAABRQAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (31, '62c27d096a031cf54558fe895c67b2f40b6f2cf76bf3fe23b4f48b3e3ad99008', '6a82f4b09ca89fc5c61bcaca9f21d2c9da91bc62808f413a3576f5dd861721ca', 1, 1, '2019-01-31 17:29:28', '2019-01-31 17:29:04.365383', '2019-01-31 17:29:04.365396', 133143986176, 15, 1000000000000000000, 5000, 100, 100000000, 10000, 10, 'AAAACmqC9LCcqJ/FxhvKyp8h0snakbxigI9BOjV29d2GFyHKCXOeWc+kkQCpcCWsbUL/NUjnWVGUtsfzsZncwgCROOYAAAAAXFMweAAAAAAAAAAA8GmZ2mhGWPCcWAd1pU9SBJXfejtD7FOEoLD6oFsTOv7w2mdOL4Pqeiq4/RSDbSISGZL7mQ0kh0gUxkz4E334lgAAAB8N4Lazp2QAAAAAAAAAABOIAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (30, '6a82f4b09ca89fc5c61bcaca9f21d2c9da91bc62808f413a3576f5dd861721ca', 'f14dec2d7e3f6e1691456c19fc6250e8429b0a3cb542a9ea53fdc9d88052ae1f', 1, 1, '2019-01-31 17:29:27', '2019-01-31 17:29:04.378653', '2019-01-31 17:29:04.378653', 128849018880, 15, 1000000000000000000, 4900, 100, 100000000, 10000, 10, 'AAAACvFN7C1+P24WkUVsGfxiUOhCmwo8tUKp6lP9ydiAUq4fmW8nbDaj+lBH0TcUAKVhLLPs77MjBKTK5MWmRZb1P0gAAAAAXFMwdwAAAAAAAAAA1jXLaoK/IOqKNVkib2cNLrjlETHXpZlcJ4BFYO8bM3kB0r3NIVxepWnij1maD1zfpfEaZfbR7CX+zCo1FkwSwwAAAB4N4Lazp2QAAAAAAAAAABMkAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (29, 'f14dec2d7e3f6e1691456c19fc6250e8429b0a3cb542a9ea53fdc9d88052ae1f', 'bc9484a9a5eec1bf62eec18b056f574b9829fa9fac741bd6aec882a599e9e579', 1, 1, '2019-01-31 17:29:26', '2019-01-31 17:29:04.39077', '2019-01-31 17:29:04.39077', 124554051584, 15, 1000000000000000000, 4800, 100, 100000000, 10000, 10, 'AAAACryUhKml7sG/Yu7BiwVvV0uYKfqfrHQb1q7IgqWZ6eV5+Qe/+rhl+r0yMSYdyTLWbMeZQWplJssiIJBTT9TkhN0AAAAAXFMwdgAAAAAAAAAAbaCD4RbfEqSrI+qpIyzzUU+bYYMJsRw3pHcmHwQrvaCvVPJLAqlYx+H0FROR9GFN/D32gyWcAl7YEeddO6UIWQAAAB0N4Lazp2QAAAAAAAAAABLAAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (28, 'bc9484a9a5eec1bf62eec18b056f574b9829fa9fac741bd6aec882a599e9e579', 'b22e58e9911640efafb2d567c68c6e34f238bc04e4e363e1276f48f2081fbe80', 7, 7, '2019-01-31 17:29:25', '2019-01-31 17:29:04.401189', '2019-01-31 17:29:04.40119', 120259084288, 15, 1000000000000000000, 4700, 100, 100000000, 10000, 10, 'AAAACrIuWOmRFkDvr7LVZ8aMbjTyOLwE5ONj4SdvSPIIH76A8LqYhuLgsMJnbcU9VVm8KWSphDfjn0pRkt7s5rE3VnAAAAAAXFMwdQAAAAAAAAAAdQ/T4yJNKn9hiJoTuxDsNbd9ibRWf2hNHJhsiqBKWD2XevldOglTO7mUsnfyxmceaVImkvr0m6z2Z1L9FBdkvgAAABwN4Lazp2QAAAAAAAAAABJcAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 7, 0, false);
+INSERT INTO history_ledgers VALUES (27, 'b22e58e9911640efafb2d567c68c6e34f238bc04e4e363e1276f48f2081fbe80', '0c929cc3fc329a8c8d2432037782eb347f5913b4c98557ccddc5bdcd68982556', 1, 1, '2019-01-31 17:29:24', '2019-01-31 17:29:04.413602', '2019-01-31 17:29:04.413603', 115964116992, 15, 1000000000000000000, 4000, 100, 100000000, 10000, 10, 'AAAACgySnMP8MpqMjSQyA3eC6zR/WRO0yYVXzN3Fvc1omCVWxcImbTTloVbrBZbrnn02GV5ro2RXH1XBfl3tX52+7ycAAAAAXFMwdAAAAAAAAAAAkpeXdNXfmh1EnUjCYIMebdzV8PlgCe6J6eJq8Mb4SXZ5sxnRTSAUOspIkLs7Ux9bUfgF0YpmkzDB5NX4FUUlbgAAABsN4Lazp2QAAAAAAAAAAA+gAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (26, '0c929cc3fc329a8c8d2432037782eb347f5913b4c98557ccddc5bdcd68982556', '56c7b20fa5c7d650992072bef0d1aa9825b35853a2153016464b55d183452476', 2, 2, '2019-01-31 17:29:23', '2019-01-31 17:29:04.42483', '2019-01-31 17:29:04.42483', 111669149696, 15, 1000000000000000000, 3900, 100, 100000000, 10000, 10, 'AAAAClbHsg+lx9ZQmSByvvDRqpgls1hTohUwFkZLVdGDRSR2DXfRAXVGP//txFOEYxRaywe6IB6iFYnWiE9xAnMhMK8AAAAAXFMwcwAAAAAAAAAAGTKcjd5tsclF3P+ptDiMdUWp83tuYF+zlADp+X+gt+f3OiR0qiyKHIVkljdbF3Nng3+X1XsZOBbdTRZgTpRcnwAAABoN4Lazp2QAAAAAAAAAAA88AAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (25, '56c7b20fa5c7d650992072bef0d1aa9825b35853a2153016464b55d183452476', '63d34b38f0fc54920bdbb8e76f3bd766a4eb9ec413d7f3aa8e44dc3b71bb8424', 1, 1, '2019-01-31 17:29:22', '2019-01-31 17:29:04.437222', '2019-01-31 17:29:04.437222', 107374182400, 15, 1000000000000000000, 3700, 100, 100000000, 10000, 10, 'AAAACmPTSzjw/FSSC9u4528712ak657EE9fzqo5E3Dtxu4QkQ+IdKnxOOyi5gFuhM0INafpmgU1YOXtSmdl8e2B44nQAAAAAXFMwcgAAAAAAAAAAM9gLVDvjwMuVZ+njRjvvMxlhodRg/VJpuapiCb0y9SAvFcBGeWlqHDItwSuwoDSwIsCjUGy65lgGrYtO0ttDEgAAABkN4Lazp2QAAAAAAAAAAA50AAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (24, '63d34b38f0fc54920bdbb8e76f3bd766a4eb9ec413d7f3aa8e44dc3b71bb8424', '95c903bcf12dd784595646cdee1ee4d7f22a17bf1165710fe4ecef90da13beb5', 1, 1, '2019-01-31 17:29:21', '2019-01-31 17:29:04.448212', '2019-01-31 17:29:04.448213', 103079215104, 15, 1000000000000000000, 3600, 100, 100000000, 10000, 10, 'AAAACpXJA7zxLdeEWVZGze4e5NfyKhe/EWVxD+Ts75DaE761r+pcUh2G76ycaOcTO6sy8Ia0Iv+iAKJ/Kwdbp1P7vjsAAAAAXFMwcQAAAAAAAAAA1ziHajubDrj1Iu5EQ8YZB24Czm29AJPdaJnsX3+A+fWbhUKafD7NeLtGcj8zHUucvsoVWHkCB5aSiuzQhKwWZgAAABgN4Lazp2QAAAAAAAAAAA4QAAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (23, '95c903bcf12dd784595646cdee1ee4d7f22a17bf1165710fe4ecef90da13beb5', '81b2a9c65c44006e87df8bbc001a10f92ec675189264b35fadb9fc348a738c42', 1, 1, '2019-01-31 17:29:20', '2019-01-31 17:29:04.466216', '2019-01-31 17:29:04.466216', 98784247808, 15, 1000000000000000000, 3500, 100, 100000000, 10000, 10, 'AAAACoGyqcZcRABuh9+LvAAaEPkuxnUYkmSzX625/DSKc4xCyNmUPvekwYdvguqOpRSIovSLopu40ugJI3W5ctUnsJUAAAAAXFMwcAAAAAAAAAAAeu6ZLz++eOyW3/HPY616v+oF2X756WCT8G93ApdHifqrwhvK7vXfbwAi0FVRArkI98wSgEQ2YdHxEvKzj5nljgAAABcN4Lazp2QAAAAAAAAAAA2sAAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (22, '81b2a9c65c44006e87df8bbc001a10f92ec675189264b35fadb9fc348a738c42', '8d0efb63d647408f9a0316f489baccb370c3bac19b3956b9f591dd2cbab0ff66', 1, 1, '2019-01-31 17:29:19', '2019-01-31 17:29:04.475204', '2019-01-31 17:29:04.475204', 94489280512, 15, 1000000000000000000, 3400, 100, 100000000, 10000, 10, 'AAAACo0O+2PWR0CPmgMW9Im6zLNww7rBmzlWufWR3Sy6sP9mwJitIO+K144Fdwp7PfLCLadhaqKuYGnPFHcqccPZL9oAAAAAXFMwbwAAAAAAAAAA0AMiyT4CyDLLvayS0AmP97hen9j1rkIsoYeN0iQrjuonYIKlor6Xdb06QM405D50Ip9qKTkFDNGw5iG+BOW2QAAAABYN4Lazp2QAAAAAAAAAAA1IAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (21, '8d0efb63d647408f9a0316f489baccb370c3bac19b3956b9f591dd2cbab0ff66', '7a8c4baeceecf5eb426cd32eba2a4cdef8bae5b86929af6d32ffbcaa1417179c', 2, 2, '2019-01-31 17:29:18', '2019-01-31 17:29:04.486026', '2019-01-31 17:29:04.486026', 90194313216, 15, 1000000000000000000, 3300, 100, 100000000, 10000, 10, 'AAAACnqMS67O7PXrQmzTLroqTN74uuW4aSmvbTL/vKoUFxecLZZvdO3s/3UJdTh50f5BBtH9sPUELRE22ZyuJSdDJbIAAAAAXFMwbgAAAAAAAAAA3LAnNjSwmLo474ojoHgysfkXmeeBIXdgWbyOJwbnsx/n/0KcqOjc4L+2Lf2Yuce1MdqhetM8pGLwmCdjHn1uYwAAABUN4Lazp2QAAAAAAAAAAAzkAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (20, '7a8c4baeceecf5eb426cd32eba2a4cdef8bae5b86929af6d32ffbcaa1417179c', 'f92d62b78a9ff6958f45b9d127685cc1739035220b90004ea6ea6049b5b6884b', 1, 1, '2019-01-31 17:29:17', '2019-01-31 17:29:04.498223', '2019-01-31 17:29:04.498223', 85899345920, 15, 1000000000000000000, 3100, 100, 100000000, 10000, 10, 'AAAACvktYreKn/aVj0W50SdoXMFzkDUiC5AATqbqYEm1tohLjqkOLAyOWWwrM+DAYlHI2x8dkV7cPQJ49T86uEC3NrMAAAAAXFMwbQAAAAAAAAAAx/hxaEW2XNJB3iDf23eYfGcwcQ862pSt7OJ6FF108IY7c81v/4T/c6lBZvLlsg/1fp6j5mLeB7FmEmVYCDq66AAAABQN4Lazp2QAAAAAAAAAAAwcAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (19, 'f92d62b78a9ff6958f45b9d127685cc1739035220b90004ea6ea6049b5b6884b', 'cfd3c9857bb27f0a9f212add9f6606ff2d58bfed5992c4ec648758bb653cb646', 1, 1, '2019-01-31 17:29:16', '2019-01-31 17:29:04.515313', '2019-01-31 17:29:04.515313', 81604378624, 15, 1000000000000000000, 3000, 100, 100000000, 10000, 10, 'AAAACs/TyYV7sn8KnyEq3Z9mBv8tWL/tWZLE7GSHWLtlPLZGmj39apih8WeL8GxrXPB8vWVSC6vYIsKR7SERgeaWOrEAAAAAXFMwbAAAAAAAAAAAYzC64fmWjZOtqE4JjsKqwerdnzN6ywnJSvdsDWFPcu04tESCEO43fHGUrWksnPnOmtk5wTEXI9IGwVa7PRguYwAAABMN4Lazp2QAAAAAAAAAAAu4AAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (18, 'cfd3c9857bb27f0a9f212add9f6606ff2d58bfed5992c4ec648758bb653cb646', 'd77ca83203978a9fb7a451f90dd4d83005ccaadcb7af55bd12a8f4e6176e98b8', 3, 3, '2019-01-31 17:29:15', '2019-01-31 17:29:04.532334', '2019-01-31 17:29:04.532334', 77309411328, 15, 1000000000000000000, 2900, 100, 100000000, 10000, 10, 'AAAACtd8qDIDl4qft6RR+Q3U2DAFzKrct69VvRKo9OYXbpi4WsLmcQ6q7yzhvC89kddyhhB2nq1Cqmlj3vTRAl3bI8cAAAAAXFMwawAAAAAAAAAANKxyAMoyM/gn59hBYzLxbU28Wbix3QHy7s086vpSY4eWePLnsINCvfVN5hUmVgPG+7xiiEFgx7DgedgJjedN2AAAABIN4Lazp2QAAAAAAAAAAAtUAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (17, 'd77ca83203978a9fb7a451f90dd4d83005ccaadcb7af55bd12a8f4e6176e98b8', '255e3e927ca815735905893e4edf2cb5939eaa8d8e25e6e2b023407bd8c4c5c8', 2, 2, '2019-01-31 17:29:14', '2019-01-31 17:29:04.543728', '2019-01-31 17:29:04.543728', 73014444032, 15, 1000000000000000000, 2600, 100, 100000000, 10000, 10, 'AAAACiVePpJ8qBVzWQWJPk7fLLWTnqqNjiXm4rAjQHvYxMXIkwKZb+vyuouemK5y2LffzV/7+eHOZZs3UEio7qsxWA4AAAAAXFMwagAAAAAAAAAA8821W9f/r6q/bLds+e3rCse8WUssMPs3M2xtYAWTt2VvgU7sNXGoKI8CE3q2bn7ugd3SLoc1HZZjDEhnapu/wgAAABEN4Lazp2QAAAAAAAAAAAooAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (16, '255e3e927ca815735905893e4edf2cb5939eaa8d8e25e6e2b023407bd8c4c5c8', '6553649aa0580ad17a7917173d9b6dfb877d666a76082d423d79652f0aefb26a', 3, 3, '2019-01-31 17:29:13', '2019-01-31 17:29:04.556803', '2019-01-31 17:29:04.556804', 68719476736, 15, 1000000000000000000, 2400, 100, 100000000, 10000, 10, 'AAAACmVTZJqgWArRenkXFz2bbfuHfWZqdggtQj15ZS8K77JqJmxadt7QpgVXvXWmrESjc+g9WhfFPul/Kr2a7NSrqWwAAAAAXFMwaQAAAAAAAAAAYKou7BkPtQtmD4O54/TToUgBAVUCmCl/O+9Tgq2hNHxFvqqfLYK9qlAZkLGFIhRljYambJOYbb5j6/Ipx9LZlQAAABAN4Lazp2QAAAAAAAAAAAlgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (15, '6553649aa0580ad17a7917173d9b6dfb877d666a76082d423d79652f0aefb26a', 'b581d45dde651f000f8feff4aabf65d52e4f485b7725b7eb9377deca91bc243f', 1, 1, '2019-01-31 17:29:12', '2019-01-31 17:29:04.570213', '2019-01-31 17:29:04.570213', 64424509440, 15, 1000000000000000000, 2100, 100, 100000000, 10000, 10, 'AAAACrWB1F3eZR8AD4/v9Kq/ZdUuT0hbdyW365N33sqRvCQ/pZb+YX5Qjv2KPpbYbjzW2HQJKNC0+7pcO/4e6dB9ZqEAAAAAXFMwaAAAAAAAAAAAUsjUlWJzXzXDGEu7ZX8NM4G3hXMJgTqm1o4qvEMfb6G//7dox0K18+TYWCROJcgQm42Um7IP2NnrYUWJbGcRJQAAAA8N4Lazp2QAAAAAAAAAAAg0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (14, 'b581d45dde651f000f8feff4aabf65d52e4f485b7725b7eb9377deca91bc243f', '46140b055f6174dfad8a779d0324954660dbef9aa4a85c82323e4f4329089e73', 2, 2, '2019-01-31 17:29:11', '2019-01-31 17:29:04.583429', '2019-01-31 17:29:04.583429', 60129542144, 15, 1000000000000000000, 2000, 100, 100000000, 10000, 10, 'AAAACkYUCwVfYXTfrYp3nQMklUZg2++apKhcgjI+T0MpCJ5zZ91/I0UwfYEZSoLsueI0smhOhNJLvBlOe/jvki6fFjQAAAAAXFMwZwAAAAAAAAAAkBsR4zZKGkuzmOMkFZMIwPdAxWuGy+ssPtEN7i6N7ekKhkwf2WmpSVEJvZDPX4aHatmY485wpMr60x7js8vTjAAAAA4N4Lazp2QAAAAAAAAAAAfQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (13, '46140b055f6174dfad8a779d0324954660dbef9aa4a85c82323e4f4329089e73', '54728858689e8ce308290a83885ddfd3d2f61cc5a80aedd9ce75a2bfd3e6c5c7', 2, 2, '2019-01-31 17:29:10', '2019-01-31 17:29:04.594242', '2019-01-31 17:29:04.594242', 55834574848, 15, 1000000000000000000, 1800, 100, 100000000, 10000, 10, 'AAAAClRyiFhonozjCCkKg4hd39PS9hzFqArt2c51or/T5sXH0eRkNy9xBJR6FQTmx54+QGxfCJMZ/MWfmMRPpy/eyGUAAAAAXFMwZgAAAAAAAAAAFkWUNyF+w67R5n+HgDNebl5snV2YlBsz3xLTqBMQsQGX/H1I0kCIIV/UScdNmwAcHxSLACPJz+wTtbR7RfzzqAAAAA0N4Lazp2QAAAAAAAAAAAcIAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (12, '54728858689e8ce308290a83885ddfd3d2f61cc5a80aedd9ce75a2bfd3e6c5c7', 'bd798b0cbbd707fcf04948ba71b424054f7f4b045babf0fe628e3b21e82f89ff', 1, 1, '2019-01-31 17:29:09', '2019-01-31 17:29:04.61007', '2019-01-31 17:29:04.61007', 51539607552, 15, 1000000000000000000, 1600, 100, 100000000, 10000, 10, 'AAAACr15iwy71wf88ElIunG0JAVPf0sEW6vw/mKOOyHoL4n/YgTpmjhWbDiSpmwuF0QBrxxWl6gbA2a6oCk6IAQubu4AAAAAXFMwZQAAAAAAAAAAc4P3o+BJiJvXXIfj/eVQPF1RRIrnE2nfX0a4J7WFySplspV80wPfY9q8zAMt73jRTTjLU26zAYzZHhlniMEEkgAAAAwN4Lazp2QAAAAAAAAAAAZAAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (11, 'bd798b0cbbd707fcf04948ba71b424054f7f4b045babf0fe628e3b21e82f89ff', '8e9dd6ec62bcf92dfabb9ba18c26427da3f7484118adae411e80ae5cb1c418d4', 1, 1, '2019-01-31 17:29:08', '2019-01-31 17:29:04.633964', '2019-01-31 17:29:04.633965', 47244640256, 15, 1000000000000000000, 1500, 100, 100000000, 10000, 10, 'AAAACo6d1uxivPkt+ruboYwmQn2j90hBGK2uQR6ArlyxxBjU4y+12eED6VGIw1NXylstLZ8WYBn0KzFMFJfi2hLErF0AAAAAXFMwZAAAAAAAAAAApIxpaTPANJS3XftGXFSYrEJ0MhfN6GN1Oe5+YEP6099fHVs66NmJiKZCrMFSgVbEs9hauAy9A0vajVvVIcVfiAAAAAsN4Lazp2QAAAAAAAAAAAXcAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (10, '8e9dd6ec62bcf92dfabb9ba18c26427da3f7484118adae411e80ae5cb1c418d4', 'b6a6f6771dd0247fe8653bec6a9a07c50ae0d261fedd4ccfc4d61a8860f8a914', 1, 2, '2019-01-31 17:29:07', '2019-01-31 17:29:04.648419', '2019-01-31 17:29:04.648419', 42949672960, 15, 1000000000000000000, 1400, 100, 100000000, 10000, 10, 'AAAACram9ncd0CR/6GU77GqaB8UK4NJh/t1Mz8TWGohg+KkUEbX4Rqf2+hk5b2y6zD1YKtv2VdiLJ9+s+ewx5R656EUAAAAAXFMwYwAAAAAAAAAA9+jjsWK6v6g0OYMFxTo1+Yogi2yDSjXhJ86N1AxJOvFjHO6xvdWBK7rm0fRHPEcyuZMV1s4CUJfiv/Oe84b59wAAAAoN4Lazp2QAAAAAAAAAAAV4AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (9, 'b6a6f6771dd0247fe8653bec6a9a07c50ae0d261fedd4ccfc4d61a8860f8a914', '1217f27a3fb86e283416a609423e7bbfe5c4549abe0ac812178f0da23735cd47', 1, 1, '2019-01-31 17:29:06', '2019-01-31 17:29:04.667523', '2019-01-31 17:29:04.667523', 38654705664, 15, 1000000000000000000, 1200, 100, 100000000, 10000, 10, 'AAAAChIX8no/uG4oNBamCUI+e7/lxFSavgrIEhePDaI3Nc1HYzJgkBjBVD4qf2gAtP/37iCEAgI4w05kL53B6jpa3z8AAAAAXFMwYgAAAAAAAAAAbXQLWug2IZ2G4RnwUrCTvIwO6TMpu21+S2UXhqLKWTHROlVoca9PYgUutaiHqQ8qb7UoluBPZoy7TvindGuhKAAAAAkN4Lazp2QAAAAAAAAAAASwAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (8, '1217f27a3fb86e283416a609423e7bbfe5c4549abe0ac812178f0da23735cd47', 'c216d2bf9a368696383346afe756796de3d71ecc1de09e5499755d2e32413519', 4, 4, '2019-01-31 17:29:05', '2019-01-31 17:29:04.680015', '2019-01-31 17:29:04.680015', 34359738368, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACsIW0r+aNoaWODNGr+dWeW3j1x7MHeCeVJl1XS4yQTUZPGFgKlEDbx6C5MpQHTB9E5inTHvHkxcDf+gxK+++vV4AAAAAXFMwYQAAAAAAAAAA5fh0DRZ+OfeA4iH3GTMbG6cqJGEx0qC57216HbTwQPWYa1fL1rgMCIzlsEYF3mb/tjVT2AHsDDCyUWvtNpf7bAAAAAgN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (7, 'c216d2bf9a368696383346afe756796de3d71ecc1de09e5499755d2e32413519', '15669e29e2ecdc0211ab6edfc4b04659d3ab40feb2c4f582eacd6a319b3c0074', 1, 1, '2019-01-31 17:29:04', '2019-01-31 17:29:04.694226', '2019-01-31 17:29:04.694226', 30064771072, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAAChVmnini7NwCEatu38SwRlnTq0D+ssT1gurNajGbPAB0VTUvD7BPzMxE1/CF+9yMAXQx6i7/fID5JG/Yv9g795wAAAAAXFMwYAAAAAAAAAAAk5HOQp3TCIyOUj8VmNGrhs2C+IXj942pn3qPVIAXXx7SYiQnuuOMXkYOu+Ls0tPBUGKgVbJvJIQkvgcN7or9jAAAAAcN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (6, '15669e29e2ecdc0211ab6edfc4b04659d3ab40feb2c4f582eacd6a319b3c0074', '60c2c8a5919821b5ada35bed8cf83eb738aec109ccd6b4ce8a3d0cf113f8f224', 1, 1, '2019-01-31 17:29:03', '2019-01-31 17:29:04.706009', '2019-01-31 17:29:04.706009', 25769803776, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACmDCyKWRmCG1raNb7Yz4Prc4rsEJzNa0zoo9DPET+PIkxkB+czlUJ479Zbj6f/GGZopuVwTMo+hdru2hBjoMYxsAAAAAXFMwXwAAAAAAAAAAN8Q2AtCCsel4HIsK4udqXyLQqcraGjcAHVSUN815grGo9iE9wro7xas5fV8vs97BvI6tRUROFkxYR5WicZaT5AAAAAYN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (5, '60c2c8a5919821b5ada35bed8cf83eb738aec109ccd6b4ce8a3d0cf113f8f224', 'dc73809069c425f8745ef4f13d8d5f7349ecd48781db9c0ebd047776b670fc6e', 3, 3, '2019-01-31 17:29:02', '2019-01-31 17:29:04.721303', '2019-01-31 17:29:04.721303', 21474836480, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAACtxzgJBpxCX4dF708T2NX3NJ7NSHgducDr0Ed3a2cPxuLqu+gM4gubmcYATUhsKT0R8kSmfYqgzwW1SkAMkkQMIAAAAAXFMwXgAAAAAAAAAAk6vO/KRxwZ0ynJMMrKUpOSf5WhbdkNk0LIO/zZxB+qdYx94ZQ5TeWL1QU/AlBLQ8iMv0FS72OW5B0/nU+96UAgAAAAUN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (4, 'dc73809069c425f8745ef4f13d8d5f7349ecd48781db9c0ebd047776b670fc6e', 'aca9ba842b964e13315fa6c8ed152fda59ea74b318fabe3df619728322a471c2', 1, 1, '2019-01-31 17:29:01', '2019-01-31 17:29:04.737755', '2019-01-31 17:29:04.737755', 17179869184, 15, 1000000000000000000, 200, 100, 100000000, 10000, 10, 'AAAACqypuoQrlk4TMV+myO0VL9pZ6nSzGPq+PfYZcoMipHHC04HrKJHsE4oNsGqGDFe/73Ppk5RlEWHOZyj/A1O8sl8AAAAAXFMwXQAAAAAAAAAAI8FeHEzJ0/4FO8/NG/43wTEny1CW/vcH2jcrQkzbbciL5dFDwycg7QMlpvNQ/jkf1N5EFLGCJpnvV5DuWj+4MAAAAAQN4Lazp2QAAAAAAAAAAADIAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (3, 'aca9ba842b964e13315fa6c8ed152fda59ea74b318fabe3df619728322a471c2', '9c4e2c0e69b26691db8bc99f6df67f6a709e45f2f3f2c1aca70ce01365a5cbb4', 1, 1, '2019-01-31 17:29:00', '2019-01-31 17:29:04.752406', '2019-01-31 17:29:04.752406', 12884901888, 15, 1000000000000000000, 100, 100, 100000000, 10000, 10, 'AAAACpxOLA5psmaR24vJn232f2pwnkXy8/LBrKcM4BNlpcu0a7RF9hzgHByzie7alVPsVSuBFihTzF2OAckECQ08r/gAAAAAXFMwXAAAAAAAAAAA5PnvEvMOE8kyDs3Gbu1hou8cmoww1I7xqYGObt0Zo0ogFSwM9WpNYFJUPrfG1APVqUslWvOmemnf5cN9eaG2MgAAAAMN4Lazp2QAAAAAAAAAAABkAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (2, '9c4e2c0e69b26691db8bc99f6df67f6a709e45f2f3f2c1aca70ce01365a5cbb4', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 0, 0, '2019-01-31 17:28:59', '2019-01-31 17:29:04.7652', '2019-01-31 17:29:04.7652', 8589934592, 15, 1000000000000000000, 0, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZuZRHr9UdXKbTKiclfOjy72YZFJUkJPVcKT5htvorm1QAAAAAXFMwWwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERlzUiftOYRhKRI3aHsIRGqiybCW4MmKRi2t2lafBd0khAAAAAIN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:29:04.771906', '2019-01-31 17:29:04.771906', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -1343,6 +1414,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -1378,6 +1456,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
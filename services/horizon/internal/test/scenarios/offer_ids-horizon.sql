@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -492,6 +557,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -565,15 +636,15 @@ INSERT INTO history_effects VALUES (4, 8589950977, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (9, 'b4ed2903773c6a3d59447c04b2951d57b8a57b788db8f7f88e2ce84fa718af5e', '6839c39492d3c106fa466e966d17090df0e9416286b75bef3c5c2839ed5269fd', 1, 1, '2019-01-31 17:28:51', '2019-01-31 17:28:47.708655', '2019-01-31 17:28:47.708655', 38654705664, 15, 1000000000000000000, 1500, 100, 100000000, 10000, 10, 'AAAACmg5w5SS08EG+kZulm0XCQ3w6UFihrdb7zxcKDntUmn9tS+jeSyUPwRfIVTpVHwy3z/jR6N7pxNRR2lCT4xI22IAAAAAXFMwUwAAAAAAAAAA5u7HOB8cX0WnqixnvzOlY3D3Y3oP0dYzzW9yWnVbm0ZTnewEh3yMG2akayxBgp4t1bOOKuTiShlrOugYXB6D0wAAAAkN4Lazp2QAAAAAAAAAAAXcAAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (8, '6839c39492d3c106fa466e966d17090df0e9416286b75bef3c5c2839ed5269fd', 'c8fae6f1206d0eea6c23cde4e5264c95660e4731536cd0041660ab59faad69ad', 1, 1, '2019-01-31 17:28:50', '2019-01-31 17:28:47.738555', '2019-01-31 17:28:47.738555', 34359738368, 15, 1000000000000000000, 1400, 100, 100000000, 10000, 10, 'AAAACsj65vEgbQ7qbCPN5OUmTJVmDkcxU2zQBBZgq1n6rWmtlIBwf3ktpOCrRVkxMCjx36fiboSDHs3+fzkglZuxgB4AAAAAXFMwUgAAAAAAAAAAAOMGyexOuevk4QIYCK/e2TLQXecE/TBuu1dvGQilTsPoEo1Bqh2y6JFEHyiV51Rm/R+7LcUu+s7WR2xPEtUp5gAAAAgN4Lazp2QAAAAAAAAAAAV4AAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (7, 'c8fae6f1206d0eea6c23cde4e5264c95660e4731536cd0041660ab59faad69ad', '24f6150933fe0248e1a4101daeaa2888d3a1b08c2877d4bf323d46c9523ef0c3', 1, 1, '2019-01-31 17:28:49', '2019-01-31 17:28:47.760251', '2019-01-31 17:28:47.760251', 30064771072, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACiT2FQkz/gJI4aQQHa6qKIjTobCMKHfUvzI9RslSPvDD4sjuqSPt86fPXM1X9v+jPBIwE2iktGhZlXqkw1HTwVgAAAAAXFMwUQAAAAAAAAAA8011I8cweiVULRk7g+I3eWM/k+U+svLLvJL8X9ENB1pkp2pX7zpl53J57T/2ogrbxX6CqCy9B+WX/WFxVgQGGQAAAAcN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (6, '24f6150933fe0248e1a4101daeaa2888d3a1b08c2877d4bf323d46c9523ef0c3', 'b9ce93831b645fbcfbccc819f6c6e3c98d3e8371d54fe135628f6f81d3003233', 1, 1, '2019-01-31 17:28:48', '2019-01-31 17:28:47.769884', '2019-01-31 17:28:47.769884', 25769803776, 15, 1000000000000000000, 1200, 100, 100000000, 10000, 10, 'AAAACrnOk4MbZF+8+8zIGfbG48mNPoNx1U/hNWKPb4HTADIz4j3B52m8FD7T9GHIKj+vpHEMjjjKY2IfOu8VtwKODVYAAAAAXFMwUAAAAAAAAAAAuQrPQr2qlNVpCIqhQaeQMsD9JWWNaxISOIwMsxvilIXUu8Yp4FRWPTL49BryjHJtmL3pLo6jdjsOd/CA2Yfc3wAAAAYN4Lazp2QAAAAAAAAAAASwAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (5, 'b9ce93831b645fbcfbccc819f6c6e3c98d3e8371d54fe135628f6f81d3003233', '854ac9cc380875323a155d119c44c12084e7367a155d225ece308b758cc646bb', 1, 1, '2019-01-31 17:28:47', '2019-01-31 17:28:47.778414', '2019-01-31 17:28:47.778415', 21474836480, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACoVKycw4CHUyOhVdEZxEwSCE5zZ6FV0iXs4wi3WMxka70knOba+4HcMXl6iaqmJxxqnjFI1wKEjPok5hRuoBGnYAAAAAXFMwTwAAAAAAAAAA5tFak/fh7MEOithjlydErBU0/M0ilaefu1Ub0Cw1cbTzFxb8gu9laovDtVVmRNNoVxTd4e0bs0SBD1ECsWqaTwAAAAUN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAABAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (4, '854ac9cc380875323a155d119c44c12084e7367a155d225ece308b758cc646bb', '6c953a331a2136b34e21e5988712b5c88c21847f7cfc00fa1b6d2dc1694c69e5', 2, 2, '2019-01-31 17:28:46', '2019-01-31 17:28:47.795829', '2019-01-31 17:28:47.79583', 17179869184, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACmyVOjMaITazTiHlmIcStciMIYR/fPwA+httLcFpTGnlM6EBvYLF+a0CwobSySR7+Dioi/z4gj3Wwo3Y9+BDGyEAAAAAXFMwTgAAAAAAAAAAN5B5W9KsxoOXOpscNNdDinauNxC/Pw2kk7DDUo0SXOXPxLn714AfsSEe0pKfjL13DcYiKYEzRv0GJwE1ah4YVgAAAAQN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (3, '6c953a331a2136b34e21e5988712b5c88c21847f7cfc00fa1b6d2dc1694c69e5', '62b9eda2731aeb01c0cdcf45a8c430e6a92a7b169ad4a14ec958f7dd451d986e', 4, 4, '2019-01-31 17:28:45', '2019-01-31 17:28:47.811687', '2019-01-31 17:28:47.811687', 12884901888, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACmK57aJzGusBwM3PRajEMOapKnsWmtShTslY991FHZhua6SiTUwaTmOC8+8pIgozz4AqirhmyU9YEJhghKbQTz0AAAAAXFMwTQAAAAAAAAAAeTL8Iw0ShUy6czNHgs+G27QvHrgowubFTPRxK2ziKfG3ovsYVK3zQZ6ZE5ZmN4iN90lIWuQ12CA+YvPeVwJiewAAAAMN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (2, '62b9eda2731aeb01c0cdcf45a8c430e6a92a7b169ad4a14ec958f7dd451d986e', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 4, 4, '2019-01-31 17:28:44', '2019-01-31 17:28:47.822945', '2019-01-31 17:28:47.822945', 8589934592, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76Zh82QgsuASmS0xgFp1fjiT2wNgvVWRClSW5uMP344SMoAAAAAXFMwTAAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAh7rHH3ehqvUsWEPfAlvVspAA2/ZR0JjPAFIdHbQ2sSjCdKNX9bX7oXl4uO6TSACchqtoxD28NFm6aqXf4rFVngAAAAIN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:28:47.837822', '2019-01-31 17:28:47.837822', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (9, 'b4ed2903773c6a3d59447c04b2951d57b8a57b788db8f7f88e2ce84fa718af5e', '6839c39492d3c106fa466e966d17090df0e9416286b75bef3c5c2839ed5269fd', 1, 1, '2019-01-31 17:28:51', '2019-01-31 17:28:47.708655', '2019-01-31 17:28:47.708655', 38654705664, 15, 1000000000000000000, 1500, 100, 100000000, 10000, 10, 'AAAACmg5w5SS08EG+kZulm0XCQ3w6UFihrdb7zxcKDntUmn9tS+jeSyUPwRfIVTpVHwy3z/jR6N7pxNRR2lCT4xI22IAAAAAXFMwUwAAAAAAAAAA5u7HOB8cX0WnqixnvzOlY3D3Y3oP0dYzzW9yWnVbm0ZTnewEh3yMG2akayxBgp4t1bOOKuTiShlrOugYXB6D0wAAAAkN4Lazp2QAAAAAAAAAAAXcAAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (8, '6839c39492d3c106fa466e966d17090df0e9416286b75bef3c5c2839ed5269fd', 'c8fae6f1206d0eea6c23cde4e5264c95660e4731536cd0041660ab59faad69ad', 1, 1, '2019-01-31 17:28:50', '2019-01-31 17:28:47.738555', '2019-01-31 17:28:47.738555', 34359738368, 15, 1000000000000000000, 1400, 100, 100000000, 10000, 10, 'AAAACsj65vEgbQ7qbCPN5OUmTJVmDkcxU2zQBBZgq1n6rWmtlIBwf3ktpOCrRVkxMCjx36fiboSDHs3+fzkglZuxgB4AAAAAXFMwUgAAAAAAAAAAAOMGyexOuevk4QIYCK/e2TLQXecE/TBuu1dvGQilTsPoEo1Bqh2y6JFEHyiV51Rm/R+7LcUu+s7WR2xPEtUp5gAAAAgN4Lazp2QAAAAAAAAAAAV4AAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (7, 'c8fae6f1206d0eea6c23cde4e5264c95660e4731536cd0041660ab59faad69ad', '24f6150933fe0248e1a4101daeaa2888d3a1b08c2877d4bf323d46c9523ef0c3', 1, 1, '2019-01-31 17:28:49', '2019-01-31 17:28:47.760251', '2019-01-31 17:28:47.760251', 30064771072, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACiT2FQkz/gJI4aQQHa6qKIjTobCMKHfUvzI9RslSPvDD4sjuqSPt86fPXM1X9v+jPBIwE2iktGhZlXqkw1HTwVgAAAAAXFMwUQAAAAAAAAAA8011I8cweiVULRk7g+I3eWM/k+U+svLLvJL8X9ENB1pkp2pX7zpl53J57T/2ogrbxX6CqCy9B+WX/WFxVgQGGQAAAAcN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (6, '24f6150933fe0248e1a4101daeaa2888d3a1b08c2877d4bf323d46c9523ef0c3', 'b9ce93831b645fbcfbccc819f6c6e3c98d3e8371d54fe135628f6f81d3003233', 1, 1, '2019-01-31 17:28:48', '2019-01-31 17:28:47.769884', '2019-01-31 17:28:47.769884', 25769803776, 15, 1000000000000000000, 1200, 100, 100000000, 10000, 10, 'AAAACrnOk4MbZF+8+8zIGfbG48mNPoNx1U/hNWKPb4HTADIz4j3B52m8FD7T9GHIKj+vpHEMjjjKY2IfOu8VtwKODVYAAAAAXFMwUAAAAAAAAAAAuQrPQr2qlNVpCIqhQaeQMsD9JWWNaxISOIwMsxvilIXUu8Yp4FRWPTL49BryjHJtmL3pLo6jdjsOd/CA2Yfc3wAAAAYN4Lazp2QAAAAAAAAAAASwAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (5, 'b9ce93831b645fbcfbccc819f6c6e3c98d3e8371d54fe135628f6f81d3003233', '854ac9cc380875323a155d119c44c12084e7367a155d225ece308b758cc646bb', 1, 1, '2019-01-31 17:28:47', '2019-01-31 17:28:47.778414', '2019-01-31 17:28:47.778415', 21474836480, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACoVKycw4CHUyOhVdEZxEwSCE5zZ6FV0iXs4wi3WMxka70knOba+4HcMXl6iaqmJxxqnjFI1wKEjPok5hRuoBGnYAAAAAXFMwTwAAAAAAAAAA5tFak/fh7MEOithjlydErBU0/M0ilaefu1Ub0Cw1cbTzFxb8gu9laovDtVVmRNNoVxTd4e0bs0SBD1ECsWqaTwAAAAUN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAABAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (4, '854ac9cc380875323a155d119c44c12084e7367a155d225ece308b758cc646bb', '6c953a331a2136b34e21e5988712b5c88c21847f7cfc00fa1b6d2dc1694c69e5', 2, 2, '2019-01-31 17:28:46', '2019-01-31 17:28:47.795829', '2019-01-31 17:28:47.79583', 17179869184, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACmyVOjMaITazTiHlmIcStciMIYR/fPwA+httLcFpTGnlM6EBvYLF+a0CwobSySR7+Dioi/z4gj3Wwo3Y9+BDGyEAAAAAXFMwTgAAAAAAAAAAN5B5W9KsxoOXOpscNNdDinauNxC/Pw2kk7DDUo0SXOXPxLn714AfsSEe0pKfjL13DcYiKYEzRv0GJwE1ah4YVgAAAAQN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (3, '6c953a331a2136b34e21e5988712b5c88c21847f7cfc00fa1b6d2dc1694c69e5', '62b9eda2731aeb01c0cdcf45a8c430e6a92a7b169ad4a14ec958f7dd451d986e', 4, 4, '2019-01-31 17:28:45', '2019-01-31 17:28:47.811687', '2019-01-31 17:28:47.811687', 12884901888, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACmK57aJzGusBwM3PRajEMOapKnsWmtShTslY991FHZhua6SiTUwaTmOC8+8pIgozz4AqirhmyU9YEJhghKbQTz0AAAAAXFMwTQAAAAAAAAAAeTL8Iw0ShUy6czNHgs+G27QvHrgowubFTPRxK2ziKfG3ovsYVK3zQZ6ZE5ZmN4iN90lIWuQ12CA+YvPeVwJiewAAAAMN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (2, '62b9eda2731aeb01c0cdcf45a8c430e6a92a7b169ad4a14ec958f7dd451d986e', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 4, 4, '2019-01-31 17:28:44', '2019-01-31 17:28:47.822945', '2019-01-31 17:28:47.822945', 8589934592, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76Zh82QgsuASmS0xgFp1fjiT2wNgvVWRClSW5uMP344SMoAAAAAXFMwTAAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAh7rHH3ehqvUsWEPfAlvVspAA2/ZR0JjPAFIdHbQ2sSjCdKNX9bX7oXl4uO6TSACchqtoxD28NFm6aqXf4rFVngAAAAIN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:28:47.837822', '2019-01-31 17:28:47.837822', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -779,6 +850,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -814,6 +892,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -490,6 +555,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -559,13 +630,13 @@ INSERT INTO history_effects VALUES (2, 8589942785, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (7, 'b1d02e347ae6080b21c35a80010dcdae76faef533187391682b8f22e6419466e', '9e95818fda74c6b193fe7dcfa858114cc0e5404e38c4a20017185260903e1439', 3, 3, '2019-01-31 17:30:08', '2019-01-31 17:30:06.73473', '2019-01-31 17:30:06.734731', 30064771072, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACp6VgY/adMaxk/59z6hYEUzA5UBOOMSiABcYUmCQPhQ5rvbHlW5ajyOEfI7cf7IGXrAp6L7IWF7Kt68XjfNO6ecAAAAAXFMwoAAAAAAAAAAAL150/k9Xfs0TJQCfIu9DCsOEki1Kn+hL2IFyyLVMdXLEB2MGY6rMLIkxQcomqp1ybCJ4PzHuCHXr+ewmBOWJhQAAAAcN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (6, '9e95818fda74c6b193fe7dcfa858114cc0e5404e38c4a20017185260903e1439', '6b9a63dc55604ce1dfe67398d8edbd1a6ef32829b33c4e1f7a3f697f0df3797a', 2, 2, '2019-01-31 17:30:07', '2019-01-31 17:30:06.757613', '2019-01-31 17:30:06.757613', 25769803776, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACmuaY9xVYEzh3+ZzmNjtvRpu8ygpszxOH3o/aX8N83l61gSUjNWXD+108fVGssC+7OeriKIhkNITy+k3WDVp6sIAAAAAXFMwnwAAAAAAAAAA+GFZHqUIknS3p5sZb4czc8Kj0abGSMVfNaq+9zzlt2Qa+3OQLLSWjro7W7rsZlSYRiv+feLzghIAs63tZupVGgAAAAYN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (5, '6b9a63dc55604ce1dfe67398d8edbd1a6ef32829b33c4e1f7a3f697f0df3797a', '6ca0eca60b325069f725c1a03f6bd1e07b15728e4c67c032722e89155134a1e8', 4, 4, '2019-01-31 17:30:06', '2019-01-31 17:30:06.7773', '2019-01-31 17:30:06.7773', 21474836480, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACmyg7KYLMlBp9yXBoD9r0eB7FXKOTGfAMnIuiRVRNKHoutBtcp76YUAr+469DtiGfIx7ywlyOQ8j8WFDp0ywLkAAAAAAXFMwngAAAAAAAAAAK7ZFHpUv5xgPNV5PVN8eW9yyrJcBUZDK2CSKHdvPgtjeB/gSgMYprEMjthoWsvrlHcCCA6fjrfKLRYGvGMNzPgAAAAUN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (4, '6ca0eca60b325069f725c1a03f6bd1e07b15728e4c67c032722e89155134a1e8', '81670066bd0a9e5e9dd4318e78ce42679e4e36cdff3899914495d67ab8cb7966', 1, 1, '2019-01-31 17:30:05', '2019-01-31 17:30:06.790002', '2019-01-31 17:30:06.790003', 17179869184, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACoFnAGa9Cp5endQxjnjOQmeeTjbN/ziZkUSV1nq4y3lmk3N0vYIBmuvdRZlClEg30lymw2PyowvhbEYWK4UuYbIAAAAAXFMwnQAAAAAAAAAA9Nf75AfzKHcfz2nf3wc7i1MpbTisZhsly7MED4o1H3lalimAdjpo264/yo1vjG0rXRDdCPlDcK2p6xWkvGeI4QAAAAQN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (3, '81670066bd0a9e5e9dd4318e78ce42679e4e36cdff3899914495d67ab8cb7966', '9f1a37fd1fa54668d76db3eecce76148ab333548ec1eca8c01a413d632d78cf9', 1, 1, '2019-01-31 17:30:04', '2019-01-31 17:30:06.803019', '2019-01-31 17:30:06.803019', 12884901888, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACp8aN/0fpUZo122z7sznYUirMzVI7B7KjAGkE9Yy14z5TJVoaZ6TCmCSROxKK9/FUifOAZIkgUTB7ae5RosAmLUAAAAAXFMwnAAAAAAAAAAAzpWxfUdkwpI+mahMUGVw0hP2fK7oFHG9i01PTevvzr9Qt1iRXw0eiRKIuCQLtID1Y3DrEWIqLp9B8ejufUw3SQAAAAMN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (2, '9f1a37fd1fa54668d76db3eecce76148ab333548ec1eca8c01a413d632d78cf9', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 2, 2, '2019-01-31 17:30:03', '2019-01-31 17:30:06.81687', '2019-01-31 17:30:06.816871', 8589934592, 15, 1000000000000000000, 200, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZRZSEryEgGUi8Runb12uW8dKmZaWm1LdHQ2+tzinFtaAAAAAAXFMwmwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAA+9VGePGCiconnKwGWQgSrBOnhFsIJOmYJO/8HociG0QafJa3tW58ocSqZx1UYdfLEs1lAgDNKw/ZUm8CHfQ84wAAAAIN4Lazp2QAAAAAAAAAAADIAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:30:06.830858', '2019-01-31 17:30:06.830858', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (7, 'b1d02e347ae6080b21c35a80010dcdae76faef533187391682b8f22e6419466e', '9e95818fda74c6b193fe7dcfa858114cc0e5404e38c4a20017185260903e1439', 3, 3, '2019-01-31 17:30:08', '2019-01-31 17:30:06.73473', '2019-01-31 17:30:06.734731', 30064771072, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACp6VgY/adMaxk/59z6hYEUzA5UBOOMSiABcYUmCQPhQ5rvbHlW5ajyOEfI7cf7IGXrAp6L7IWF7Kt68XjfNO6ecAAAAAXFMwoAAAAAAAAAAAL150/k9Xfs0TJQCfIu9DCsOEki1Kn+hL2IFyyLVMdXLEB2MGY6rMLIkxQcomqp1ybCJ4PzHuCHXr+ewmBOWJhQAAAAcN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (6, '9e95818fda74c6b193fe7dcfa858114cc0e5404e38c4a20017185260903e1439', '6b9a63dc55604ce1dfe67398d8edbd1a6ef32829b33c4e1f7a3f697f0df3797a', 2, 2, '2019-01-31 17:30:07', '2019-01-31 17:30:06.757613', '2019-01-31 17:30:06.757613', 25769803776, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAACmuaY9xVYEzh3+ZzmNjtvRpu8ygpszxOH3o/aX8N83l61gSUjNWXD+108fVGssC+7OeriKIhkNITy+k3WDVp6sIAAAAAXFMwnwAAAAAAAAAA+GFZHqUIknS3p5sZb4czc8Kj0abGSMVfNaq+9zzlt2Qa+3OQLLSWjro7W7rsZlSYRiv+feLzghIAs63tZupVGgAAAAYN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (5, '6b9a63dc55604ce1dfe67398d8edbd1a6ef32829b33c4e1f7a3f697f0df3797a', '6ca0eca60b325069f725c1a03f6bd1e07b15728e4c67c032722e89155134a1e8', 4, 4, '2019-01-31 17:30:06', '2019-01-31 17:30:06.7773', '2019-01-31 17:30:06.7773', 21474836480, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACmyg7KYLMlBp9yXBoD9r0eB7FXKOTGfAMnIuiRVRNKHoutBtcp76YUAr+469DtiGfIx7ywlyOQ8j8WFDp0ywLkAAAAAAXFMwngAAAAAAAAAAK7ZFHpUv5xgPNV5PVN8eW9yyrJcBUZDK2CSKHdvPgtjeB/gSgMYprEMjthoWsvrlHcCCA6fjrfKLRYGvGMNzPgAAAAUN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (4, '6ca0eca60b325069f725c1a03f6bd1e07b15728e4c67c032722e89155134a1e8', '81670066bd0a9e5e9dd4318e78ce42679e4e36cdff3899914495d67ab8cb7966', 1, 1, '2019-01-31 17:30:05', '2019-01-31 17:30:06.790002', '2019-01-31 17:30:06.790003', 17179869184, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACoFnAGa9Cp5endQxjnjOQmeeTjbN/ziZkUSV1nq4y3lmk3N0vYIBmuvdRZlClEg30lymw2PyowvhbEYWK4UuYbIAAAAAXFMwnQAAAAAAAAAA9Nf75AfzKHcfz2nf3wc7i1MpbTisZhsly7MED4o1H3lalimAdjpo264/yo1vjG0rXRDdCPlDcK2p6xWkvGeI4QAAAAQN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (3, '81670066bd0a9e5e9dd4318e78ce42679e4e36cdff3899914495d67ab8cb7966', '9f1a37fd1fa54668d76db3eecce76148ab333548ec1eca8c01a413d632d78cf9', 1, 1, '2019-01-31 17:30:04', '2019-01-31 17:30:06.803019', '2019-01-31 17:30:06.803019', 12884901888, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACp8aN/0fpUZo122z7sznYUirMzVI7B7KjAGkE9Yy14z5TJVoaZ6TCmCSROxKK9/FUifOAZIkgUTB7ae5RosAmLUAAAAAXFMwnAAAAAAAAAAAzpWxfUdkwpI+mahMUGVw0hP2fK7oFHG9i01PTevvzr9Qt1iRXw0eiRKIuCQLtID1Y3DrEWIqLp9B8ejufUw3SQAAAAMN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (2, '9f1a37fd1fa54668d76db3eecce76148ab333548ec1eca8c01a413d632d78cf9', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 2, 2, '2019-01-31 17:30:03', '2019-01-31 17:30:06.81687', '2019-01-31 17:30:06.816871', 8589934592, 15, 1000000000000000000, 200, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZRZSEryEgGUi8Runb12uW8dKmZaWm1LdHQ2+tzinFtaAAAAAAXFMwmwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAA+9VGePGCiconnKwGWQgSrBOnhFsIJOmYJO/8HociG0QafJa3tW58ocSqZx1UYdfLEs1lAgDNKw/ZUm8CHfQ84wAAAAIN4Lazp2QAAAAAAAAAAADIAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:30:06.830858', '2019-01-31 17:30:06.830858', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -773,6 +844,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -808,6 +886,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
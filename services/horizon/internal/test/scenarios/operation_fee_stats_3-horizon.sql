@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -490,6 +555,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -551,15 +622,15 @@ INSERT INTO history_effects VALUES (2, 12884905985, 3, 10, '{"weight": 1, "publi
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (9, 'f7986e6389684963c0b93dc39e66c46f6c29f19288bb71db7f8d2aedcc33676b', '3c8b79069f96bf1682eb33c15450bf0dbc3b511fbaa60860c1e03029220a943d', 3, 3, '2019-01-31 17:30:50', '2019-01-31 17:30:46.98873', '2019-01-31 17:30:46.98873', 38654705664, 15, 1000000000000000000, 2800, 100, 100000000, 10000, 10, 'AAAACjyLeQaflr8WguszwVRQvw28O1EfuqYIYMHgMCkiCpQ9iJRYVXXaA7XYpA4YCIHCSyO65X1tyUZWfTpdjVPLCNwAAAAAXFMwygAAAAAAAAAAlRBwKKjfR5xdJCXk5u2vvF15ELn2jevv3V74iKKQ/oyyMivRV2IH2hh2/VjptlmIbJ6E/oHvlhS6UZvmMUuQAAAAAAkN4Lazp2QAAAAAAAAAAArwAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (8, '3c8b79069f96bf1682eb33c15450bf0dbc3b511fbaa60860c1e03029220a943d', '7ef675c17db2fe97e4316798821084afa9402ecc8034eb3719930833df233a0f', 1, 1, '2019-01-31 17:30:49', '2019-01-31 17:30:47.010045', '2019-01-31 17:30:47.010045', 34359738368, 15, 1000000000000000000, 1600, 100, 100000000, 10000, 10, 'AAAACn72dcF9sv6X5DFnmIIQhK+pQC7MgDTrNxmTCDPfIzoPpvU/C5w3PBy7d3poypTuJPX3LYcSKPVu4A8PT3RHNesAAAAAXFMwyQAAAAAAAAAAWalzBHdM7Le5KvQTcgzv9hcDI5gG4ELqA/2UGy0Vg5vCx8Qpaa8ZwoLHTOleK+wx2eFHN8OVKSz8tL7JeaNLWAAAAAgN4Lazp2QAAAAAAAAAAAZAAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (7, '7ef675c17db2fe97e4316798821084afa9402ecc8034eb3719930833df233a0f', 'd7dc15dfdeda2515ab683068cf614cb827babeb94ab62cff1548a95a9962a8e5', 1, 1, '2019-01-31 17:30:48', '2019-01-31 17:30:47.023378', '2019-01-31 17:30:47.023378', 30064771072, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACtfcFd/e2iUVq2gwaM9hTLgnur65SrYs/xVIqVqZYqjl9R7fG/ddJf/MnHk368u8626qxLe8TflKAxDVlQrLPHsAAAAAXFMwyAAAAAAAAAAAZXUlPyMD3GLCCeTjseI5F+u/cQkuZAbr1H9/6uMlamgbKKND3qSLZ+17gCPoCSQp1yaskPJ5Rj1UmBTLcjsRmgAAAAcN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (6, 'd7dc15dfdeda2515ab683068cf614cb827babeb94ab62cff1548a95a9962a8e5', 'df15c98605cc1cf99f665e134db65c9bd6c1a8cdfca4893166db16a0399b6c66', 1, 1, '2019-01-31 17:30:47', '2019-01-31 17:30:47.036093', '2019-01-31 17:30:47.036094', 25769803776, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACt8VyYYFzBz5n2ZeE022XJvWwajN/KSJMWbbFqA5m2xmVazZnMuJHH+2WcipMYW7upvaQ+Ya2vhiP36ZG+3zCjkAAAAAXFMwxwAAAAAAAAAAcz1dvtlmE0JFAnmRFpJ+t05dyQwWtQfYMwc2evQtgAy3OBnW5VDIJwEwxm4mP6DvFfBnPyjlFQY0Pz+yG/L29wAAAAYN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (5, 'df15c98605cc1cf99f665e134db65c9bd6c1a8cdfca4893166db16a0399b6c66', '7d1232ba61adae95c77a0d7a4be3ad300ab821fe7f469894323e84d6a2a291cf', 1, 1, '2019-01-31 17:30:46', '2019-01-31 17:30:47.047083', '2019-01-31 17:30:47.047083', 21474836480, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAACn0SMrphra6Vx3oNekvjrTAKuCH+f0aYlDI+hNaiopHP7MgOTVxeMPUhxR5CpWpYJW7bCcMEGcaU0xYRAVsKAlcAAAAAXFMwxgAAAAAAAAAAsEbOveIUVek3pDH/IgRF1Ya9/uZQeKtkLQ8lH+0iT1y6v6JYfprH7ftDEaIPU6w3NyIxRFOKBg9CQ2dvZ9hIzwAAAAUN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (4, '7d1232ba61adae95c77a0d7a4be3ad300ab821fe7f469894323e84d6a2a291cf', '6313a10a7a7492621c5232518bd49acd5f9ecd729e41634c8ee1580050ac6cd2', 1, 2, '2019-01-31 17:30:45', '2019-01-31 17:30:47.057985', '2019-01-31 17:30:47.057985', 17179869184, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmMToQp6dJJiHFIyUYvUms1fns1ynkFjTI7hWABQrGzSRJKf1Og9hUdHg1oaLs/nJOV6vBYMHw+jTgSPrcDcIhwAAAAAXFMwxQAAAAAAAAAAvUi8p6JAmXRap5H687O+OXcAKN2//CsLZbyxWLVlZb+0RfJ/Nvllj00/4h41ZJ1lDhbM/ZDW+70R5o6z743tCAAAAAQN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (3, '6313a10a7a7492621c5232518bd49acd5f9ecd729e41634c8ee1580050ac6cd2', '7bf6845cb1c662b92ab4ac070958bfff8867373371fb9f24347ff67dfa728b44', 1, 1, '2019-01-31 17:30:44', '2019-01-31 17:30:47.06977', '2019-01-31 17:30:47.06977', 12884901888, 15, 1000000000000000000, 100, 100, 100000000, 10000, 10, 'AAAACnv2hFyxxmK5KrSsBwlYv/+IZzczcfufJDR/9n36cotEIjbfBjmi06gt40/epVzE+Ws+55qpFJb3WYclejYSf/UAAAAAXFMwxAAAAAAAAAAAfFnUZMxpcaRFgW684JniUG/dzZ5jn4eP2mZ8LIGonSowGo/j+rR2YzF936gWRwAd8ZvJsdgZggnOuIg5paGlSwAAAAMN4Lazp2QAAAAAAAAAAABkAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (2, '7bf6845cb1c662b92ab4ac070958bfff8867373371fb9f24347ff67dfa728b44', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 0, 0, '2019-01-31 17:30:43', '2019-01-31 17:30:47.080688', '2019-01-31 17:30:47.080688', 8589934592, 15, 1000000000000000000, 0, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZuZRHr9UdXKbTKiclfOjy72YZFJUkJPVcKT5htvorm1QAAAAAXFMwwwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERlzUiftOYRhKRI3aHsIRGqiybCW4MmKRi2t2lafBd0khAAAAAIN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:30:47.087424', '2019-01-31 17:30:47.087424', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (9, 'f7986e6389684963c0b93dc39e66c46f6c29f19288bb71db7f8d2aedcc33676b', '3c8b79069f96bf1682eb33c15450bf0dbc3b511fbaa60860c1e03029220a943d', 3, 3, '2019-01-31 17:30:50', '2019-01-31 17:30:46.98873', '2019-01-31 17:30:46.98873', 38654705664, 15, 1000000000000000000, 2800, 100, 100000000, 10000, 10, 'AAAACjyLeQaflr8WguszwVRQvw28O1EfuqYIYMHgMCkiCpQ9iJRYVXXaA7XYpA4YCIHCSyO65X1tyUZWfTpdjVPLCNwAAAAAXFMwygAAAAAAAAAAlRBwKKjfR5xdJCXk5u2vvF15ELn2jevv3V74iKKQ/oyyMivRV2IH2hh2/VjptlmIbJ6E/oHvlhS6UZvmMUuQAAAAAAkN4Lazp2QAAAAAAAAAAArwAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (8, '3c8b79069f96bf1682eb33c15450bf0dbc3b511fbaa60860c1e03029220a943d', '7ef675c17db2fe97e4316798821084afa9402ecc8034eb3719930833df233a0f', 1, 1, '2019-01-31 17:30:49', '2019-01-31 17:30:47.010045', '2019-01-31 17:30:47.010045', 34359738368, 15, 1000000000000000000, 1600, 100, 100000000, 10000, 10, 'AAAACn72dcF9sv6X5DFnmIIQhK+pQC7MgDTrNxmTCDPfIzoPpvU/C5w3PBy7d3poypTuJPX3LYcSKPVu4A8PT3RHNesAAAAAXFMwyQAAAAAAAAAAWalzBHdM7Le5KvQTcgzv9hcDI5gG4ELqA/2UGy0Vg5vCx8Qpaa8ZwoLHTOleK+wx2eFHN8OVKSz8tL7JeaNLWAAAAAgN4Lazp2QAAAAAAAAAAAZAAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (7, '7ef675c17db2fe97e4316798821084afa9402ecc8034eb3719930833df233a0f', 'd7dc15dfdeda2515ab683068cf614cb827babeb94ab62cff1548a95a9962a8e5', 1, 1, '2019-01-31 17:30:48', '2019-01-31 17:30:47.023378', '2019-01-31 17:30:47.023378', 30064771072, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACtfcFd/e2iUVq2gwaM9hTLgnur65SrYs/xVIqVqZYqjl9R7fG/ddJf/MnHk368u8626qxLe8TflKAxDVlQrLPHsAAAAAXFMwyAAAAAAAAAAAZXUlPyMD3GLCCeTjseI5F+u/cQkuZAbr1H9/6uMlamgbKKND3qSLZ+17gCPoCSQp1yaskPJ5Rj1UmBTLcjsRmgAAAAcN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (6, 'd7dc15dfdeda2515ab683068cf614cb827babeb94ab62cff1548a95a9962a8e5', 'df15c98605cc1cf99f665e134db65c9bd6c1a8cdfca4893166db16a0399b6c66', 1, 1, '2019-01-31 17:30:47', '2019-01-31 17:30:47.036093', '2019-01-31 17:30:47.036094', 25769803776, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACt8VyYYFzBz5n2ZeE022XJvWwajN/KSJMWbbFqA5m2xmVazZnMuJHH+2WcipMYW7upvaQ+Ya2vhiP36ZG+3zCjkAAAAAXFMwxwAAAAAAAAAAcz1dvtlmE0JFAnmRFpJ+t05dyQwWtQfYMwc2evQtgAy3OBnW5VDIJwEwxm4mP6DvFfBnPyjlFQY0Pz+yG/L29wAAAAYN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (5, 'df15c98605cc1cf99f665e134db65c9bd6c1a8cdfca4893166db16a0399b6c66', '7d1232ba61adae95c77a0d7a4be3ad300ab821fe7f469894323e84d6a2a291cf', 1, 1, '2019-01-31 17:30:46', '2019-01-31 17:30:47.047083', '2019-01-31 17:30:47.047083', 21474836480, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAACn0SMrphra6Vx3oNekvjrTAKuCH+f0aYlDI+hNaiopHP7MgOTVxeMPUhxR5CpWpYJW7bCcMEGcaU0xYRAVsKAlcAAAAAXFMwxgAAAAAAAAAAsEbOveIUVek3pDH/IgRF1Ya9/uZQeKtkLQ8lH+0iT1y6v6JYfprH7ftDEaIPU6w3NyIxRFOKBg9CQ2dvZ9hIzwAAAAUN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (4, '7d1232ba61adae95c77a0d7a4be3ad300ab821fe7f469894323e84d6a2a291cf', '6313a10a7a7492621c5232518bd49acd5f9ecd729e41634c8ee1580050ac6cd2', 1, 2, '2019-01-31 17:30:45', '2019-01-31 17:30:47.057985', '2019-01-31 17:30:47.057985', 17179869184, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmMToQp6dJJiHFIyUYvUms1fns1ynkFjTI7hWABQrGzSRJKf1Og9hUdHg1oaLs/nJOV6vBYMHw+jTgSPrcDcIhwAAAAAXFMwxQAAAAAAAAAAvUi8p6JAmXRap5H687O+OXcAKN2//CsLZbyxWLVlZb+0RfJ/Nvllj00/4h41ZJ1lDhbM/ZDW+70R5o6z743tCAAAAAQN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (3, '6313a10a7a7492621c5232518bd49acd5f9ecd729e41634c8ee1580050ac6cd2', '7bf6845cb1c662b92ab4ac070958bfff8867373371fb9f24347ff67dfa728b44', 1, 1, '2019-01-31 17:30:44', '2019-01-31 17:30:47.06977', '2019-01-31 17:30:47.06977', 12884901888, 15, 1000000000000000000, 100, 100, 100000000, 10000, 10, 'AAAACnv2hFyxxmK5KrSsBwlYv/+IZzczcfufJDR/9n36cotEIjbfBjmi06gt40/epVzE+Ws+55qpFJb3WYclejYSf/UAAAAAXFMwxAAAAAAAAAAAfFnUZMxpcaRFgW684JniUG/dzZ5jn4eP2mZ8LIGonSowGo/j+rR2YzF936gWRwAd8ZvJsdgZggnOuIg5paGlSwAAAAMN4Lazp2QAAAAAAAAAAABkAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (2, '7bf6845cb1c662b92ab4ac070958bfff8867373371fb9f24347ff67dfa728b44', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 0, 0, '2019-01-31 17:30:43', '2019-01-31 17:30:47.080688', '2019-01-31 17:30:47.080688', 8589934592, 15, 1000000000000000000, 0, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZuZRHr9UdXKbTKiclfOjy72YZFJUkJPVcKT5htvorm1QAAAAAXFMwwwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERlzUiftOYRhKRI3aHsIRGqiybCW4MmKRi2t2lafBd0khAAAAAIN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:30:47.087424', '2019-01-31 17:30:47.087424', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -746,6 +817,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -781,6 +859,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
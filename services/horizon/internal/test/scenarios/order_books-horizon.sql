@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -492,6 +557,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -557,12 +628,12 @@ INSERT INTO history_effects VALUES (2, 8589946881, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (6, '4e3373ec7c45f34071e62a123a161e807dbe399260484537a64e14a034b7b37a', '8fe601660cee14ca512b0515b3466cfa7b8f580a63267a17246b8e5b8ab3716e', 6, 6, '2019-01-31 17:28:33', '2019-01-31 17:28:32.883044', '2019-01-31 17:28:32.883044', 25769803776, 15, 1000000000000000000, 2300, 100, 100000000, 10000, 10, 'AAAACo/mAWYM7hTKUSsFFbNGbPp7j1gKYyZ6FyRrjluKs3FuQ7Ck67QlMOFZQJBzWBIfyy94Tv0bafiMVnOKrsXD/90AAAAAXFMwQQAAAAAAAAAAO03rkSSGII+TmdA0r8T7dSNCWamYkyOlDZ73gpuryU8710WOFQS8WiJZAeModKWtsoFH8RBmhdwuZqdWTic8pQAAAAYN4Lazp2QAAAAAAAAAAAj8AAAAAAAAAAAAAAAMAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 6, 0);
-INSERT INTO history_ledgers VALUES (5, '8fe601660cee14ca512b0515b3466cfa7b8f580a63267a17246b8e5b8ab3716e', 'c73a1a1ee5453af888c57019dba8493ec7f1b3d578ab42a9ab7350ec1c7dc57e', 6, 6, '2019-01-31 17:28:32', '2019-01-31 17:28:32.90281', '2019-01-31 17:28:32.902811', 21474836480, 15, 1000000000000000000, 1700, 100, 100000000, 10000, 10, 'AAAACsc6Gh7lRTr4iMVwGduoST7H8bPVeKtCqatzUOwcfcV+8q68GALdrjvaRm2FnB3Fg631B3sgg+WiciFxPPd1358AAAAAXFMwQAAAAAAAAAAAIMV0QzQRdUmn10wWB1qZt+ll6BH0Tj/XNrUL4wpqrGuqFM4lz4R28kfCEs37veboNloo9yvtcxouuyr5qvK/OwAAAAUN4Lazp2QAAAAAAAAAAAakAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 6, 0);
-INSERT INTO history_ledgers VALUES (4, 'c73a1a1ee5453af888c57019dba8493ec7f1b3d578ab42a9ab7350ec1c7dc57e', 'f65e4e00085dbe06434fbd09f8607f21b5b96a06a64ad758cf3c85e8ead804a0', 4, 4, '2019-01-31 17:28:31', '2019-01-31 17:28:32.915439', '2019-01-31 17:28:32.915439', 17179869184, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACvZeTgAIXb4GQ0+9CfhgfyG1uWoGpkrXWM88hejq2ASgl2vJrkN/Tmy/k/af3lNh1RAkv0Qd0qoXLYPB+N1/PS8AAAAAXFMwPwAAAAAAAAAAEvM5tT8l0dTEZQLsPGomBGcTJkGZVcTwRLD10i4EXb9IEOc9EtYehz0BXvP6m42VU/t8KSwV1psDkaPW58QhXgAAAAQN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (3, 'f65e4e00085dbe06434fbd09f8607f21b5b96a06a64ad758cf3c85e8ead804a0', 'ef174c121f14ee2864028827f5e977d958f8df915cc60df3fcca0bcbdac6900b', 4, 4, '2019-01-31 17:28:30', '2019-01-31 17:28:32.928434', '2019-01-31 17:28:32.928434', 12884901888, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAACu8XTBIfFO4oZAKIJ/Xpd9lY+N+RXMYN8/zKC8vaxpALgzCPKMxO7Z8VkSnWxyFSylBGzYfZdi4xMDI0YwjAhEkAAAAAXFMwPgAAAAAAAAAAscFlow9fsw7DsFjGhjjPxcSMdjdLNWUz34mmgDWxHBZ+wGQz5wMjtV4InEXVvEQP+zGSEF+sw/zJHLuian2bAgAAAAMN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (2, 'ef174c121f14ee2864028827f5e977d958f8df915cc60df3fcca0bcbdac6900b', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 3, 3, '2019-01-31 17:28:29', '2019-01-31 17:28:32.942313', '2019-01-31 17:28:32.942313', 8589934592, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZCS8URcyE4gRYsZAdb7e4uHwbaA5fhFIEcA1CSR23Z5oAAAAAXFMwPQAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAzi1xgnE3PAfb2CbU6dwiHL3p/nbMw7WpXJpWKu4ASug/J66yoSmncDm/ZibPQsmqZ6XGb6Vj/E0UaOKHzJtZnwAAAAIN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:28:32.952761', '2019-01-31 17:28:32.952761', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (6, '4e3373ec7c45f34071e62a123a161e807dbe399260484537a64e14a034b7b37a', '8fe601660cee14ca512b0515b3466cfa7b8f580a63267a17246b8e5b8ab3716e', 6, 6, '2019-01-31 17:28:33', '2019-01-31 17:28:32.883044', '2019-01-31 17:28:32.883044', 25769803776, 15, 1000000000000000000, 2300, 100, 100000000, 10000, 10, 'AAAACo/mAWYM7hTKUSsFFbNGbPp7j1gKYyZ6FyRrjluKs3FuQ7Ck67QlMOFZQJBzWBIfyy94Tv0bafiMVnOKrsXD/90AAAAAXFMwQQAAAAAAAAAAO03rkSSGII+TmdA0r8T7dSNCWamYkyOlDZ73gpuryU8710WOFQS8WiJZAeModKWtsoFH8RBmhdwuZqdWTic8pQAAAAYN4Lazp2QAAAAAAAAAAAj8AAAAAAAAAAAAAAAMAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 6, 0, false);
+INSERT INTO history_ledgers VALUES (5, '8fe601660cee14ca512b0515b3466cfa7b8f580a63267a17246b8e5b8ab3716e', 'c73a1a1ee5453af888c57019dba8493ec7f1b3d578ab42a9ab7350ec1c7dc57e', 6, 6, '2019-01-31 17:28:32', '2019-01-31 17:28:32.90281', '2019-01-31 17:28:32.902811', 21474836480, 15, 1000000000000000000, 1700, 100, 100000000, 10000, 10, 'AAAACsc6Gh7lRTr4iMVwGduoST7H8bPVeKtCqatzUOwcfcV+8q68GALdrjvaRm2FnB3Fg631B3sgg+WiciFxPPd1358AAAAAXFMwQAAAAAAAAAAAIMV0QzQRdUmn10wWB1qZt+ll6BH0Tj/XNrUL4wpqrGuqFM4lz4R28kfCEs37veboNloo9yvtcxouuyr5qvK/OwAAAAUN4Lazp2QAAAAAAAAAAAakAAAAAAAAAAAAAAAGAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 6, 0, false);
+INSERT INTO history_ledgers VALUES (4, 'c73a1a1ee5453af888c57019dba8493ec7f1b3d578ab42a9ab7350ec1c7dc57e', 'f65e4e00085dbe06434fbd09f8607f21b5b96a06a64ad758cf3c85e8ead804a0', 4, 4, '2019-01-31 17:28:31', '2019-01-31 17:28:32.915439', '2019-01-31 17:28:32.915439', 17179869184, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACvZeTgAIXb4GQ0+9CfhgfyG1uWoGpkrXWM88hejq2ASgl2vJrkN/Tmy/k/af3lNh1RAkv0Qd0qoXLYPB+N1/PS8AAAAAXFMwPwAAAAAAAAAAEvM5tT8l0dTEZQLsPGomBGcTJkGZVcTwRLD10i4EXb9IEOc9EtYehz0BXvP6m42VU/t8KSwV1psDkaPW58QhXgAAAAQN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (3, 'f65e4e00085dbe06434fbd09f8607f21b5b96a06a64ad758cf3c85e8ead804a0', 'ef174c121f14ee2864028827f5e977d958f8df915cc60df3fcca0bcbdac6900b', 4, 4, '2019-01-31 17:28:30', '2019-01-31 17:28:32.928434', '2019-01-31 17:28:32.928434', 12884901888, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAACu8XTBIfFO4oZAKIJ/Xpd9lY+N+RXMYN8/zKC8vaxpALgzCPKMxO7Z8VkSnWxyFSylBGzYfZdi4xMDI0YwjAhEkAAAAAXFMwPgAAAAAAAAAAscFlow9fsw7DsFjGhjjPxcSMdjdLNWUz34mmgDWxHBZ+wGQz5wMjtV4InEXVvEQP+zGSEF+sw/zJHLuian2bAgAAAAMN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (2, 'ef174c121f14ee2864028827f5e977d958f8df915cc60df3fcca0bcbdac6900b', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 3, 3, '2019-01-31 17:28:29', '2019-01-31 17:28:32.942313', '2019-01-31 17:28:32.942313', 8589934592, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZCS8URcyE4gRYsZAdb7e4uHwbaA5fhFIEcA1CSR23Z5oAAAAAXFMwPQAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAzi1xgnE3PAfb2CbU6dwiHL3p/nbMw7WpXJpWKu4ASug/J66yoSmncDm/ZibPQsmqZ6XGb6Vj/E0UaOKHzJtZnwAAAAIN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:28:32.952761', '2019-01-31 17:28:32.952761', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -798,6 +869,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -833,6 +911,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
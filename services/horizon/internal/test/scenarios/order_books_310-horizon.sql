@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -492,6 +557,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -557,11 +628,11 @@ INSERT INTO history_effects VALUES (3, 8589946881, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (5, '5f325210e6342eed740dfbb5c7b6670b72d04e5ef4081662fc67642f00b5c365', 'e1b6898ddc5ca65cd35b4df51e5b39c5173335bf68d3d47d4de77b87dbdfffd3', 33, 33, '2019-01-31 17:30:14', '2019-01-31 17:30:15.14928', '2019-01-31 17:30:15.14928', 21474836480, 15, 1000000000000000000, 4400, 100, 100000000, 10000, 10, 'AAAACuG2iY3cXKZc01tN9R5bOcUXMzW/aNPUfU3ne4fb3//T2zRcf7XmQY5Plcl6hIg840eUs8Et8hRwrWsmPw7aPUIAAAAAXFMwpgAAAAAAAAAAJ4/Gr554ZVHMujN++AAdhrv5CCDQrTNFeytjikmekx3GWvZp+yvZ3JMG/nO7VPf0O1l1GYVcpXUv+POcA5TiKgAAAAUN4Lazp2QAAAAAAAAAABEwAAAAAAAAAAAAAAAhAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 33, 0);
-INSERT INTO history_ledgers VALUES (4, 'e1b6898ddc5ca65cd35b4df51e5b39c5173335bf68d3d47d4de77b87dbdfffd3', 'ca3c27d540529dbbc5df38890cbb2fb1e9a1cee68fe6e7465a8b388dda2655ee', 4, 4, '2019-01-31 17:30:13', '2019-01-31 17:30:15.187076', '2019-01-31 17:30:15.187076', 17179869184, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACso8J9VAUp27xd84iQy7L7Hpoc7mj+bnRlqLOI3aJlXu8dqQUFp7Y3d934N8v8IfRkG/4eRPdoN31g442ghadLgAAAAAXFMwpQAAAAAAAAAA9P74c8f1xfd4wX2Dasj3a3ZXngqhfaJ2yA7eEO3Lts+/EjoVYEGkogDZDwjkmRTPO+y4gLWsWVKb9XnEZEvnRAAAAAQN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (3, 'ca3c27d540529dbbc5df38890cbb2fb1e9a1cee68fe6e7465a8b388dda2655ee', 'f86efbd8b576310cf199c035f55c9125a9af28a6aa0e40f7f84eac4ba7f70942', 4, 4, '2019-01-31 17:30:12', '2019-01-31 17:30:15.201629', '2019-01-31 17:30:15.201629', 12884901888, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAACvhu+9i1djEM8ZnANfVckSWpryimqg5A9/hOrEun9wlC9s0hRwlXCq/TEOyRBi1BMXEOAWmmIOdlDDoMP4TNj/sAAAAAXFMwpAAAAAAAAAAAgzaJz4Xq1aG8spy1oEm7qAluf8fQetm330Mf8Zmid+BUZGQDuT/r9+dklMDPWbAIYZinF6LrexrlSouwy7vF1gAAAAMN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (2, 'f86efbd8b576310cf199c035f55c9125a9af28a6aa0e40f7f84eac4ba7f70942', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 3, 3, '2019-01-31 17:30:11', '2019-01-31 17:30:15.214464', '2019-01-31 17:30:15.214464', 8589934592, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZjdLwAXsL2eIh4K+qv7MhQiH7W7LZaUq9IK+/p5Db8bMAAAAAXFMwowAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAA5YwG7hzcH7+TYJ0iG4Sklbds5327gq5uPLExV/3yG/3zKqCFlFyUUxeIuSI552Tm/KyO+V+GCQQEm90iGXpYrwAAAAIN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:30:15.227431', '2019-01-31 17:30:15.227431', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (5, '5f325210e6342eed740dfbb5c7b6670b72d04e5ef4081662fc67642f00b5c365', 'e1b6898ddc5ca65cd35b4df51e5b39c5173335bf68d3d47d4de77b87dbdfffd3', 33, 33, '2019-01-31 17:30:14', '2019-01-31 17:30:15.14928', '2019-01-31 17:30:15.14928', 21474836480, 15, 1000000000000000000, 4400, 100, 100000000, 10000, 10, 'AAAACuG2iY3cXKZc01tN9R5bOcUXMzW/aNPUfU3ne4fb3//T2zRcf7XmQY5Plcl6hIg840eUs8Et8hRwrWsmPw7aPUIAAAAAXFMwpgAAAAAAAAAAJ4/Gr554ZVHMujN++AAdhrv5CCDQrTNFeytjikmekx3GWvZp+yvZ3JMG/nO7VPf0O1l1GYVcpXUv+POcA5TiKgAAAAUN4Lazp2QAAAAAAAAAABEwAAAAAAAAAAAAAAAhAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 33, 0, false);
+INSERT INTO history_ledgers VALUES (4, 'e1b6898ddc5ca65cd35b4df51e5b39c5173335bf68d3d47d4de77b87dbdfffd3', 'ca3c27d540529dbbc5df38890cbb2fb1e9a1cee68fe6e7465a8b388dda2655ee', 4, 4, '2019-01-31 17:30:13', '2019-01-31 17:30:15.187076', '2019-01-31 17:30:15.187076', 17179869184, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACso8J9VAUp27xd84iQy7L7Hpoc7mj+bnRlqLOI3aJlXu8dqQUFp7Y3d934N8v8IfRkG/4eRPdoN31g442ghadLgAAAAAXFMwpQAAAAAAAAAA9P74c8f1xfd4wX2Dasj3a3ZXngqhfaJ2yA7eEO3Lts+/EjoVYEGkogDZDwjkmRTPO+y4gLWsWVKb9XnEZEvnRAAAAAQN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (3, 'ca3c27d540529dbbc5df38890cbb2fb1e9a1cee68fe6e7465a8b388dda2655ee', 'f86efbd8b576310cf199c035f55c9125a9af28a6aa0e40f7f84eac4ba7f70942', 4, 4, '2019-01-31 17:30:12', '2019-01-31 17:30:15.201629', '2019-01-31 17:30:15.201629', 12884901888, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAACvhu+9i1djEM8ZnANfVckSWpryimqg5A9/hOrEun9wlC9s0hRwlXCq/TEOyRBi1BMXEOAWmmIOdlDDoMP4TNj/sAAAAAXFMwpAAAAAAAAAAAgzaJz4Xq1aG8spy1oEm7qAluf8fQetm330Mf8Zmid+BUZGQDuT/r9+dklMDPWbAIYZinF6LrexrlSouwy7vF1gAAAAMN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (2, 'f86efbd8b576310cf199c035f55c9125a9af28a6aa0e40f7f84eac4ba7f70942', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 3, 3, '2019-01-31 17:30:11', '2019-01-31 17:30:15.214464', '2019-01-31 17:30:15.214464', 8589934592, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZjdLwAXsL2eIh4K+qv7MhQiH7W7LZaUq9IK+/p5Db8bMAAAAAXFMwowAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAA5YwG7hzcH7+TYJ0iG4Sklbds5327gq5uPLExV/3yG/3zKqCFlFyUUxeIuSI552Tm/KyO+V+GCQQEm90iGXpYrwAAAAIN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 3, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:30:15.227431', '2019-01-31 17:30:15.227431', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -881,6 +952,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -916,6 +994,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
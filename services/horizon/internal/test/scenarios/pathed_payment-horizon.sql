@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -492,6 +557,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -569,12 +640,12 @@ INSERT INTO history_effects VALUES (2, 8589955073, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (6, 'a17182a184e83bb06d7ad07f3310b0d1eb8631f6675fae7cbbc805ab3b37963c', 'd8239b6d2ab56b53ba9893fcbca5df95cb6f1c19ee3a66bcd243a7a00fdea368', 1, 1, '2019-01-31 17:29:34', '2019-01-31 17:29:33.996529', '2019-01-31 17:29:33.996529', 25769803776, 15, 1000000000000000000, 1500, 100, 100000000, 10000, 10, 'AAAACtgjm20qtWtTupiT/Lyl35XLbxwZ7jpmvNJDp6AP3qNo706iJZKiAmvgYT4oyKUnrEt1b8l4b0cQVG9AbCcL+14AAAAAXFMwfgAAAAAAAAAAdopGqZ/Fgz8xg91fCwTsvxIms9ukW73CHeCtBuNmNWIl2HsckawK1Q20qlVug6Y0dTeqyv3jA79Sdw74+fZgaQAAAAYN4Lazp2QAAAAAAAAAAAXcAAAAAAAAAAAAAAABAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (5, 'd8239b6d2ab56b53ba9893fcbca5df95cb6f1c19ee3a66bcd243a7a00fdea368', '5c7c0aa2a8f0093959efb969830b645d253f22d96466cd3e713f2efa4bbc376e', 1, 1, '2019-01-31 17:29:33', '2019-01-31 17:29:34.021501', '2019-01-31 17:29:34.021502', 21474836480, 15, 1000000000000000000, 1400, 100, 100000000, 10000, 10, 'AAAAClx8CqKo8Ak5We+5aYMLZF0lPyLZZGbNPnE/LvpLvDduHJ1RoyHZ0T7zTTp0hV59FhgB7EpGU1pXzZCoyyftGnkAAAAAXFMwfQAAAAAAAAAAMipVgDbLOBwRolHBsTSsmxFNzeTX3AdqZSXt3LGMFmi8AJHYNaBoneHJIKqkzxQLMBhOloK6G5EKUcQiWbQu7QAAAAUN4Lazp2QAAAAAAAAAAAV4AAAAAAAAAAAAAAABAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (4, '5c7c0aa2a8f0093959efb969830b645d253f22d96466cd3e713f2efa4bbc376e', '765e9e900074250d941098bfd1152b793e41877ff95a7b162c327d3dd8b8cbdc', 4, 4, '2019-01-31 17:29:32', '2019-01-31 17:29:34.033318', '2019-01-31 17:29:34.033318', 17179869184, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACnZenpAAdCUNlBCYv9EVK3k+QYd/+Vp7FiwyfT3YuMvciUJigkd8J3nKZvxTJ8AEAXgy5Z4gzQzDFdhAVgeOhm4AAAAAXFMwfAAAAAAAAAAAsi9a21jqDRD4i/XHyj+xRSVgYcx7Ecx56J32RnrNw/gp8R8tfp8F7Ft4gMcHupi/0h+AITtAiyoqeClxsMkmYwAAAAQN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (3, '765e9e900074250d941098bfd1152b793e41877ff95a7b162c327d3dd8b8cbdc', '84bda7023f728d186dafcb8bed4b56566581554be1888a46a0e7659e322036eb', 4, 4, '2019-01-31 17:29:31', '2019-01-31 17:29:34.050308', '2019-01-31 17:29:34.050308', 12884901888, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACoS9pwI/co0Yba/Li+1LVlZlgVVL4YiKRqDnZZ4yIDbrPCCBZfsIuhN3uke4DAT+tOUVJjoYZ2cFUTj/TDmRRs4AAAAAXFMwewAAAAAAAAAAOP/Mj1g0/IcWDTiLYOE6vJfcxovUq+ntgN73jkZkZve3FGbp7Ma0DApnlfKLPJ1aUepL4Uh712Q1vtGoUCf3mAAAAAMN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (2, '84bda7023f728d186dafcb8bed4b56566581554be1888a46a0e7659e322036eb', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 5, 5, '2019-01-31 17:29:30', '2019-01-31 17:29:34.064641', '2019-01-31 17:29:34.064641', 8589934592, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZhnfbEDpHPYd45nQcT7XkfIMwSX9gFD9+fWat716QIWcAAAAAXFMwegAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAQ9m8LSG8b5W0buxEtqstVQz7IV/HrDmGEtdA4mCEDXwp2gko2aYOjyG9WNl6CAHoM51KxJFK9g0oOuL3ks8iXgAAAAIN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 5, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:29:34.078734', '2019-01-31 17:29:34.078734', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (6, 'a17182a184e83bb06d7ad07f3310b0d1eb8631f6675fae7cbbc805ab3b37963c', 'd8239b6d2ab56b53ba9893fcbca5df95cb6f1c19ee3a66bcd243a7a00fdea368', 1, 1, '2019-01-31 17:29:34', '2019-01-31 17:29:33.996529', '2019-01-31 17:29:33.996529', 25769803776, 15, 1000000000000000000, 1500, 100, 100000000, 10000, 10, 'AAAACtgjm20qtWtTupiT/Lyl35XLbxwZ7jpmvNJDp6AP3qNo706iJZKiAmvgYT4oyKUnrEt1b8l4b0cQVG9AbCcL+14AAAAAXFMwfgAAAAAAAAAAdopGqZ/Fgz8xg91fCwTsvxIms9ukW73CHeCtBuNmNWIl2HsckawK1Q20qlVug6Y0dTeqyv3jA79Sdw74+fZgaQAAAAYN4Lazp2QAAAAAAAAAAAXcAAAAAAAAAAAAAAABAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (5, 'd8239b6d2ab56b53ba9893fcbca5df95cb6f1c19ee3a66bcd243a7a00fdea368', '5c7c0aa2a8f0093959efb969830b645d253f22d96466cd3e713f2efa4bbc376e', 1, 1, '2019-01-31 17:29:33', '2019-01-31 17:29:34.021501', '2019-01-31 17:29:34.021502', 21474836480, 15, 1000000000000000000, 1400, 100, 100000000, 10000, 10, 'AAAAClx8CqKo8Ak5We+5aYMLZF0lPyLZZGbNPnE/LvpLvDduHJ1RoyHZ0T7zTTp0hV59FhgB7EpGU1pXzZCoyyftGnkAAAAAXFMwfQAAAAAAAAAAMipVgDbLOBwRolHBsTSsmxFNzeTX3AdqZSXt3LGMFmi8AJHYNaBoneHJIKqkzxQLMBhOloK6G5EKUcQiWbQu7QAAAAUN4Lazp2QAAAAAAAAAAAV4AAAAAAAAAAAAAAABAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (4, '5c7c0aa2a8f0093959efb969830b645d253f22d96466cd3e713f2efa4bbc376e', '765e9e900074250d941098bfd1152b793e41877ff95a7b162c327d3dd8b8cbdc', 4, 4, '2019-01-31 17:29:32', '2019-01-31 17:29:34.033318', '2019-01-31 17:29:34.033318', 17179869184, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACnZenpAAdCUNlBCYv9EVK3k+QYd/+Vp7FiwyfT3YuMvciUJigkd8J3nKZvxTJ8AEAXgy5Z4gzQzDFdhAVgeOhm4AAAAAXFMwfAAAAAAAAAAAsi9a21jqDRD4i/XHyj+xRSVgYcx7Ecx56J32RnrNw/gp8R8tfp8F7Ft4gMcHupi/0h+AITtAiyoqeClxsMkmYwAAAAQN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (3, '765e9e900074250d941098bfd1152b793e41877ff95a7b162c327d3dd8b8cbdc', '84bda7023f728d186dafcb8bed4b56566581554be1888a46a0e7659e322036eb', 4, 4, '2019-01-31 17:29:31', '2019-01-31 17:29:34.050308', '2019-01-31 17:29:34.050308', 12884901888, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACoS9pwI/co0Yba/Li+1LVlZlgVVL4YiKRqDnZZ4yIDbrPCCBZfsIuhN3uke4DAT+tOUVJjoYZ2cFUTj/TDmRRs4AAAAAXFMwewAAAAAAAAAAOP/Mj1g0/IcWDTiLYOE6vJfcxovUq+ntgN73jkZkZve3FGbp7Ma0DApnlfKLPJ1aUepL4Uh712Q1vtGoUCf3mAAAAAMN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (2, '84bda7023f728d186dafcb8bed4b56566581554be1888a46a0e7659e322036eb', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 5, 5, '2019-01-31 17:29:30', '2019-01-31 17:29:34.064641', '2019-01-31 17:29:34.064641', 8589934592, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZhnfbEDpHPYd45nQcT7XkfIMwSX9gFD9+fWat716QIWcAAAAAXFMwegAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAQ9m8LSG8b5W0buxEtqstVQz7IV/HrDmGEtdA4mCEDXwp2gko2aYOjyG9WNl6CAHoM51KxJFK9g0oOuL3ks8iXgAAAAIN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 5, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:29:34.078734', '2019-01-31 17:29:34.078734', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -785,6 +856,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -820,6 +898,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -490,6 +555,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -549,18 +620,18 @@ INSERT INTO history_effects VALUES (3, 8589942785, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (12, '20fd8607744182e443cde514de26277572abc602322dc1b923258840ab0523ee', '1db6d7fd43e250e2c68994e4e5f383442af112bbd4f262591ff8e3f38022757c', 0, 0, '2019-01-31 17:28:17', '2019-01-31 17:28:10.767328', '2019-01-31 17:28:10.767328', 51539607552, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACh221/1D4lDixomU5OXzg0Qq8RK71PJiWR/44/OAInV8FW/zoO6RHLhnTKWSvBqI8+6aygiXOBdibpWvyTb5vSIAAAAAXFMwMQAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERnHA7FDJsbVxwWrfMuaBHJ9rHXfVeCcVWfWkGAFayL17QAAAAwN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
-INSERT INTO history_ledgers VALUES (11, '1db6d7fd43e250e2c68994e4e5f383442af112bbd4f262591ff8e3f38022757c', 'cf4c819353fdd5f0a7375e0df738c8ad476b0b98b0f8676494b3a3cb135e6421', 1, 1, '2019-01-31 17:28:16', '2019-01-31 17:28:10.777053', '2019-01-31 17:28:10.777053', 47244640256, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACs9MgZNT/dXwpzdeDfc4yK1HawuYsPhnZJSzo8sTXmQhMrQHx1MOGU9Yr+Czxnl1vTvbKIrznLBjaryJ6n6vNCQAAAAAXFMwMAAAAAAAAAAAmFwOlFqcVdyetl3vjpiXLbSMzYVia44vS0tbRk58Yzyv8PGcnWBY6oZGJkb80jzYV2y7rYj49eMVWmZ/aU7tqQAAAAsN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (10, 'cf4c819353fdd5f0a7375e0df738c8ad476b0b98b0f8676494b3a3cb135e6421', '1839c37303c9150b334b1b72c6993c3f6cece9c587cfd71bf8ecc43d1fd92453', 1, 1, '2019-01-31 17:28:15', '2019-01-31 17:28:10.805879', '2019-01-31 17:28:10.805879', 42949672960, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAAChg5w3MDyRULM0sbcsaZPD9s7OnFh8/XG/jsxD0f2SRTRiBjLPSHJPxtaQD4TZyNmawxPsaMiiMDSTWedf1cLwcAAAAAXFMwLwAAAAAAAAAA6lRE0phahaqqFmy0QiHScS5fiHWsfSsqZJ0lki7J1+FpIurxTYUZMu6q+BevVEo/hvuK874xdb0o34ikdNDD3AAAAAoN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (9, '1839c37303c9150b334b1b72c6993c3f6cece9c587cfd71bf8ecc43d1fd92453', 'be2923badcf7b163663c0591fe0518c19e136a135e7020e7479e4caa259b6f69', 1, 1, '2019-01-31 17:28:14', '2019-01-31 17:28:10.822079', '2019-01-31 17:28:10.822079', 38654705664, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACr4pI7rc97FjZjwFkf4FGMGeE2oTXnAg50eeTKolm29p4rf5lAnqqpsfLDGPAN1zaFN7XcS5Y4TKwMs/CKmI3JkAAAAAXFMwLgAAAAAAAAAAPOhxFEK89OWazUrqiNnGGEHhUviPsqFuH3eqjSDcylwVqtGNXlNDA3tyjcP/Rs4OxkE5AxlX+To3crz/qCzZAQAAAAkN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (8, 'be2923badcf7b163663c0591fe0518c19e136a135e7020e7479e4caa259b6f69', '4dab2ffdfd7a9a123cae2334e4cbbffc7e9a023d46efe5e6fbc6f1183d679ef3', 1, 1, '2019-01-31 17:28:13', '2019-01-31 17:28:10.835363', '2019-01-31 17:28:10.835363', 34359738368, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACk2rL/39epoSPK4jNOTLv/x+mgI9Ru/l5vvG8Rg9Z57z4W5zIlU1geoAm00XtfLNgln3xgZjnvfey5TRCiZCoEAAAAAAXFMwLQAAAAAAAAAAJ0ts4/XYQta2CPBn5gpjCmmaaT6lvEUhsrs3yyYWo8nRaI/PGHEmxVHODiyULCss1eyqUgETqbm3ho0p/lvbqQAAAAgN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (7, '4dab2ffdfd7a9a123cae2334e4cbbffc7e9a023d46efe5e6fbc6f1183d679ef3', '527dbf992b52924bfd04bbfdcabe387d221ca6a691b4d2a34e4afb285bc09173', 1, 1, '2019-01-31 17:28:12', '2019-01-31 17:28:10.852599', '2019-01-31 17:28:10.852599', 30064771072, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAAClJ9v5krUpJL/QS7/cq+OH0iHKamkbTSo05K+yhbwJFzyKVUfwYQb5PfJSWVekVgM0tHvk1Au5zpSXdO9GR/SooAAAAAXFMwLAAAAAAAAAAA86p35r63mMyyIdwFy/OVIQnYahLVbx+xS7RU2RGWQgBklD1bGopRXEaOpdhiBY+9vD+NPBIgqA2hedGPJSMlpQAAAAcN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (6, '527dbf992b52924bfd04bbfdcabe387d221ca6a691b4d2a34e4afb285bc09173', 'cab8a3222e9adcab6f30c6e71cb75a1c81e2b8804ff88264081b4b69a241f4b7', 1, 1, '2019-01-31 17:28:11', '2019-01-31 17:28:10.868447', '2019-01-31 17:28:10.868447', 25769803776, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACsq4oyIumtyrbzDG5xy3WhyB4riAT/iCZAgbS2miQfS3muzdh2S3I9H6OdHR0xfvt/+X3FoKMquR+Th8G8OO1WAAAAAAXFMwKwAAAAAAAAAAQKGf90N/CebuaXWxlpPbcziaBUE4wyo+nOmUW36ADtsaC4dLLhW35XcY71bZNE3aQANYfDo3eCFdvrip0ht2NwAAAAYN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (5, 'cab8a3222e9adcab6f30c6e71cb75a1c81e2b8804ff88264081b4b69a241f4b7', '1c103412bd0dc2f3afad694bd1ef44b8be09f3d267d6e7dafc71b254a7bbd36f', 1, 1, '2019-01-31 17:28:10', '2019-01-31 17:28:10.878694', '2019-01-31 17:28:10.878694', 21474836480, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAAChwQNBK9DcLzr61pS9HvRLi+CfPSZ9bn2vxxslSnu9Nv0J0CpTx+SIFZOr/M97JWtaIwtQ7ArI2iEsSXIQp/31oAAAAAXFMwKgAAAAAAAAAAbJCOaKm2CaEJkDvk9WFgcQA3JpN23CxDI5jTyWfntchRRjx0H5b/h1fxtP9YoszQYwBnMnohI/1GxIxfmgx6LQAAAAUN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (4, '1c103412bd0dc2f3afad694bd1ef44b8be09f3d267d6e7dafc71b254a7bbd36f', 'e80d7d71d63b3162a2dbfc6c782a92a897896302c39b6af551ea5144955374fb', 1, 1, '2019-01-31 17:28:09', '2019-01-31 17:28:10.892045', '2019-01-31 17:28:10.892045', 17179869184, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACugNfXHWOzFiotv8bHgqkqiXiWMCw5tq9VHqUUSVU3T7hHloYP3gxpya5ilxrnJi/SA8jNw0XJE0j8Kdne6h9MUAAAAAXFMwKQAAAAAAAAAAa1i3djL4JG2U0qym2CMoeBxlr+vmeubZ7vGC4ngh49KsCkdOgeIk4I0yguCjVMcBDrvk8xAh7VtCvvq6Dle66gAAAAQN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (3, 'e80d7d71d63b3162a2dbfc6c782a92a897896302c39b6af551ea5144955374fb', '67f1fded3ac10d1fb12a23856035aad2fb3b7e555df072498f5bf67bdaae5735', 1, 1, '2019-01-31 17:28:08', '2019-01-31 17:28:10.906336', '2019-01-31 17:28:10.906336', 12884901888, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmfx/e06wQ0fsSojhWA1qtL7O35VXfBySY9b9nvarlc1USTnWUAqTTjkZMTi04OHX6oDvcLbel7bdFSsbBlx17sAAAAAXFMwKAAAAAAAAAAAWytPxoudFtBA+XPgoX68OnSpyq0CyNJxb/gkM8zhiqIpIP2hWHdqU5nubQoPWn1W6P9CUYHp9JVl/2sw4BNMtwAAAAMN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (2, '67f1fded3ac10d1fb12a23856035aad2fb3b7e555df072498f5bf67bdaae5735', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 2, 2, '2019-01-31 17:28:07', '2019-01-31 17:28:10.917738', '2019-01-31 17:28:10.917739', 8589934592, 15, 1000000000000000000, 200, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZUeFWh3+LY3YTHkhb6t+XikT48AKVUrh+INl3MY9NNtUAAAAAXFMwJwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAwWNZfI5WyFWx2m7ToNQChYZ5zqFrwog2j0kXqQNLArv1t7OZPmchiRp+HQg+NnnCMlEtYyMUds5oJrwcr1auywAAAAIN4Lazp2QAAAAAAAAAAADIAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:28:10.927585', '2019-01-31 17:28:10.927585', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (12, '20fd8607744182e443cde514de26277572abc602322dc1b923258840ab0523ee', '1db6d7fd43e250e2c68994e4e5f383442af112bbd4f262591ff8e3f38022757c', 0, 0, '2019-01-31 17:28:17', '2019-01-31 17:28:10.767328', '2019-01-31 17:28:10.767328', 51539607552, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACh221/1D4lDixomU5OXzg0Qq8RK71PJiWR/44/OAInV8FW/zoO6RHLhnTKWSvBqI8+6aygiXOBdibpWvyTb5vSIAAAAAXFMwMQAAAAAAAAAA3z9hmASpL9tAVxktxD3XSOp3itxSvEmM6AUkwBS4ERnHA7FDJsbVxwWrfMuaBHJ9rHXfVeCcVWfWkGAFayL17QAAAAwN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
+INSERT INTO history_ledgers VALUES (11, '1db6d7fd43e250e2c68994e4e5f383442af112bbd4f262591ff8e3f38022757c', 'cf4c819353fdd5f0a7375e0df738c8ad476b0b98b0f8676494b3a3cb135e6421', 1, 1, '2019-01-31 17:28:16', '2019-01-31 17:28:10.777053', '2019-01-31 17:28:10.777053', 47244640256, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACs9MgZNT/dXwpzdeDfc4yK1HawuYsPhnZJSzo8sTXmQhMrQHx1MOGU9Yr+Czxnl1vTvbKIrznLBjaryJ6n6vNCQAAAAAXFMwMAAAAAAAAAAAmFwOlFqcVdyetl3vjpiXLbSMzYVia44vS0tbRk58Yzyv8PGcnWBY6oZGJkb80jzYV2y7rYj49eMVWmZ/aU7tqQAAAAsN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (10, 'cf4c819353fdd5f0a7375e0df738c8ad476b0b98b0f8676494b3a3cb135e6421', '1839c37303c9150b334b1b72c6993c3f6cece9c587cfd71bf8ecc43d1fd92453', 1, 1, '2019-01-31 17:28:15', '2019-01-31 17:28:10.805879', '2019-01-31 17:28:10.805879', 42949672960, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAAChg5w3MDyRULM0sbcsaZPD9s7OnFh8/XG/jsxD0f2SRTRiBjLPSHJPxtaQD4TZyNmawxPsaMiiMDSTWedf1cLwcAAAAAXFMwLwAAAAAAAAAA6lRE0phahaqqFmy0QiHScS5fiHWsfSsqZJ0lki7J1+FpIurxTYUZMu6q+BevVEo/hvuK874xdb0o34ikdNDD3AAAAAoN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (9, '1839c37303c9150b334b1b72c6993c3f6cece9c587cfd71bf8ecc43d1fd92453', 'be2923badcf7b163663c0591fe0518c19e136a135e7020e7479e4caa259b6f69', 1, 1, '2019-01-31 17:28:14', '2019-01-31 17:28:10.822079', '2019-01-31 17:28:10.822079', 38654705664, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACr4pI7rc97FjZjwFkf4FGMGeE2oTXnAg50eeTKolm29p4rf5lAnqqpsfLDGPAN1zaFN7XcS5Y4TKwMs/CKmI3JkAAAAAXFMwLgAAAAAAAAAAPOhxFEK89OWazUrqiNnGGEHhUviPsqFuH3eqjSDcylwVqtGNXlNDA3tyjcP/Rs4OxkE5AxlX+To3crz/qCzZAQAAAAkN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (8, 'be2923badcf7b163663c0591fe0518c19e136a135e7020e7479e4caa259b6f69', '4dab2ffdfd7a9a123cae2334e4cbbffc7e9a023d46efe5e6fbc6f1183d679ef3', 1, 1, '2019-01-31 17:28:13', '2019-01-31 17:28:10.835363', '2019-01-31 17:28:10.835363', 34359738368, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACk2rL/39epoSPK4jNOTLv/x+mgI9Ru/l5vvG8Rg9Z57z4W5zIlU1geoAm00XtfLNgln3xgZjnvfey5TRCiZCoEAAAAAAXFMwLQAAAAAAAAAAJ0ts4/XYQta2CPBn5gpjCmmaaT6lvEUhsrs3yyYWo8nRaI/PGHEmxVHODiyULCss1eyqUgETqbm3ho0p/lvbqQAAAAgN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (7, '4dab2ffdfd7a9a123cae2334e4cbbffc7e9a023d46efe5e6fbc6f1183d679ef3', '527dbf992b52924bfd04bbfdcabe387d221ca6a691b4d2a34e4afb285bc09173', 1, 1, '2019-01-31 17:28:12', '2019-01-31 17:28:10.852599', '2019-01-31 17:28:10.852599', 30064771072, 15, 1000000000000000000, 700, 100, 100000000, 10000, 10, 'AAAAClJ9v5krUpJL/QS7/cq+OH0iHKamkbTSo05K+yhbwJFzyKVUfwYQb5PfJSWVekVgM0tHvk1Au5zpSXdO9GR/SooAAAAAXFMwLAAAAAAAAAAA86p35r63mMyyIdwFy/OVIQnYahLVbx+xS7RU2RGWQgBklD1bGopRXEaOpdhiBY+9vD+NPBIgqA2hedGPJSMlpQAAAAcN4Lazp2QAAAAAAAAAAAK8AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (6, '527dbf992b52924bfd04bbfdcabe387d221ca6a691b4d2a34e4afb285bc09173', 'cab8a3222e9adcab6f30c6e71cb75a1c81e2b8804ff88264081b4b69a241f4b7', 1, 1, '2019-01-31 17:28:11', '2019-01-31 17:28:10.868447', '2019-01-31 17:28:10.868447', 25769803776, 15, 1000000000000000000, 600, 100, 100000000, 10000, 10, 'AAAACsq4oyIumtyrbzDG5xy3WhyB4riAT/iCZAgbS2miQfS3muzdh2S3I9H6OdHR0xfvt/+X3FoKMquR+Th8G8OO1WAAAAAAXFMwKwAAAAAAAAAAQKGf90N/CebuaXWxlpPbcziaBUE4wyo+nOmUW36ADtsaC4dLLhW35XcY71bZNE3aQANYfDo3eCFdvrip0ht2NwAAAAYN4Lazp2QAAAAAAAAAAAJYAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (5, 'cab8a3222e9adcab6f30c6e71cb75a1c81e2b8804ff88264081b4b69a241f4b7', '1c103412bd0dc2f3afad694bd1ef44b8be09f3d267d6e7dafc71b254a7bbd36f', 1, 1, '2019-01-31 17:28:10', '2019-01-31 17:28:10.878694', '2019-01-31 17:28:10.878694', 21474836480, 15, 1000000000000000000, 500, 100, 100000000, 10000, 10, 'AAAAChwQNBK9DcLzr61pS9HvRLi+CfPSZ9bn2vxxslSnu9Nv0J0CpTx+SIFZOr/M97JWtaIwtQ7ArI2iEsSXIQp/31oAAAAAXFMwKgAAAAAAAAAAbJCOaKm2CaEJkDvk9WFgcQA3JpN23CxDI5jTyWfntchRRjx0H5b/h1fxtP9YoszQYwBnMnohI/1GxIxfmgx6LQAAAAUN4Lazp2QAAAAAAAAAAAH0AAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (4, '1c103412bd0dc2f3afad694bd1ef44b8be09f3d267d6e7dafc71b254a7bbd36f', 'e80d7d71d63b3162a2dbfc6c782a92a897896302c39b6af551ea5144955374fb', 1, 1, '2019-01-31 17:28:09', '2019-01-31 17:28:10.892045', '2019-01-31 17:28:10.892045', 17179869184, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACugNfXHWOzFiotv8bHgqkqiXiWMCw5tq9VHqUUSVU3T7hHloYP3gxpya5ilxrnJi/SA8jNw0XJE0j8Kdne6h9MUAAAAAXFMwKQAAAAAAAAAAa1i3djL4JG2U0qym2CMoeBxlr+vmeubZ7vGC4ngh49KsCkdOgeIk4I0yguCjVMcBDrvk8xAh7VtCvvq6Dle66gAAAAQN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (3, 'e80d7d71d63b3162a2dbfc6c782a92a897896302c39b6af551ea5144955374fb', '67f1fded3ac10d1fb12a23856035aad2fb3b7e555df072498f5bf67bdaae5735', 1, 1, '2019-01-31 17:28:08', '2019-01-31 17:28:10.906336', '2019-01-31 17:28:10.906336', 12884901888, 15, 1000000000000000000, 300, 100, 100000000, 10000, 10, 'AAAACmfx/e06wQ0fsSojhWA1qtL7O35VXfBySY9b9nvarlc1USTnWUAqTTjkZMTi04OHX6oDvcLbel7bdFSsbBlx17sAAAAAXFMwKAAAAAAAAAAAWytPxoudFtBA+XPgoX68OnSpyq0CyNJxb/gkM8zhiqIpIP2hWHdqU5nubQoPWn1W6P9CUYHp9JVl/2sw4BNMtwAAAAMN4Lazp2QAAAAAAAAAAAEsAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (2, '67f1fded3ac10d1fb12a23856035aad2fb3b7e555df072498f5bf67bdaae5735', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 2, 2, '2019-01-31 17:28:07', '2019-01-31 17:28:10.917738', '2019-01-31 17:28:10.917739', 8589934592, 15, 1000000000000000000, 200, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76ZUeFWh3+LY3YTHkhb6t+XikT48AKVUrh+INl3MY9NNtUAAAAAXFMwJwAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAwWNZfI5WyFWx2m7ToNQChYZ5zqFrwog2j0kXqQNLArv1t7OZPmchiRp+HQg+NnnCMlEtYyMUds5oJrwcr1auywAAAAIN4Lazp2QAAAAAAAAAAADIAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 2, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:28:10.927585', '2019-01-31 17:28:10.927585', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -738,6 +809,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -773,6 +851,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -64,12 +64,16 @@ ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_st
 ALTER TABLE IF EXISTS public.history_transaction_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_operation_participants ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE IF EXISTS public.history_assets ALTER COLUMN id DROP DEFAULT;
+DROP TABLE IF EXISTS public.webhook_cursor;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
 DROP TABLE IF EXISTS public.history_trades;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
+DROP TABLE IF EXISTS public.history_operation_assets;
 DROP TABLE IF EXISTS public.history_operation_participants;
 DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
@@ -313,7 +317,18 @@ CREATE TABLE history_ledgers (
     protocol_version integer DEFAULT 0 NOT NULL,
     ledger_header text,
     successful_transaction_count integer,
-    failed_transaction_count integer
+    failed_transaction_count integer,
+    from_archive boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: history_operation_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_operation_assets (
+    history_operation_id bigint NOT NULL,
+    history_asset_id integer NOT NULL
 );
 
 
@@ -434,12 +449,62 @@ CREATE TABLE history_transactions (
     id bigint,
     tx_envelope text NOT NULL,
     tx_result text NOT NULL,
-    tx_meta text NOT NULL,
-    tx_fee_meta text NOT NULL,
+    tx_meta text,
+    tx_fee_meta text,
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
+);
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigserial PRIMARY KEY,
+    url character varying NOT NULL,
+    secret character varying(64) NOT NULL,
+    account character varying(64),
+    asset_type character varying(64),
+    asset_code character varying(12),
+    asset_issuer character varying(56),
+    effect_type integer,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt ON webhook_deliveries USING btree (next_attempt_at);
+
+
+--
+-- Name: webhook_cursor; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_cursor (
+    id integer PRIMARY KEY CHECK (id = 1),
+    cursor character varying(64) NOT NULL
 );
 
 
@@ -492,6 +557,12 @@ INSERT INTO gorp_migrations VALUES ('12_asset_stats_amount_string.sql', '2019-01
 INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-01-31 18:27:26.809634+01');
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-01-31 18:27:26.811268+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-01-31 18:27:26.81283+01');
+INSERT INTO gorp_migrations VALUES ('16_webhooks.sql', '2019-01-31 18:27:26.81371+01');
+INSERT INTO gorp_migrations VALUES ('17_ingest_failed_transactions.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('18_transactions_by_memo.sql', '2019-01-31 18:27:26.81446+01');
+INSERT INTO gorp_migrations VALUES ('19_operation_assets.sql', '2019-01-31 18:27:26.81712+01');
+INSERT INTO gorp_migrations VALUES ('20_webhook_cursor.sql', '2019-01-31 18:27:26.81803+01');
+INSERT INTO gorp_migrations VALUES ('21_ledgers_from_archive.sql', '2019-01-31 18:27:26.81904+01');
 
 
 --
@@ -561,17 +632,17 @@ INSERT INTO history_effects VALUES (3, 8589950977, 3, 10, '{"weight": 1, "public
 -- Data for Name: history_ledgers; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_ledgers VALUES (11, '4a89afa09ba5111f74ee29f8d766d7a9c8c7345d36cecd0588d18fde9f0b2cf9', '3c7d4f65e974ae73c66cc169478dd2addc01580b83c9100977160761a2b0e7a2', 1, 1, '2019-01-31 17:29:47', '2019-01-31 17:29:41.27328', '2019-01-31 17:29:41.273281', 47244640256, 15, 1000000000000000000, 1600, 100, 100000000, 10000, 10, 'AAAACjx9T2XpdK5zxmzBaUeN0q3cAVgLg8kQCXcWB2GisOeiG7JXU6i+wi3Lcix/X28/aRK8KWj3QR5guh3/7OMJ52sAAAAAXFMwiwAAAAAAAAAAyToVYm2hYR3IUF38pmq7NVDjFMzRrJQIFysbu8CJLzpqJMCKXKCJsr7uledrvTl4ti+HCy5RshieINe7vg4T2QAAAAsN4Lazp2QAAAAAAAAAAAZAAAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (10, '3c7d4f65e974ae73c66cc169478dd2addc01580b83c9100977160761a2b0e7a2', 'e096c3e8e32a5fe58cb8a49f03ef18eaef19468e58360ec906ec4318fbe979c7', 1, 1, '2019-01-31 17:29:46', '2019-01-31 17:29:41.300555', '2019-01-31 17:29:41.300555', 42949672960, 15, 1000000000000000000, 1500, 100, 100000000, 10000, 10, 'AAAACuCWw+jjKl/ljLiknwPvGOrvGUaOWDYOyQbsQxj76XnH+EJYI6nQTFCJUnP+be+fJaipaSbkW0rSH4ysi2IJeiUAAAAAXFMwigAAAAAAAAAA86EnczSRdO4rCIOmS8ayHhvsqHnN/KFtkbgNWl/wMJdbuZlR8fJrlevZv9roEdZVn/9dYy7bCWBP2WjtugMV+gAAAAoN4Lazp2QAAAAAAAAAAAXcAAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (9, 'e096c3e8e32a5fe58cb8a49f03ef18eaef19468e58360ec906ec4318fbe979c7', '4e571e082ae1de5cc3e01cf907662f612df4a2d2fdaa1b00dc116341b4aca07c', 1, 1, '2019-01-31 17:29:45', '2019-01-31 17:29:41.313505', '2019-01-31 17:29:41.313505', 38654705664, 15, 1000000000000000000, 1400, 100, 100000000, 10000, 10, 'AAAACk5XHggq4d5cw+Ac+QdmL2Et9KLS/aobANwRY0G0rKB8yj81QVvUlv7PmaFAECbUw8i7+WsggMByMMGEiMcU/HAAAAAAXFMwiQAAAAAAAAAA9lpB+omdYoLzElV/8lWltle7/pGcsn/8XT1dgI1+HdPWSOcun10ZX7SoRa5w8/Jp2Xsu90NHe13TW8WcOkfm4QAAAAkN4Lazp2QAAAAAAAAAAAV4AAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (8, '4e571e082ae1de5cc3e01cf907662f612df4a2d2fdaa1b00dc116341b4aca07c', '39e1b4bea40936c342984606e077b55b4d3f1d5662ae2c9dc93f707ce9297163', 1, 1, '2019-01-31 17:29:44', '2019-01-31 17:29:41.328615', '2019-01-31 17:29:41.328615', 34359738368, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACjnhtL6kCTbDQphGBuB3tVtNPx1WYq4snck/cHzpKXFjhH7xJCgwWCbHpEfkDVVtC2PpI7leKADXsGxrB7gqz6YAAAAAXFMwiAAAAAAAAAAA8011I8cweiVULRk7g+I3eWM/k+U+svLLvJL8X9ENB1rg67kkDArVOaI0alFpSBoDMXJdvnd/TO7SqfBxd5gzWwAAAAgN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (7, '39e1b4bea40936c342984606e077b55b4d3f1d5662ae2c9dc93f707ce9297163', '037904b2a70d194692f615c040b3c782630a0a85e853d0c4741facfb68a9d8f4', 1, 1, '2019-01-31 17:29:43', '2019-01-31 17:29:41.337115', '2019-01-31 17:29:41.337115', 30064771072, 15, 1000000000000000000, 1200, 100, 100000000, 10000, 10, 'AAAACgN5BLKnDRlGkvYVwECzx4JjCgqF6FPQxHQfrPtoqdj0igzC6I2Jj+9aZvu4TfI+8wFo6uy6EIjx/iohakAH2TEAAAAAXFMwhwAAAAAAAAAAuQrPQr2qlNVpCIqhQaeQMsD9JWWNaxISOIwMsxvilIUfk3yPT0VkQ4Z49VGtnK780kWNiFtJV15EL56FA2gz7wAAAAcN4Lazp2QAAAAAAAAAAASwAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (6, '037904b2a70d194692f615c040b3c782630a0a85e853d0c4741facfb68a9d8f4', 'bbe0a3131931984d2fb15e8315abf92240862aaa757a8229d74fde8f6ebb567d', 1, 1, '2019-01-31 17:29:42', '2019-01-31 17:29:41.352739', '2019-01-31 17:29:41.35274', 25769803776, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACrvgoxMZMZhNL7FegxWr+SJAhiqqdXqCKddP3o9uu1Z95FZbRedRTzy7snJpDah1lfn4/c8olegYrW2stYw6uAsAAAAAXFMwhgAAAAAAAAAA5tFak/fh7MEOithjlydErBU0/M0ilaefu1Ub0Cw1cbQqdHA7CVQ7hR+GNCabW5drTgrzi5FsCg+aazmULY+UYAAAAAYN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAABAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (5, 'bbe0a3131931984d2fb15e8315abf92240862aaa757a8229d74fde8f6ebb567d', '1123fb53acf8ee2c3f0a984268519738af10407d126ab46024d2d97609296662', 1, 1, '2019-01-31 17:29:41', '2019-01-31 17:29:41.363561', '2019-01-31 17:29:41.363561', 21474836480, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAAChEj+1Os+O4sPwqYQmhRlzivEEB9Emq0YCTS2XYJKWZit/3nfk8/Pd+AQTQd+7l3r0UJFg9xb3ISLV4qnH4C6tgAAAAAXFMwhQAAAAAAAAAAl9BamGt8FzgVD0vUMD8G8Hekcwind13Prg/OGPJxUCkJQfjHd4J+m9YT4oUipQE9ULV4aV7POxoybB8pwnsxEQAAAAUN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (4, '1123fb53acf8ee2c3f0a984268519738af10407d126ab46024d2d97609296662', 'fafb69865df47358640db5fdf506411dd6e6e228fde720d4065f07850465c5f5', 1, 1, '2019-01-31 17:29:40', '2019-01-31 17:29:41.375588', '2019-01-31 17:29:41.375588', 17179869184, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACvr7aYZd9HNYZA21/fUGQR3W5uIo/ecg1AZfB4UEZcX1QLNVvtrjZBN43COMeeJjLOkbpYR5QgKw0UFevNXTvVUAAAAAXFMwhAAAAAAAAAAAR1/hJj/f6iaOJ+RlLdXCAKuWfRwWj1Nl0e5ctQd6kVaaLU7XSKFa9I1Qc81WmdGTwYxbfrIelxaD3uohsQFPyQAAAAQN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0);
-INSERT INTO history_ledgers VALUES (3, 'fafb69865df47358640db5fdf506411dd6e6e228fde720d4065f07850465c5f5', 'dafeac5c829ec3c140c90ef53f17310fe60de958c660a3089247c7b3a06dcfda', 4, 4, '2019-01-31 17:29:39', '2019-01-31 17:29:41.386334', '2019-01-31 17:29:41.386334', 12884901888, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACtr+rFyCnsPBQMkO9T8XMQ/mDelYxmCjCJJHx7Ogbc/a3gvgU/5a28Bd1gRjN0oSGABnQYamgz/inGU6yVcFWUQAAAAAXFMwgwAAAAAAAAAAbDw/gPTu8ErmXCTr6S7LE6NX2zQONIIe2hkOKEK45sC3ovsYVK3zQZ6ZE5ZmN4iN90lIWuQ12CA+YvPeVwJiewAAAAMN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (2, 'dafeac5c829ec3c140c90ef53f17310fe60de958c660a3089247c7b3a06dcfda', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 4, 4, '2019-01-31 17:29:38', '2019-01-31 17:29:41.403842', '2019-01-31 17:29:41.403843', 8589934592, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76Zh82QgsuASmS0xgFp1fjiT2wNgvVWRClSW5uMP344SMoAAAAAXFMwggAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAh7rHH3ehqvUsWEPfAlvVspAA2/ZR0JjPAFIdHbQ2sSjCdKNX9bX7oXl4uO6TSACchqtoxD28NFm6aqXf4rFVngAAAAIN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0);
-INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:29:41.418244', '2019-01-31 17:29:41.418244', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0);
+INSERT INTO history_ledgers VALUES (11, '4a89afa09ba5111f74ee29f8d766d7a9c8c7345d36cecd0588d18fde9f0b2cf9', '3c7d4f65e974ae73c66cc169478dd2addc01580b83c9100977160761a2b0e7a2', 1, 1, '2019-01-31 17:29:47', '2019-01-31 17:29:41.27328', '2019-01-31 17:29:41.273281', 47244640256, 15, 1000000000000000000, 1600, 100, 100000000, 10000, 10, 'AAAACjx9T2XpdK5zxmzBaUeN0q3cAVgLg8kQCXcWB2GisOeiG7JXU6i+wi3Lcix/X28/aRK8KWj3QR5guh3/7OMJ52sAAAAAXFMwiwAAAAAAAAAAyToVYm2hYR3IUF38pmq7NVDjFMzRrJQIFysbu8CJLzpqJMCKXKCJsr7uledrvTl4ti+HCy5RshieINe7vg4T2QAAAAsN4Lazp2QAAAAAAAAAAAZAAAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (10, '3c7d4f65e974ae73c66cc169478dd2addc01580b83c9100977160761a2b0e7a2', 'e096c3e8e32a5fe58cb8a49f03ef18eaef19468e58360ec906ec4318fbe979c7', 1, 1, '2019-01-31 17:29:46', '2019-01-31 17:29:41.300555', '2019-01-31 17:29:41.300555', 42949672960, 15, 1000000000000000000, 1500, 100, 100000000, 10000, 10, 'AAAACuCWw+jjKl/ljLiknwPvGOrvGUaOWDYOyQbsQxj76XnH+EJYI6nQTFCJUnP+be+fJaipaSbkW0rSH4ysi2IJeiUAAAAAXFMwigAAAAAAAAAA86EnczSRdO4rCIOmS8ayHhvsqHnN/KFtkbgNWl/wMJdbuZlR8fJrlevZv9roEdZVn/9dYy7bCWBP2WjtugMV+gAAAAoN4Lazp2QAAAAAAAAAAAXcAAAAAAAAAAAAAAAEAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (9, 'e096c3e8e32a5fe58cb8a49f03ef18eaef19468e58360ec906ec4318fbe979c7', '4e571e082ae1de5cc3e01cf907662f612df4a2d2fdaa1b00dc116341b4aca07c', 1, 1, '2019-01-31 17:29:45', '2019-01-31 17:29:41.313505', '2019-01-31 17:29:41.313505', 38654705664, 15, 1000000000000000000, 1400, 100, 100000000, 10000, 10, 'AAAACk5XHggq4d5cw+Ac+QdmL2Et9KLS/aobANwRY0G0rKB8yj81QVvUlv7PmaFAECbUw8i7+WsggMByMMGEiMcU/HAAAAAAXFMwiQAAAAAAAAAA9lpB+omdYoLzElV/8lWltle7/pGcsn/8XT1dgI1+HdPWSOcun10ZX7SoRa5w8/Jp2Xsu90NHe13TW8WcOkfm4QAAAAkN4Lazp2QAAAAAAAAAAAV4AAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (8, '4e571e082ae1de5cc3e01cf907662f612df4a2d2fdaa1b00dc116341b4aca07c', '39e1b4bea40936c342984606e077b55b4d3f1d5662ae2c9dc93f707ce9297163', 1, 1, '2019-01-31 17:29:44', '2019-01-31 17:29:41.328615', '2019-01-31 17:29:41.328615', 34359738368, 15, 1000000000000000000, 1300, 100, 100000000, 10000, 10, 'AAAACjnhtL6kCTbDQphGBuB3tVtNPx1WYq4snck/cHzpKXFjhH7xJCgwWCbHpEfkDVVtC2PpI7leKADXsGxrB7gqz6YAAAAAXFMwiAAAAAAAAAAA8011I8cweiVULRk7g+I3eWM/k+U+svLLvJL8X9ENB1rg67kkDArVOaI0alFpSBoDMXJdvnd/TO7SqfBxd5gzWwAAAAgN4Lazp2QAAAAAAAAAAAUUAAAAAAAAAAAAAAADAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (7, '39e1b4bea40936c342984606e077b55b4d3f1d5662ae2c9dc93f707ce9297163', '037904b2a70d194692f615c040b3c782630a0a85e853d0c4741facfb68a9d8f4', 1, 1, '2019-01-31 17:29:43', '2019-01-31 17:29:41.337115', '2019-01-31 17:29:41.337115', 30064771072, 15, 1000000000000000000, 1200, 100, 100000000, 10000, 10, 'AAAACgN5BLKnDRlGkvYVwECzx4JjCgqF6FPQxHQfrPtoqdj0igzC6I2Jj+9aZvu4TfI+8wFo6uy6EIjx/iohakAH2TEAAAAAXFMwhwAAAAAAAAAAuQrPQr2qlNVpCIqhQaeQMsD9JWWNaxISOIwMsxvilIUfk3yPT0VkQ4Z49VGtnK780kWNiFtJV15EL56FA2gz7wAAAAcN4Lazp2QAAAAAAAAAAASwAAAAAAAAAAAAAAACAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (6, '037904b2a70d194692f615c040b3c782630a0a85e853d0c4741facfb68a9d8f4', 'bbe0a3131931984d2fb15e8315abf92240862aaa757a8229d74fde8f6ebb567d', 1, 1, '2019-01-31 17:29:42', '2019-01-31 17:29:41.352739', '2019-01-31 17:29:41.35274', 25769803776, 15, 1000000000000000000, 1100, 100, 100000000, 10000, 10, 'AAAACrvgoxMZMZhNL7FegxWr+SJAhiqqdXqCKddP3o9uu1Z95FZbRedRTzy7snJpDah1lfn4/c8olegYrW2stYw6uAsAAAAAXFMwhgAAAAAAAAAA5tFak/fh7MEOithjlydErBU0/M0ilaefu1Ub0Cw1cbQqdHA7CVQ7hR+GNCabW5drTgrzi5FsCg+aazmULY+UYAAAAAYN4Lazp2QAAAAAAAAAAARMAAAAAAAAAAAAAAABAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (5, 'bbe0a3131931984d2fb15e8315abf92240862aaa757a8229d74fde8f6ebb567d', '1123fb53acf8ee2c3f0a984268519738af10407d126ab46024d2d97609296662', 1, 1, '2019-01-31 17:29:41', '2019-01-31 17:29:41.363561', '2019-01-31 17:29:41.363561', 21474836480, 15, 1000000000000000000, 1000, 100, 100000000, 10000, 10, 'AAAAChEj+1Os+O4sPwqYQmhRlzivEEB9Emq0YCTS2XYJKWZit/3nfk8/Pd+AQTQd+7l3r0UJFg9xb3ISLV4qnH4C6tgAAAAAXFMwhQAAAAAAAAAAl9BamGt8FzgVD0vUMD8G8Hekcwind13Prg/OGPJxUCkJQfjHd4J+m9YT4oUipQE9ULV4aV7POxoybB8pwnsxEQAAAAUN4Lazp2QAAAAAAAAAAAPoAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (4, '1123fb53acf8ee2c3f0a984268519738af10407d126ab46024d2d97609296662', 'fafb69865df47358640db5fdf506411dd6e6e228fde720d4065f07850465c5f5', 1, 1, '2019-01-31 17:29:40', '2019-01-31 17:29:41.375588', '2019-01-31 17:29:41.375588', 17179869184, 15, 1000000000000000000, 900, 100, 100000000, 10000, 10, 'AAAACvr7aYZd9HNYZA21/fUGQR3W5uIo/ecg1AZfB4UEZcX1QLNVvtrjZBN43COMeeJjLOkbpYR5QgKw0UFevNXTvVUAAAAAXFMwhAAAAAAAAAAAR1/hJj/f6iaOJ+RlLdXCAKuWfRwWj1Nl0e5ctQd6kVaaLU7XSKFa9I1Qc81WmdGTwYxbfrIelxaD3uohsQFPyQAAAAQN4Lazp2QAAAAAAAAAAAOEAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 1, 0, false);
+INSERT INTO history_ledgers VALUES (3, 'fafb69865df47358640db5fdf506411dd6e6e228fde720d4065f07850465c5f5', 'dafeac5c829ec3c140c90ef53f17310fe60de958c660a3089247c7b3a06dcfda', 4, 4, '2019-01-31 17:29:39', '2019-01-31 17:29:41.386334', '2019-01-31 17:29:41.386334', 12884901888, 15, 1000000000000000000, 800, 100, 100000000, 10000, 10, 'AAAACtr+rFyCnsPBQMkO9T8XMQ/mDelYxmCjCJJHx7Ogbc/a3gvgU/5a28Bd1gRjN0oSGABnQYamgz/inGU6yVcFWUQAAAAAXFMwgwAAAAAAAAAAbDw/gPTu8ErmXCTr6S7LE6NX2zQONIIe2hkOKEK45sC3ovsYVK3zQZ6ZE5ZmN4iN90lIWuQ12CA+YvPeVwJiewAAAAMN4Lazp2QAAAAAAAAAAAMgAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (2, 'dafeac5c829ec3c140c90ef53f17310fe60de958c660a3089247c7b3a06dcfda', '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', 4, 4, '2019-01-31 17:29:38', '2019-01-31 17:29:41.403842', '2019-01-31 17:29:41.403843', 8589934592, 15, 1000000000000000000, 400, 100, 100000000, 10000, 10, 'AAAACmPZj1Nu5o0bJ7W4nyOvUxG3Vpok+vFAOtC1K2M7B76Zh82QgsuASmS0xgFp1fjiT2wNgvVWRClSW5uMP344SMoAAAAAXFMwggAAAAIAAAAIAAAAAQAAAAoAAAAIAAAAAwAAJxAAAAAAh7rHH3ehqvUsWEPfAlvVspAA2/ZR0JjPAFIdHbQ2sSjCdKNX9bX7oXl4uO6TSACchqtoxD28NFm6aqXf4rFVngAAAAIN4Lazp2QAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAAZAX14QAAACcQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 4, 0, false);
+INSERT INTO history_ledgers VALUES (1, '63d98f536ee68d1b27b5b89f23af5311b7569a24faf1403ad0b52b633b07be99', NULL, 0, 0, '1970-01-01 00:00:00', '2019-01-31 17:29:41.418244', '2019-01-31 17:29:41.418244', 4294967296, 15, 1000000000000000000, 0, 100, 100000000, 100, 0, 'AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABXKi4y/ySKB7DnD9H20xjB+s0gtswIwz1XdSWYaBJaFgAAAAEN4Lazp2QAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAX14QAAAABkAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA', 0, 0, false);
 
 
 --
@@ -779,6 +850,13 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX by_memo ON history_transactions USING btree (memo_type, memo);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -814,6 +892,20 @@ CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree
 CREATE INDEX hop_by_hoid ON history_operation_participants USING btree (history_operation_id);
 
 
+--
+-- Name: hoa_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hoa_by_asset ON history_operation_assets USING btree (history_asset_id, history_operation_id);
+
+
+--
+-- Name: hoa_by_hoid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hoa_by_hoid ON history_operation_assets USING btree (history_operation_id);
+
+
 --
 -- Name: hs_ledger_by_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -4,7 +4,7 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/kinecosystem/go/services/horizon/internal/codes"
+	"github.com/kinecosystem/go/protocols/horizon/codes"
 	"github.com/kinecosystem/go/xdr"
 )
 
@@ -12,6 +12,7 @@ var (
 	ErrNoResults = errors.New("No result found")
 	ErrCanceled  = errors.New("canceled")
 	ErrTimeout   = errors.New("timeout")
+	ErrPending   = errors.New("pending")
 
 	// ErrBadSequence is a canned error response for transactions whose sequence
 	// number is wrong.
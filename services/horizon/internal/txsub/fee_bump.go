@@ -0,0 +1,292 @@
+package txsub
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/kinecosystem/go/build"
+	"github.com/kinecosystem/go/keypair"
+	"github.com/kinecosystem/go/network"
+	"github.com/kinecosystem/go/services/horizon/internal/operationfeestats"
+	"github.com/kinecosystem/go/support/log"
+	"github.com/kinecosystem/go/xdr"
+)
+
+// FeeBumpPolicy configures the automatic resubmission, with a higher fee, of
+// transactions stellar-core rejected with txINSUFFICIENT_FEE during surge
+// pricing.  Resubmitting changes the transaction, so the policy only applies
+// to transactions whose source account is owned by the server (friendbot,
+// channel accounts...) and whose seed is in Signers: the new transaction is
+// signed by the source account only, so transactions carrying signatures of
+// other signers are not resubmitted.
+type FeeBumpPolicy struct {
+	// Signers maps the address of each server-owned account to its seed.
+	Signers map[string]string
+
+	// MaxAttempts bounds the number of resubmissions of a single transaction.
+	MaxAttempts int
+
+	// MaxBaseFee caps the fee paid per operation, in stroops.  No cap is
+	// applied when 0.
+	MaxBaseFee uint32
+
+	// Backoff is the time to wait before the first resubmission.  It doubles
+	// before each following one.
+	Backoff time.Duration
+
+	// FeeStats returns the fee stats the new base fee is derived from.
+	// Defaults to operationfeestats.CurrentState.
+	FeeStats func() operationfeestats.State
+}
+
+// SubmissionAttempt describes one submission to stellar-core of a transaction
+// resubmitted by a FeeBumpPolicy.
+type SubmissionAttempt struct {
+	// The hash of the transaction submitted in this attempt
+	Hash string
+
+	// The base64-encoded TransactionEnvelope submitted in this attempt
+	EnvelopeXDR string
+
+	// The fee of the transaction submitted in this attempt, in stroops
+	Fee uint32
+
+	// Any error stellar-core responded with
+	Err error
+}
+
+// ErrFeeBumpExhausted is returned when the fee of a transaction can't be
+// raised anymore without going over the policy's MaxBaseFee.
+var ErrFeeBumpExhausted = errors.New("base fee reached the maximum allowed")
+
+// ErrFeeBumpForeignSignatures is returned when a transaction carries
+// signatures that can't be made again with the seed of its source account.
+var ErrFeeBumpForeignSignatures = errors.New("transaction is signed by other signers than its source account")
+
+// applies returns true if the policy can resubmit the transaction of `info`
+// after it was rejected with `sr`.
+func (p *FeeBumpPolicy) applies(info envelopeInfo, sr SubmissionResult) bool {
+	if p == nil || p.MaxAttempts <= 0 {
+		return false
+	}
+
+	if _, ok := p.Signers[info.SourceAddress]; !ok {
+		return false
+	}
+
+	fte, ok := sr.Err.(*FailedTransactionError)
+	if !ok {
+		return false
+	}
+
+	result, err := fte.Result()
+	if err != nil {
+		return false
+	}
+
+	return result.Result.Code == xdr.TransactionResultCodeTxInsufficientFee
+}
+
+// backoff returns the time to wait before resubmission number `attempt`,
+// starting from 1.
+func (p *FeeBumpPolicy) backoff(attempt int) time.Duration {
+	return p.Backoff << uint(attempt-1)
+}
+
+// baseFee returns the fee per operation to resubmit a transaction with, given
+// the fee per operation it was last submitted with.  The fee paid by the
+// recent ledgers is used when it is higher, otherwise the previous fee is
+// doubled.
+func (p *FeeBumpPolicy) baseFee(previous uint32) (uint32, error) {
+	stats := operationfeestats.CurrentState
+	if p.FeeStats != nil {
+		stats = p.FeeStats
+	}
+	state := stats()
+
+	next := state.Mode
+	if state.LastBaseFee > next {
+		next = state.LastBaseFee
+	}
+
+	if next <= int64(previous) {
+		next = 2 * int64(previous)
+	}
+
+	if p.MaxBaseFee != 0 && next > int64(p.MaxBaseFee) {
+		next = int64(p.MaxBaseFee)
+	}
+
+	if next <= int64(previous) {
+		return 0, ErrFeeBumpExhausted
+	}
+
+	return uint32(next), nil
+}
+
+// checkSignatures returns ErrFeeBumpForeignSignatures unless every signature
+// of the transaction of `env` was made with its source account's seed: bump
+// replaces them with a single signature of that seed, which would lose the
+// signatures of any other signer.
+func (p *FeeBumpPolicy) checkSignatures(env string, passphrase string) error {
+	var txe xdr.TransactionEnvelope
+	err := xdr.SafeUnmarshalBase64(env, &txe)
+	if err != nil {
+		return errors.Wrap(err, 1)
+	}
+
+	kp, err := keypair.Parse(p.Signers[txe.Tx.SourceAccount.Address()])
+	if err != nil {
+		return errors.Wrap(err, 1)
+	}
+
+	hash, err := network.HashTransaction(&txe.Tx, passphrase)
+	if err != nil {
+		return errors.Wrap(err, 1)
+	}
+
+	for _, sig := range txe.Signatures {
+		if kp.Verify(hash[:], sig.Signature) != nil {
+			return ErrFeeBumpForeignSignatures
+		}
+	}
+
+	return nil
+}
+
+// bump rebuilds the transaction of `env` with a higher fee and signs it with
+// its source account's seed.
+func (p *FeeBumpPolicy) bump(env string, passphrase string) (string, error) {
+	err := p.checkSignatures(env, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	var txe xdr.TransactionEnvelope
+	err = xdr.SafeUnmarshalBase64(env, &txe)
+	if err != nil {
+		return "", errors.Wrap(err, 1)
+	}
+
+	ops := uint32(len(txe.Tx.Operations))
+	if ops == 0 {
+		return "", errors.New("transaction has no operations")
+	}
+
+	baseFee, err := p.baseFee(uint32(txe.Tx.Fee) / ops)
+	if err != nil {
+		return "", err
+	}
+	txe.Tx.Fee = xdr.Uint32(baseFee * ops)
+
+	aid := txe.Tx.SourceAccount.Address()
+	txb := build.TransactionBuilder{TX: &txe.Tx}
+	err = txb.Mutate(build.Network{passphrase})
+	if err != nil {
+		return "", errors.Wrap(err, 1)
+	}
+
+	bumped, err := txb.Sign(p.Signers[aid])
+	if err != nil {
+		return "", errors.Wrap(err, 1)
+	}
+
+	return bumped.Base64()
+}
+
+// submitWithFeeBump submits `env` and, if the FeeBump policy applies,
+// resubmits it with a higher fee until stellar-core accepts it or the policy
+// gives up.  It returns the last submission's result, envelope and info, and
+// every attempt when the transaction was resubmitted.
+func (sys *System) submitWithFeeBump(
+	ctx context.Context,
+	env string,
+	info envelopeInfo,
+) (SubmissionResult, string, envelopeInfo, []SubmissionAttempt) {
+	sr := sys.submitOnce(ctx, env)
+	if !sys.FeeBump.applies(info, sr) {
+		return sr, env, info, nil
+	}
+
+	err := sys.FeeBump.checkSignatures(env, sys.NetworkPassphrase)
+	if err != nil {
+		sys.Log.Ctx(ctx).WithFields(log.F{
+			"hash":   info.Hash,
+			"reason": err.Error(),
+		}).Info("Not resubmitting transaction")
+		return sr, env, info, nil
+	}
+
+	attempts := []SubmissionAttempt{
+		sys.attempt(env, info, sr),
+	}
+
+	for i := 1; i <= sys.FeeBump.MaxAttempts && sys.FeeBump.applies(info, sr); i++ {
+		select {
+		case <-time.After(sys.FeeBump.backoff(i)):
+		case <-ctx.Done():
+			return SubmissionResult{Err: ErrCanceled}, env, info, attempts
+		}
+
+		bumped, err := sys.FeeBump.bump(env, sys.NetworkPassphrase)
+		if err != nil {
+			sys.Log.Ctx(ctx).WithFields(log.F{
+				"hash":   info.Hash,
+				"reason": err.Error(),
+			}).Info("Stopped resubmitting transaction")
+			break
+		}
+
+		bumpedInfo, err := extractEnvelopeInfo(ctx, bumped, sys.NetworkPassphrase)
+		if err != nil {
+			return SubmissionResult{Err: err}, env, info, attempts
+		}
+
+		sys.Log.Ctx(ctx).WithFields(log.F{
+			"hash":     info.Hash,
+			"new_hash": bumpedInfo.Hash,
+			"attempt":  i,
+		}).Info("Resubmitting transaction with a higher fee")
+
+		env, info = bumped, bumpedInfo
+		sr = sys.submitOnce(ctx, env)
+		attempts = append(attempts, sys.attempt(env, info, sr))
+	}
+
+	return sr, env, info, attempts
+}
+
+// attempt describes the submission of `env`.
+func (sys *System) attempt(env string, info envelopeInfo, sr SubmissionResult) SubmissionAttempt {
+	var txe xdr.TransactionEnvelope
+	// env was already decoded by extractEnvelopeInfo
+	xdr.SafeUnmarshalBase64(env, &txe)
+
+	return SubmissionAttempt{
+		Hash:        info.Hash,
+		EnvelopeXDR: env,
+		Fee:         uint32(txe.Tx.Fee),
+		Err:         sr.Err,
+	}
+}
+
+// withAttempts returns a listener forwarding the result it receives to
+// `response` with the `attempts` that led to it.
+func withAttempts(response chan Result, attempts []SubmissionAttempt) chan Result {
+	if len(attempts) == 0 {
+		return response
+	}
+
+	l := make(chan Result, 1)
+	go func() {
+		r, ok := <-l
+		if ok {
+			r.Attempts = attempts
+			response <- r
+		}
+		close(response)
+	}()
+
+	return l
+}
@@ -52,6 +52,10 @@ type OpenSubmissionList interface {
 	// Pending return a list of transaction hashes that have at least one
 	// listener registered to them in this list.
 	Pending(context.Context) []string
+
+	// IsPending returns true if the provided transaction hash is open in this
+	// list.
+	IsPending(context.Context, string) (bool, error)
 }
 
 // Submitter represents the low-level "submit a transaction to stellar-core"
@@ -85,6 +89,11 @@ type Result struct {
 	// The base64-encoded TransactionMeta for the transaction this result
 	// corresponds to
 	ResultMetaXDR string
+
+	// Every submission made when the transaction was resubmitted with a
+	// higher fee, see FeeBumpPolicy.  The last attempt is the transaction
+	// this result corresponds to.
+	Attempts []SubmissionAttempt
 }
 
 // SubmissionResult gets returned in response to a call to Submitter.Submit.
@@ -96,6 +105,10 @@ type SubmissionResult struct {
 	// inclusion in the ledger (i.e. A successful submission).
 	Err error
 
+	// Status records the status stellar-core responded with: PENDING,
+	// DUPLICATE or ERROR.  It is empty if stellar-core could not be reached.
+	Status string
+
 	// Duration records the time it took to submit a transaction
 	// to stellar-core
 	Duration time.Duration
@@ -121,3 +121,11 @@ func (s *submissionList) Pending(ctx context.Context) []string {
 
 	return results
 }
+
+func (s *submissionList) IsPending(ctx context.Context, hash string) (bool, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	_, ok := s.submissions[hash]
+	return ok, nil
+}
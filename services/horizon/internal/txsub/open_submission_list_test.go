@@ -145,6 +145,17 @@ func (suite *SubmissionListTestSuite) TestSubmissionList_Pending() {
 	assert.Equal(suite.T(), 2, len(suite.list.Pending(suite.ctx)))
 }
 
+func (suite *SubmissionListTestSuite) TestSubmissionList_IsPending() {
+	pending, err := suite.list.IsPending(suite.ctx, suite.hashes[0])
+	assert.Nil(suite.T(), err)
+	assert.False(suite.T(), pending)
+
+	suite.list.Add(suite.ctx, suite.hashes[0], suite.listeners[0])
+	pending, err = suite.list.IsPending(suite.ctx, suite.hashes[0])
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), pending)
+}
+
 func TestSubmissionListTestSuite(t *testing.T) {
 	suite.Run(t, new(SubmissionListTestSuite))
 }
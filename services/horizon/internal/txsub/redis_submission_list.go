@@ -0,0 +1,258 @@
+package txsub
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/kinecosystem/go/support/log"
+)
+
+// NewRedisSubmissionList returns a list that shares open submissions between
+// every horizon instance connected to the redis server behind `pool`, using
+// the redis hash stored at `key`.  A transaction submitted through one
+// instance is reported as pending by all of them, so that a resubmission
+// reaching another instance waits for the open submission instead of being
+// submitted again, and the submission times out at the same time everywhere.
+//
+// Listeners can't leave the process they were created in: each instance
+// notifies its own listeners when it finds the result of their transaction.
+func NewRedisSubmissionList(pool *redis.Pool, key string) OpenSubmissionList {
+	return &redisSubmissionList{
+		pool:        pool,
+		key:         key,
+		submissions: map[string]*openSubmission{},
+		log:         log.DefaultLogger.WithField("service", "txsub.redisSubmissionList"),
+	}
+}
+
+// scanCount is the number of entries of the shared hash requested per HSCAN
+// call.
+const scanCount = 100
+
+type redisSubmissionList struct {
+	sync.Mutex
+	pool        *redis.Pool
+	key         string
+	submissions map[string]*openSubmission // hash => `*openSubmission` with local listeners
+	log         *log.Entry
+}
+
+func (s *redisSubmissionList) Add(ctx context.Context, hash string, l Listener) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if cap(l) == 0 {
+		panic("Unbuffered listener cannot be added to OpenSubmissionList")
+	}
+
+	if len(hash) != 64 {
+		return errors.New("Unexpected transaction hash length: must be 64 hex characters")
+	}
+
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	// HSETNX keeps the time of the first submission made in the cluster
+	_, err := conn.Do("HSETNX", s.key, hash, time.Now().UnixNano())
+	if err != nil {
+		return errors.Wrap(err, 1)
+	}
+
+	submittedAt, err := redis.Int64(conn.Do("HGET", s.key, hash))
+	if err == redis.ErrNil {
+		// finished by another instance in the meantime
+		submittedAt = time.Now().UnixNano()
+	} else if err != nil {
+		return errors.Wrap(err, 1)
+	}
+
+	os, ok := s.submissions[hash]
+
+	if !ok {
+		os = &openSubmission{
+			Hash:        hash,
+			SubmittedAt: time.Unix(0, submittedAt),
+			Listeners:   []Listener{},
+		}
+		s.submissions[hash] = os
+		s.log.WithField("hash", hash).Info("Created a new submission for a transaction")
+	} else {
+		s.log.WithField("hash", hash).Info("Adding listener to existing submission")
+	}
+
+	os.Listeners = append(os.Listeners, l)
+
+	return nil
+}
+
+func (s *redisSubmissionList) Finish(ctx context.Context, r Result) error {
+	s.Lock()
+	defer s.Unlock()
+
+	os, ok := s.submissions[r.Hash]
+	if ok {
+		s.log.WithFields(log.F{
+			"hash":      r.Hash,
+			"listeners": len(os.Listeners),
+			"result":    fmt.Sprintf("%+v", r),
+		}).Info("Sending submission result to listeners")
+
+		for _, l := range os.Listeners {
+			l <- r
+			close(l)
+		}
+
+		delete(s.submissions, r.Hash)
+	}
+
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HDEL", s.key, r.Hash)
+	if err != nil {
+		return errors.Wrap(err, 1)
+	}
+
+	return nil
+}
+
+func (s *redisSubmissionList) Clean(ctx context.Context, maxAge time.Duration) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	shared := map[string]bool{}
+	err := s.scan(conn, func(hash string, submittedAt int64) error {
+		if time.Since(time.Unix(0, submittedAt)) <= maxAge {
+			shared[hash] = true
+			return nil
+		}
+
+		_, err := conn.Do("HDEL", s.key, hash)
+		if err != nil {
+			return errors.Wrap(err, 1)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, os := range s.submissions {
+		if time.Since(os.SubmittedAt) > maxAge {
+			s.log.WithFields(log.F{
+				"hash":      os.Hash,
+				"listeners": len(os.Listeners),
+			}).Warn("Cleared submission due to timeout")
+			r := Result{Err: ErrTimeout}
+			delete(s.submissions, os.Hash)
+			for _, l := range os.Listeners {
+				l <- r
+				close(l)
+			}
+		}
+	}
+
+	left := len(shared)
+	for hash := range s.submissions {
+		if _, ok := shared[hash]; !ok {
+			left++
+		}
+	}
+
+	return left, nil
+}
+
+func (s *redisSubmissionList) Pending(ctx context.Context) []string {
+	s.Lock()
+	defer s.Unlock()
+
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	results := make([]string, 0, len(s.submissions))
+	seen := map[string]bool{}
+
+	// HSCAN can return a hash more than once
+	err := s.scan(conn, func(hash string, submittedAt int64) error {
+		if !seen[hash] {
+			seen[hash] = true
+			results = append(results, hash)
+		}
+		return nil
+	})
+	if err != nil {
+		// the local submissions can still be resolved
+		s.log.WithStack(err).Error(err)
+	}
+
+	for hash := range s.submissions {
+		if !seen[hash] {
+			results = append(results, hash)
+		}
+	}
+
+	return results
+}
+
+func (s *redisSubmissionList) IsPending(ctx context.Context, hash string) (bool, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.submissions[hash]; ok {
+		return true, nil
+	}
+
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	exists, err := redis.Bool(conn.Do("HEXISTS", s.key, hash))
+	if err != nil {
+		return false, errors.Wrap(err, 1)
+	}
+
+	return exists, nil
+}
+
+// scan calls `fn` with each shared submission and the time it was submitted
+// at, iterating over the hash with HSCAN instead of loading it whole.
+func (s *redisSubmissionList) scan(conn redis.Conn, fn func(hash string, submittedAt int64) error) error {
+	cursor := 0
+	for {
+		reply, err := redis.Values(conn.Do("HSCAN", s.key, cursor, "COUNT", scanCount))
+		if err != nil {
+			return errors.Wrap(err, 1)
+		}
+
+		if len(reply) != 2 {
+			return errors.Errorf("unexpected HSCAN reply: %v", reply)
+		}
+
+		cursor, err = redis.Int(reply[0], nil)
+		if err != nil {
+			return errors.Wrap(err, 1)
+		}
+
+		page, err := redis.Int64Map(reply[1], nil)
+		if err != nil {
+			return errors.Wrap(err, 1)
+		}
+
+		for hash, submittedAt := range page {
+			err = fn(hash, submittedAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
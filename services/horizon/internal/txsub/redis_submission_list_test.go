@@ -0,0 +1,203 @@
+package txsub
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RedisSubmissionListTestSuite struct {
+	suite.Suite
+	pool      *redis.Pool
+	key       string
+	list      OpenSubmissionList
+	other     OpenSubmissionList
+	listeners []chan Result
+	hashes    []string
+	ctx       context.Context
+}
+
+const testRedisURL = "redis://127.0.0.1:6379/"
+
+func (suite *RedisSubmissionListTestSuite) SetupSuite() {
+	conn, err := redis.DialURL(testRedisURL)
+	if err == nil {
+		_, err = conn.Do("PING")
+		conn.Close()
+	}
+	if err != nil {
+		suite.T().Skipf("redis is not available at %s: %v", testRedisURL, err)
+	}
+}
+
+func (suite *RedisSubmissionListTestSuite) SetupTest() {
+	suite.pool = &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.DialURL(testRedisURL)
+		},
+	}
+	suite.key = "horizon-txsub-test"
+
+	conn := suite.pool.Get()
+	conn.Do("DEL", suite.key)
+	conn.Close()
+
+	// two lists sharing the same key act as two horizons of a cluster
+	suite.list = NewRedisSubmissionList(suite.pool, suite.key)
+	suite.other = NewRedisSubmissionList(suite.pool, suite.key)
+	suite.hashes = []string{
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+	}
+	suite.listeners = []chan Result{
+		make(chan Result, 1),
+		make(chan Result, 1),
+	}
+	suite.ctx = test.Context()
+}
+
+func (suite *RedisSubmissionListTestSuite) TearDownTest() {
+	suite.pool.Close()
+}
+
+// receive returns the next value sent on `listener`, failing the test rather
+// than blocking when nothing is sent.
+func (suite *RedisSubmissionListTestSuite) receive(listener chan Result) (Result, bool) {
+	select {
+	case r, ok := <-listener:
+		return r, ok
+	case <-time.After(time.Second):
+		suite.T().Fatal("listener was neither sent a result nor closed")
+		return Result{}, false
+	}
+}
+
+func (suite *RedisSubmissionListTestSuite) TestRedisSubmissionList_Add() {
+	err := suite.list.Add(suite.ctx, suite.hashes[0], suite.listeners[0])
+	assert.Nil(suite.T(), err)
+
+	// shares the submission with the other horizons
+	assert.Equal(suite.T(), []string{suite.hashes[0]}, suite.other.Pending(suite.ctx))
+
+	// keeps the submitted at time of the first submission in the cluster
+	<-time.After(20 * time.Millisecond)
+	err = suite.other.Add(suite.ctx, suite.hashes[0], suite.listeners[1])
+	assert.Nil(suite.T(), err)
+	first := suite.list.(*redisSubmissionList).submissions[suite.hashes[0]]
+	second := suite.other.(*redisSubmissionList).submissions[suite.hashes[0]]
+	assert.True(suite.T(), first.SubmittedAt.Equal(second.SubmittedAt))
+
+	// panics when the listener is not buffered
+	assert.Panics(suite.T(), func() {
+		suite.list.Add(suite.ctx, suite.hashes[0], make(Listener))
+	})
+
+	// errors when the provided hash is not 64-bytes
+	err = suite.list.Add(suite.ctx, "123", suite.listeners[0])
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *RedisSubmissionListTestSuite) TestRedisSubmissionList_Finish() {
+	suite.list.Add(suite.ctx, suite.hashes[0], suite.listeners[0])
+	suite.other.Add(suite.ctx, suite.hashes[0], suite.listeners[1])
+	r := Result{
+		Hash: suite.hashes[0],
+	}
+	err := suite.list.Finish(suite.ctx, r)
+	assert.Nil(suite.T(), err)
+
+	// writes to the local listeners
+	r1, ok1 := suite.receive(suite.listeners[0])
+	assert.Equal(suite.T(), r, r1)
+	assert.True(suite.T(), ok1)
+	_, more := suite.receive(suite.listeners[0])
+	assert.False(suite.T(), more)
+
+	// leaves the listeners of the other horizons alone
+	assert.Equal(suite.T(), 0, len(suite.listeners[1]))
+
+	// removes the shared entry
+	assert.Equal(suite.T(), []string{suite.hashes[0]}, suite.other.Pending(suite.ctx))
+	err = suite.other.Finish(suite.ctx, r)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 0, len(suite.other.Pending(suite.ctx)))
+
+	// works when no one is waiting for the result
+	err = suite.list.Finish(suite.ctx, r)
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *RedisSubmissionListTestSuite) TestRedisSubmissionList_Clean() {
+	suite.list.Add(suite.ctx, suite.hashes[0], suite.listeners[0])
+	<-time.After(200 * time.Millisecond)
+	suite.other.Add(suite.ctx, suite.hashes[1], suite.listeners[1])
+	left, err := suite.list.Clean(suite.ctx, 200*time.Millisecond)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 1, left)
+
+	// removes submissions older than the maxAge provided
+	assert.Equal(suite.T(), []string{suite.hashes[1]}, suite.list.Pending(suite.ctx))
+
+	// closes any cleaned listeners
+	assert.Equal(suite.T(), 1, len(suite.listeners[0]))
+	suite.receive(suite.listeners[0])
+	select {
+	case _, stillOpen := <-suite.listeners[0]:
+		assert.False(suite.T(), stillOpen)
+	default:
+		panic("cleaned listener is still open")
+	}
+}
+
+func (suite *RedisSubmissionListTestSuite) TestRedisSubmissionList_Pending() {
+	assert.Equal(suite.T(), 0, len(suite.list.Pending(suite.ctx)))
+	suite.list.Add(suite.ctx, suite.hashes[0], suite.listeners[0])
+	assert.Equal(suite.T(), 1, len(suite.list.Pending(suite.ctx)))
+	suite.other.Add(suite.ctx, suite.hashes[1], suite.listeners[1])
+	assert.Equal(suite.T(), 2, len(suite.list.Pending(suite.ctx)))
+	assert.Equal(suite.T(), 2, len(suite.other.Pending(suite.ctx)))
+}
+
+// Lists the shared submissions over several HSCAN pages.
+func (suite *RedisSubmissionListTestSuite) TestRedisSubmissionList_PendingPages() {
+	for i := 0; i < 3*scanCount; i++ {
+		hash := fmt.Sprintf("%064x", i)
+		err := suite.other.Add(suite.ctx, hash, make(chan Result, 1))
+		assert.Nil(suite.T(), err)
+	}
+
+	assert.Equal(suite.T(), 3*scanCount, len(suite.list.Pending(suite.ctx)))
+
+	left, err := suite.list.Clean(suite.ctx, time.Hour)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 3*scanCount, left)
+}
+
+func (suite *RedisSubmissionListTestSuite) TestRedisSubmissionList_IsPending() {
+	pending, err := suite.list.IsPending(suite.ctx, suite.hashes[0])
+	assert.Nil(suite.T(), err)
+	assert.False(suite.T(), pending)
+
+	suite.other.Add(suite.ctx, suite.hashes[0], suite.listeners[0])
+
+	// sees the submissions of the other horizons
+	pending, err = suite.list.IsPending(suite.ctx, suite.hashes[0])
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), pending)
+
+	suite.other.Finish(suite.ctx, Result{Hash: suite.hashes[0]})
+	pending, err = suite.list.IsPending(suite.ctx, suite.hashes[0])
+	assert.Nil(suite.T(), err)
+	assert.False(suite.T(), pending)
+}
+
+func TestRedisSubmissionListTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisSubmissionListTestSuite))
+}
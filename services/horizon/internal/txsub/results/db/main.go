@@ -41,7 +41,7 @@ func (rp *DB) ResultByHash(ctx context.Context, hash string) txsub.Result {
 	// query core database
 	var cr core.Transaction
 	// In the past we were searching for the transaction in core DB *after* the
-	// latest ingested ledger. This was incorrect because history DB contains
+	// latest ingested ledger. This was incorrect because history DB contained
 	// successful transactions only. So it was possible that the transaction was
 	// never found and clients were receiving Timeout errors.
 	// However we can't change it to simply find a transaction by hash because
@@ -65,13 +65,22 @@ func (rp *DB) ResultByHash(ctx context.Context, hash string) txsub.Result {
 }
 
 func txResultFromHistory(tx history.Transaction) txsub.Result {
-	return txsub.Result{
+	result := txsub.Result{
 		Hash:           tx.TransactionHash,
 		LedgerSequence: tx.LedgerSequence,
 		EnvelopeXDR:    tx.TxEnvelope,
 		ResultXDR:      tx.TxResult,
-		ResultMetaXDR:  tx.TxMeta,
+		ResultMetaXDR:  tx.TxMeta.String,
 	}
+
+	// failed transactions are ingested too
+	if !tx.IsSuccessful() {
+		result.Err = &txsub.FailedTransactionError{
+			ResultXDR: tx.TxResult,
+		}
+	}
+
+	return result
 }
 
 func txResultFromCore(tx core.Transaction) txsub.Result {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rcrowley/go-metrics"
+	proto "github.com/kinecosystem/go/protocols/stellarcore"
 	"github.com/kinecosystem/go/services/horizon/internal/txsub/sequence"
 	"github.com/kinecosystem/go/support/log"
 )
@@ -29,6 +30,10 @@ type System struct {
 	SubmissionTimeout time.Duration
 	Log               *log.Entry
 
+	// FeeBump, when set, resubmits the transactions of server-owned accounts
+	// that were rejected because of a too low fee.
+	FeeBump *FeeBumpPolicy
+
 	Metrics struct {
 		// SubmissionTimer exposes timing metrics about the rate and latency of
 		// submissions to stellar-core
@@ -88,6 +93,24 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 
 	// From now: r.Err == ErrNoResults
 
+	// If the transaction is still open, possibly submitted through another
+	// horizon instance sharing the open submission list, wait for its result
+	// instead of submitting it again.
+	pending, err := sys.Pending.IsPending(ctx, info.Hash)
+	if err != nil {
+		sys.finish(ctx, response, Result{Err: err, EnvelopeXDR: env})
+		return
+	}
+
+	if pending {
+		sys.Log.Ctx(ctx).WithField("hash", info.Hash).Info("Waiting for open submission")
+		err = sys.Pending.Add(ctx, info.Hash, response)
+		if err != nil {
+			sys.finish(ctx, response, Result{Err: err, EnvelopeXDR: env})
+		}
+		return
+	}
+
 	curSeq, err := sys.Sequences.Get([]string{info.SourceAddress})
 	if err != nil {
 		sys.finish(ctx, response, Result{Err: err, EnvelopeXDR: env})
@@ -121,26 +144,35 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 			return
 		}
 
-		sr := sys.submitOnce(ctx, env)
+		var (
+			sr       SubmissionResult
+			attempts []SubmissionAttempt
+		)
+		sr, env, info, attempts = sys.submitWithFeeBump(ctx, env, info)
 
 		// if submission succeeded
 		if sr.Err == nil {
-			// add transactions to open list
-			sys.Pending.Add(ctx, info.Hash, response)
 			// update the submission queue, allowing the next submission to proceed
 			sys.SubmissionQueue.Update(map[string]uint64{info.SourceAddress: info.Sequence})
+
+			// add transactions to open list
+			listener := withAttempts(response, attempts)
+			err = sys.Pending.Add(ctx, info.Hash, listener)
+			if err != nil {
+				sys.finish(ctx, listener, Result{Err: err, EnvelopeXDR: env})
+			}
 			return
 		}
 
 		// any error other than "txBAD_SEQ" is a failure
 		isBad, err := sr.IsBadSeq()
 		if err != nil {
-			sys.finish(ctx, response, Result{Err: err, EnvelopeXDR: env})
+			sys.finish(ctx, response, Result{Err: err, EnvelopeXDR: env, Attempts: attempts})
 			return
 		}
 
 		if !isBad {
-			sys.finish(ctx, response, Result{Err: sr.Err, EnvelopeXDR: env})
+			sys.finish(ctx, response, Result{Err: sr.Err, EnvelopeXDR: env, Attempts: attempts})
 			return
 		}
 
@@ -152,7 +184,7 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 			sys.finish(ctx, response, r)
 		} else {
 			// finally, return the bad_seq error if no result was found on 2nd attempt
-			sys.finish(ctx, response, Result{Err: sr.Err, EnvelopeXDR: env})
+			sys.finish(ctx, response, Result{Err: sr.Err, EnvelopeXDR: env, Attempts: attempts})
 		}
 
 	case <-ctx.Done():
@@ -162,6 +194,71 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 	return
 }
 
+// SubmitAsync submits the provided base64 encoded transaction envelope to
+// stellar-core and returns its hash as soon as stellar-core accepted or
+// rejected it, without waiting for the transaction to be applied.  Accepted
+// transactions are kept in the open submission list until their result is
+// found or they time out, see Status.  Transactions that were already applied
+// are not submitted again, and are reported as DUPLICATE.
+func (sys *System) SubmitAsync(ctx context.Context, env string) (string, SubmissionResult) {
+	sys.Init()
+
+	info, err := extractEnvelopeInfo(ctx, env, sys.NetworkPassphrase)
+	if err != nil {
+		return "", SubmissionResult{Err: err}
+	}
+
+	sys.Log.Ctx(ctx).WithFields(log.F{
+		"hash": info.Hash,
+		"tx":   env,
+	}).Info("Processing asynchronous transaction")
+
+	// check the configured result provider for an existing result
+	r := sys.Results.ResultByHash(ctx, info.Hash)
+	if _, failed := r.Err.(*FailedTransactionError); r.Err == nil || failed {
+		sys.Log.Ctx(ctx).WithField("hash", info.Hash).Info("Found submission result in a DB")
+		return info.Hash, SubmissionResult{Status: proto.TXStatusDuplicate}
+	}
+
+	if r.Err != ErrNoResults {
+		return info.Hash, SubmissionResult{Err: r.Err}
+	}
+
+	sr := sys.submitOnce(ctx, env)
+	if sr.Err == nil {
+		// Nobody reads from this listener, it only keeps the submission open.
+		err = sys.Pending.Add(ctx, info.Hash, make(chan Result, 1))
+		if err != nil {
+			sys.Log.Ctx(ctx).WithStack(err).Error(err)
+		}
+	}
+
+	return info.Hash, sr
+}
+
+// Status returns the result of the transaction identified by `hash`.  The
+// result's Err is ErrPending while the transaction is still in the open
+// submission list, and ErrNoResults if it is unknown.
+func (sys *System) Status(ctx context.Context, hash string) Result {
+	sys.Init()
+
+	r := sys.Results.ResultByHash(ctx, hash)
+	if r.Err != ErrNoResults {
+		return r
+	}
+
+	pending, err := sys.Pending.IsPending(ctx, hash)
+	if err != nil {
+		return Result{Err: err, Hash: hash}
+	}
+
+	if pending {
+		return Result{Err: ErrPending, Hash: hash}
+	}
+
+	return r
+}
+
 // Submit submits the provided base64 encoded transaction envelope to the
 // network using this submission system.
 func (sys *System) submitOnce(ctx context.Context, env string) SubmissionResult {
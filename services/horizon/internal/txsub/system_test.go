@@ -8,9 +8,14 @@ import (
 	"time"
 
 	"github.com/kinecosystem/go/build"
+	"github.com/kinecosystem/go/keypair"
+	"github.com/kinecosystem/go/network"
+	"github.com/kinecosystem/go/services/horizon/internal/operationfeestats"
 	"github.com/kinecosystem/go/services/horizon/internal/test"
 	"github.com/kinecosystem/go/services/horizon/internal/txsub/sequence"
+	"github.com/kinecosystem/go/xdr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -24,6 +29,8 @@ type SystemTestSuite struct {
 	noResults Result
 	successTx Result
 	badSeq    SubmissionResult
+
+	insufficientFee SubmissionResult
 }
 
 func (suite *SystemTestSuite) SetupTest() {
@@ -53,6 +60,10 @@ func (suite *SystemTestSuite) SetupTest() {
 		Err: ErrBadSequence,
 	}
 
+	suite.insufficientFee = SubmissionResult{
+		Err: &FailedTransactionError{"AAAAAAAAAAD////3AAAAAA=="},
+	}
+
 	suite.sequences.On("Get", []string{"GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"}).
 		Return(map[string]uint64{"GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H": 0}, nil).
 		Once()
@@ -113,6 +124,192 @@ func (suite *SystemTestSuite) TestSubmit_OpenTransactionList() {
 	assert.Equal(suite.T(), int64(1), suite.system.Metrics.SubmissionTimer.Count())
 }
 
+// Fails the submission when it can't be added to the open transaction list,
+// instead of leaving the client waiting for a result that never comes.
+func (suite *SystemTestSuite) TestSubmit_OpenTransactionListError() {
+	suite.system.Pending = failingSubmissionList{NewDefaultSubmissionList()}
+
+	select {
+	case r := <-suite.system.Submit(suite.ctx, suite.successTx.EnvelopeXDR):
+		assert.EqualError(suite.T(), r.Err, "redis is down")
+	case <-time.After(time.Second):
+		suite.T().Fatal("no result was sent")
+	}
+	assert.True(suite.T(), suite.submitter.WasSubmittedTo)
+}
+
+// SubmitAsync returns core's response straight away and keeps accepted
+// transactions open.
+func (suite *SystemTestSuite) TestSubmitAsync_Pending() {
+	suite.submitter.R.Status = "PENDING"
+	hash, sr := suite.system.SubmitAsync(suite.ctx, suite.successTx.EnvelopeXDR)
+
+	assert.Nil(suite.T(), sr.Err)
+	assert.Equal(suite.T(), "PENDING", sr.Status)
+	assert.Equal(suite.T(), suite.successTx.Hash, hash)
+	assert.True(suite.T(), suite.submitter.WasSubmittedTo)
+
+	pending := suite.system.Pending.Pending(suite.ctx)
+	assert.Equal(suite.T(), []string{suite.successTx.Hash}, pending)
+
+	r := suite.system.Status(suite.ctx, hash)
+	assert.Equal(suite.T(), ErrPending, r.Err)
+
+	suite.results.Results = []Result{suite.successTx}
+	r = suite.system.Status(suite.ctx, hash)
+	assert.Nil(suite.T(), r.Err)
+	assert.Equal(suite.T(), suite.successTx.LedgerSequence, r.LedgerSequence)
+}
+
+// SubmitAsync doesn't resubmit transactions that were already applied.
+func (suite *SystemTestSuite) TestSubmitAsync_Applied() {
+	suite.results.Results = []Result{suite.successTx}
+	hash, sr := suite.system.SubmitAsync(suite.ctx, suite.successTx.EnvelopeXDR)
+
+	assert.Nil(suite.T(), sr.Err)
+	assert.Equal(suite.T(), "DUPLICATE", sr.Status)
+	assert.Equal(suite.T(), suite.successTx.Hash, hash)
+	assert.False(suite.T(), suite.submitter.WasSubmittedTo)
+	assert.Equal(suite.T(), 0, len(suite.system.Pending.Pending(suite.ctx)))
+}
+
+// SubmitAsync doesn't keep rejected transactions open.
+func (suite *SystemTestSuite) TestSubmitAsync_Error() {
+	suite.submitter.R = suite.badSeq
+	suite.submitter.R.Status = "ERROR"
+	hash, sr := suite.system.SubmitAsync(suite.ctx, suite.successTx.EnvelopeXDR)
+
+	assert.Equal(suite.T(), ErrBadSequence, sr.Err)
+	assert.Equal(suite.T(), "ERROR", sr.Status)
+	assert.Equal(suite.T(), 0, len(suite.system.Pending.Pending(suite.ctx)))
+
+	r := suite.system.Status(suite.ctx, hash)
+	assert.Equal(suite.T(), ErrNoResults, r.Err)
+}
+
+// SubmitAsync rejects malformed envelopes without submitting them.
+func (suite *SystemTestSuite) TestSubmitAsync_Malformed() {
+	_, sr := suite.system.SubmitAsync(suite.ctx, "foo")
+
+	_, ok := sr.Err.(*MalformedTransactionError)
+	assert.True(suite.T(), ok)
+	assert.False(suite.T(), suite.submitter.WasSubmittedTo)
+}
+
+// If the transaction is already open, wait for it instead of resubmitting.
+func (suite *SystemTestSuite) TestSubmit_AlreadyOpen() {
+	l := make(chan Result, 1)
+	suite.system.Pending.Add(suite.ctx, suite.successTx.Hash, l)
+
+	r := suite.system.Submit(suite.ctx, suite.successTx.EnvelopeXDR)
+	assert.False(suite.T(), suite.submitter.WasSubmittedTo)
+	assert.Equal(suite.T(), 0, len(r))
+
+	suite.results.Results = []Result{suite.successTx}
+	suite.system.Tick(suite.ctx)
+
+	assert.Equal(suite.T(), suite.successTx.Hash, (<-r).Hash)
+	assert.Equal(suite.T(), 1, len(l))
+}
+
+// Resubmits with a higher fee the transactions of server-owned accounts
+// rejected with txINSUFFICIENT_FEE, and reports every attempt.
+func (suite *SystemTestSuite) TestSubmit_FeeBump() {
+	suite.system.FeeBump = suite.feeBump(0)
+	suite.submitter.Results = []SubmissionResult{suite.insufficientFee}
+
+	r := suite.system.Submit(suite.ctx, suite.successTx.EnvelopeXDR)
+	assert.Equal(suite.T(), 2, len(suite.submitter.Envelopes))
+
+	pending := suite.system.Pending.Pending(suite.ctx)
+	assert.Equal(suite.T(), 1, len(pending))
+	assert.NotEqual(suite.T(), suite.successTx.Hash, pending[0])
+
+	suite.results.Results = []Result{{Hash: pending[0], LedgerSequence: 3}}
+	suite.system.Tick(suite.ctx)
+
+	result := <-r
+	assert.Nil(suite.T(), result.Err)
+	assert.Equal(suite.T(), pending[0], result.Hash)
+	if assert.Equal(suite.T(), 2, len(result.Attempts)) {
+		assert.Equal(suite.T(), suite.successTx.Hash, result.Attempts[0].Hash)
+		assert.Equal(suite.T(), uint32(100), result.Attempts[0].Fee)
+		assert.Equal(suite.T(), suite.insufficientFee.Err, result.Attempts[0].Err)
+		assert.Equal(suite.T(), pending[0], result.Attempts[1].Hash)
+		assert.Equal(suite.T(), uint32(150), result.Attempts[1].Fee)
+		assert.Equal(suite.T(), suite.submitter.Envelopes[1], result.Attempts[1].EnvelopeXDR)
+		assert.Nil(suite.T(), result.Attempts[1].Err)
+	}
+}
+
+// Gives up resubmitting once the fee reaches the policy's maximum.
+func (suite *SystemTestSuite) TestSubmit_FeeBumpExhausted() {
+	suite.system.FeeBump = suite.feeBump(300)
+	suite.submitter.R = suite.insufficientFee
+
+	r := <-suite.system.Submit(suite.ctx, suite.successTx.EnvelopeXDR)
+
+	assert.Equal(suite.T(), suite.insufficientFee.Err, r.Err)
+	// 100, 150, 300
+	if assert.Equal(suite.T(), 3, len(r.Attempts)) {
+		assert.Equal(suite.T(), uint32(300), r.Attempts[2].Fee)
+	}
+	assert.Equal(suite.T(), 0, len(suite.system.Pending.Pending(suite.ctx)))
+}
+
+// Doesn't resubmit the transactions of accounts the server doesn't own.
+func (suite *SystemTestSuite) TestSubmit_FeeBumpNotOwned() {
+	suite.system.FeeBump = suite.feeBump(0)
+	suite.system.FeeBump.Signers = map[string]string{}
+	suite.submitter.R = suite.insufficientFee
+
+	r := <-suite.system.Submit(suite.ctx, suite.successTx.EnvelopeXDR)
+
+	assert.Equal(suite.T(), suite.insufficientFee.Err, r.Err)
+	assert.Equal(suite.T(), 0, len(r.Attempts))
+	assert.Equal(suite.T(), 1, len(suite.submitter.Envelopes))
+}
+
+// Doesn't resubmit transactions carrying signatures of other signers, which
+// would be lost.
+func (suite *SystemTestSuite) TestSubmit_FeeBumpForeignSignatures() {
+	suite.system.FeeBump = suite.feeBump(0)
+	suite.submitter.R = suite.insufficientFee
+
+	var txe xdr.TransactionEnvelope
+	err := xdr.SafeUnmarshalBase64(suite.successTx.EnvelopeXDR, &txe)
+	require.NoError(suite.T(), err)
+	hash, err := network.HashTransaction(&txe.Tx, suite.system.NetworkPassphrase)
+	require.NoError(suite.T(), err)
+	cosigner, err := keypair.Random()
+	require.NoError(suite.T(), err)
+	sig, err := cosigner.SignDecorated(hash[:])
+	require.NoError(suite.T(), err)
+	txe.Signatures = append(txe.Signatures, sig)
+	env, err := xdr.MarshalBase64(txe)
+	require.NoError(suite.T(), err)
+
+	r := <-suite.system.Submit(suite.ctx, env)
+
+	assert.Equal(suite.T(), suite.insufficientFee.Err, r.Err)
+	assert.Equal(suite.T(), 0, len(r.Attempts))
+	assert.Equal(suite.T(), 1, len(suite.submitter.Envelopes))
+}
+
+func (suite *SystemTestSuite) feeBump(maxBaseFee uint32) *FeeBumpPolicy {
+	return &FeeBumpPolicy{
+		Signers: map[string]string{
+			"GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H": "SDHOAMBNLGCE2MV5ZKIVZAQD3VCLGP53P3OBSBI6UN5L5XZI5TKHFQL4",
+		},
+		MaxAttempts: 5,
+		MaxBaseFee:  maxBaseFee,
+		Backoff:     time.Millisecond,
+		FeeStats: func() operationfeestats.State {
+			return operationfeestats.State{Mode: 150, LastBaseFee: 100}
+		},
+	}
+}
+
 // Tick should be a no-op if there are no open submissions.
 func (suite *SystemTestSuite) TestTick_Noop() {
 	suite.system.Tick(suite.ctx)
@@ -194,6 +391,16 @@ func (suite *SystemTestSuite) TestTick_RemovesStaleSubmissions() {
 	}
 }
 
+// failingSubmissionList is an OpenSubmissionList failing to add submissions,
+// like the redis list does when redis can't be reached.
+type failingSubmissionList struct {
+	OpenSubmissionList
+}
+
+func (l failingSubmissionList) Add(ctx context.Context, hash string, listener Listener) error {
+	return errors.New("redis is down")
+}
+
 func TestSystemTestSuite(t *testing.T) {
 	suite.Run(t, new(SystemTestSuite))
 }
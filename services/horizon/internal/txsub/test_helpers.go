@@ -16,11 +16,24 @@ import (
 type MockSubmitter struct {
 	R              SubmissionResult
 	WasSubmittedTo bool
+
+	// Results, when not empty, are returned in order before R
+	Results []SubmissionResult
+	// Envelopes records every submitted envelope
+	Envelopes []string
 }
 
 // Submit implements `txsub.Submitter`
 func (sub *MockSubmitter) Submit(ctx context.Context, env string) SubmissionResult {
 	sub.WasSubmittedTo = true
+	sub.Envelopes = append(sub.Envelopes, env)
+
+	if len(sub.Results) > 0 {
+		r := sub.Results[0]
+		sub.Results = sub.Results[1:]
+		return r
+	}
+
 	return sub.R
 }
 
@@ -0,0 +1,111 @@
+// Package webhooks contains the webhook notification subsystem of horizon.
+// Operators register subscriptions matching ingested effects by account, asset
+// and effect type.  Each matching effect is queued in the horizon database
+// and POSTed, as signed JSON, to the subscription's HTTPS URL, retrying with
+// exponential backoff until it is accepted.
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/support/db"
+	"github.com/kinecosystem/go/support/render/hal"
+)
+
+// SignatureHeader is the request header holding the hex encoded HMAC-SHA256
+// of a notification's body, keyed with its subscription's secret.
+const SignatureHeader = "X-Horizon-Signature"
+
+// DeliveryHeader is the request header holding the id of a notification.  It
+// is the same for every attempt to deliver it.
+const DeliveryHeader = "X-Horizon-Delivery"
+
+// Subscription is a row of data from the `webhook_subscriptions` table.  Its
+// null fields match every effect.
+type Subscription struct {
+	ID          int64       `db:"id"`
+	URL         string      `db:"url"`
+	Secret      string      `db:"secret"`
+	Account     null.String `db:"account"`
+	AssetType   null.String `db:"asset_type"`
+	AssetCode   null.String `db:"asset_code"`
+	AssetIssuer null.String `db:"asset_issuer"`
+	EffectType  null.Int    `db:"effect_type"`
+	CreatedAt   time.Time   `db:"created_at"`
+}
+
+// PagingToken returns a cursor for this subscription
+func (s Subscription) PagingToken() string {
+	return fmt.Sprintf("%d", s.ID)
+}
+
+// Delivery is a row of data from the `webhook_deliveries` table, joined with
+// the subscription it is sent to.
+type Delivery struct {
+	ID             int64       `db:"id"`
+	SubscriptionID int64       `db:"subscription_id"`
+	URL            string      `db:"url"`
+	Secret         string      `db:"secret"`
+	Payload        string      `db:"payload"`
+	Attempts       int32       `db:"attempts"`
+	NextAttemptAt  time.Time   `db:"next_attempt_at"`
+	LastError      null.String `db:"last_error"`
+}
+
+// Notification is the JSON body POSTed to a subscription's URL.
+type Notification struct {
+	SubscriptionID string       `json:"subscription_id"`
+	Effect         hal.Pageable `json:"effect"`
+}
+
+// Q provides helper methods for the webhook tables of the horizon database.
+type Q struct {
+	*db.Session
+}
+
+// System represents the webhook notification subsystem of horizon.
+type System struct {
+	HorizonDB *db.Session
+
+	// Client is the HTTP client notifications are POSTed with.
+	Client *http.Client
+
+	// NewEffect renders the effects sent in notifications.
+	NewEffect func(context.Context, history.Effect, history.Ledger) (hal.Pageable, error)
+
+	// MaxAttempts bounds the number of times a notification is sent before
+	// being dropped.
+	MaxAttempts int32
+
+	// Backoff is the time to wait before the second attempt to deliver a
+	// notification.  It doubles after each failed attempt, up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// ClaimTimeout is the time a batch of notifications is reserved for the
+	// horizon sending it.  The notifications of a horizon stopped while
+	// sending them are sent again once it elapses.
+	ClaimTimeout time.Duration
+
+	lock       sync.Mutex
+	delivering bool
+}
+
+// New initializes the webhook system, sending notifications from the horizon
+// database.
+func New(horizon *db.Session) *System {
+	return &System{
+		HorizonDB:    horizon,
+		Client:       &http.Client{Timeout: 10 * time.Second},
+		MaxAttempts:  10,
+		Backoff:      10 * time.Second,
+		MaxBackoff:   1 * time.Hour,
+		ClaimTimeout: 5 * time.Minute,
+	}
+}
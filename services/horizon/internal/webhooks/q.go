@@ -0,0 +1,167 @@
+package webhooks
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kinecosystem/go/services/horizon/internal/db2"
+)
+
+// CreateSubscription inserts `s` into the `webhook_subscriptions` table,
+// setting its id and creation time.
+func (q *Q) CreateSubscription(s *Subscription) error {
+	s.CreatedAt = time.Now().UTC()
+
+	sql := sq.Insert("webhook_subscriptions").
+		SetMap(map[string]interface{}{
+			"url":          s.URL,
+			"secret":       s.Secret,
+			"account":      s.Account,
+			"asset_type":   s.AssetType,
+			"asset_code":   s.AssetCode,
+			"asset_issuer": s.AssetIssuer,
+			"effect_type":  s.EffectType,
+			"created_at":   s.CreatedAt,
+		}).
+		Suffix("RETURNING id")
+
+	return q.Get(&s.ID, sql)
+}
+
+// SubscriptionByID loads the subscription identified by `id`.
+func (q *Q) SubscriptionByID(dest *Subscription, id int64) error {
+	sql := selectSubscription.Where("ws.id = ?", id).Limit(1)
+	return q.Get(dest, sql)
+}
+
+// Subscriptions loads a page of subscriptions, ordered by id.
+func (q *Q) Subscriptions(dest *[]Subscription, page db2.PageQuery) error {
+	sql, err := page.ApplyTo(selectSubscription, "ws.id")
+	if err != nil {
+		return err
+	}
+
+	return q.Select(dest, sql)
+}
+
+// AllSubscriptions loads every subscription.
+func (q *Q) AllSubscriptions(dest *[]Subscription) error {
+	return q.Select(dest, selectSubscription.OrderBy("ws.id asc"))
+}
+
+// DeleteSubscription removes the subscription identified by `id` and its
+// pending deliveries.  It returns false if there was no such subscription.
+func (q *Q) DeleteSubscription(id int64) (bool, error) {
+	result, err := q.Exec(sq.Delete("webhook_subscriptions").Where("id = ?", id))
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// EnqueueDelivery queues the notification `payload` for the subscription
+// identified by `subscriptionID`.
+func (q *Q) EnqueueDelivery(subscriptionID int64, payload string) error {
+	now := time.Now().UTC()
+	_, err := q.Exec(sq.Insert("webhook_deliveries").SetMap(map[string]interface{}{
+		"subscription_id": subscriptionID,
+		"payload":         payload,
+		"next_attempt_at": now,
+		"created_at":      now,
+	}))
+	return err
+}
+
+// ClaimDeliveries loads up to `limit` deliveries that should be attempted now
+// and postpones their next attempt to `until`, so that they are not claimed
+// again while being sent.  Deliveries being claimed by another session are
+// skipped, so that horizons sharing a database don't send the same
+// notification concurrently.
+func (q *Q) ClaimDeliveries(dest *[]Delivery, limit uint64, until time.Time) error {
+	return q.SelectRaw(dest, `
+		WITH due AS (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE next_attempt_at <= $1
+			ORDER BY next_attempt_at ASC
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE webhook_deliveries wd
+		SET next_attempt_at = $3
+		FROM due, webhook_subscriptions ws
+		WHERE wd.id = due.id AND ws.id = wd.subscription_id
+		RETURNING
+			wd.id,
+			wd.subscription_id,
+			ws.url,
+			ws.secret,
+			wd.payload,
+			wd.attempts,
+			wd.next_attempt_at,
+			wd.last_error
+	`, time.Now().UTC(), limit, until.UTC())
+}
+
+// RetryDelivery records a failed attempt to send the delivery identified by
+// `id`, scheduling the next one at `next`.
+func (q *Q) RetryDelivery(id int64, attempts int32, next time.Time, reason string) error {
+	_, err := q.Exec(sq.Update("webhook_deliveries").
+		SetMap(map[string]interface{}{
+			"attempts":        attempts,
+			"next_attempt_at": next.UTC(),
+			"last_error":      reason,
+		}).
+		Where("id = ?", id))
+	return err
+}
+
+// DeleteDelivery removes the delivery identified by `id` from the queue.
+func (q *Q) DeleteDelivery(id int64) error {
+	_, err := q.Exec(sq.Delete("webhook_deliveries").Where("id = ?", id))
+	return err
+}
+
+// Cursor loads the paging token of the last effect notified.  Within a
+// transaction, it is locked until the transaction ends.
+func (q *Q) Cursor(dest *string) error {
+	return q.GetRaw(dest, `SELECT cursor FROM webhook_cursor WHERE id = 1 FOR UPDATE`)
+}
+
+// InitCursor stores `cursor` as the paging token of the last effect notified,
+// unless one is stored already.
+func (q *Q) InitCursor(cursor string) error {
+	_, err := q.ExecRaw(
+		`INSERT INTO webhook_cursor (id, cursor) VALUES (1, ?) ON CONFLICT (id) DO NOTHING`,
+		cursor,
+	)
+	return err
+}
+
+// SetCursor stores `cursor` as the paging token of the last effect notified.
+func (q *Q) SetCursor(cursor string) error {
+	_, err := q.ExecRaw(
+		`INSERT INTO webhook_cursor (id, cursor) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET cursor = excluded.cursor`,
+		cursor,
+	)
+	return err
+}
+
+var selectSubscription = sq.Select(
+	"ws.id",
+	"ws.url",
+	"ws.secret",
+	"ws.account",
+	"ws.asset_type",
+	"ws.asset_code",
+	"ws.asset_issuer",
+	"ws.effect_type",
+	"ws.created_at",
+).From("webhook_subscriptions ws")
@@ -0,0 +1,358 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/kinecosystem/go/services/horizon/internal/db2"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/errors"
+	"github.com/kinecosystem/go/services/horizon/internal/toid"
+	serrors "github.com/kinecosystem/go/support/errors"
+	"github.com/kinecosystem/go/support/log"
+)
+
+// Start makes the system notify the effects ingested after the latest ledger
+// of the history database the first time it is started, and the effects
+// ingested after the last one notified afterwards.
+func (s *System) Start() error {
+	var latest int32
+	q := &Q{Session: s.HorizonDB}
+	hq := &history.Q{Session: s.HorizonDB}
+	err := hq.LatestLedger(&latest)
+	if err != nil {
+		return serrors.Wrap(err, "failed to load latest ledger")
+	}
+
+	err = q.InitCursor(ledgerCursor(latest))
+	if err != nil {
+		return serrors.Wrap(err, "failed to initialize cursor")
+	}
+
+	return nil
+}
+
+// Notify queues a notification for each effect ingested since the last call
+// and each subscription it matches.
+func (s *System) Notify(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	hq := &history.Q{Session: s.HorizonDB}
+	wq := &Q{Session: s.HorizonDB}
+
+	var subscriptions []Subscription
+	err := wq.AllSubscriptions(&subscriptions)
+	if err != nil {
+		return serrors.Wrap(err, "failed to load subscriptions")
+	}
+
+	if len(subscriptions) == 0 {
+		var latest int32
+		err = hq.LatestLedger(&latest)
+		if err != nil {
+			return serrors.Wrap(err, "failed to load latest ledger")
+		}
+
+		err = wq.SetCursor(ledgerCursor(latest))
+		if err != nil {
+			return serrors.Wrap(err, "failed to update cursor")
+		}
+		return nil
+	}
+
+	ledgers := map[int32]history.Ledger{}
+	for {
+		n, err := s.notifyPage(ctx, subscriptions, ledgers)
+		if err != nil {
+			return err
+		}
+
+		if uint64(n) < db2.MaxPageSize {
+			return nil
+		}
+	}
+}
+
+// notifyPage queues the notifications of a page of the effects following the
+// cursor and moves the cursor past them, in a single transaction.  It returns
+// the number of effects of the page.
+func (s *System) notifyPage(
+	ctx context.Context,
+	subscriptions []Subscription,
+	ledgers map[int32]history.Ledger,
+) (int, error) {
+	wq := &Q{Session: s.HorizonDB.Clone()}
+	err := wq.Begin()
+	if err != nil {
+		return 0, serrors.Wrap(err, "failed to begin transaction")
+	}
+	defer wq.Rollback()
+	hq := &history.Q{Session: wq.Session}
+
+	// locking the cursor keeps horizons sharing a database from queuing the
+	// same notifications
+	var cursor string
+	err = wq.Cursor(&cursor)
+	if err != nil {
+		return 0, serrors.Wrap(err, "failed to load cursor")
+	}
+
+	var records []history.Effect
+	page := db2.PageQuery{Cursor: cursor, Order: db2.OrderAscending, Limit: db2.MaxPageSize}
+	err = hq.Effects().Page(page).Select(&records)
+	if err != nil {
+		return 0, serrors.Wrap(err, "failed to load effects")
+	}
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	for _, record := range records {
+		var matching []Subscription
+		for _, sub := range subscriptions {
+			if sub.Matches(record) {
+				matching = append(matching, sub)
+			}
+		}
+
+		if len(matching) == 0 {
+			continue
+		}
+
+		seq := record.LedgerSequence()
+		ledger, ok := ledgers[seq]
+		if !ok {
+			err = hq.LedgerBySequence(&ledger, seq)
+			if err != nil {
+				return 0, serrors.Wrapf(err, "failed to load ledger %d", seq)
+			}
+			ledgers[seq] = ledger
+		}
+
+		effect, err := s.NewEffect(ctx, record, ledger)
+		if err != nil {
+			return 0, serrors.Wrap(err, "failed to render effect")
+		}
+
+		for _, sub := range matching {
+			payload, err := json.Marshal(Notification{
+				SubscriptionID: sub.PagingToken(),
+				Effect:         effect,
+			})
+			if err != nil {
+				return 0, serrors.Wrap(err, "failed to marshal notification")
+			}
+
+			err = wq.EnqueueDelivery(sub.ID, string(payload))
+			if err != nil {
+				return 0, serrors.Wrap(err, "failed to queue notification")
+			}
+		}
+	}
+
+	err = wq.SetCursor(records[len(records)-1].PagingToken())
+	if err != nil {
+		return 0, serrors.Wrap(err, "failed to update cursor")
+	}
+
+	err = wq.Commit()
+	if err != nil {
+		return 0, serrors.Wrap(err, "failed to commit transaction")
+	}
+
+	return len(records), nil
+}
+
+// Tick triggers the webhook system to send the notifications due, provided
+// that it is not already sending notifications.
+func (s *System) Tick(ctx context.Context) {
+	s.lock.Lock()
+	if s.delivering {
+		s.lock.Unlock()
+		return
+	}
+	s.delivering = true
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		s.delivering = false
+		s.lock.Unlock()
+	}()
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			err := errors.FromPanic(rec)
+			log.Errorf("webhooks panicked: %s", err)
+			errors.ReportToSentry(err, nil)
+		}
+	}()
+
+	err := s.Deliver(ctx)
+	if err != nil {
+		log.WithField("err", err.Error()).Error("failed to deliver webhook notifications")
+	}
+}
+
+// Deliver sends the notifications that are due, removing the ones accepted
+// from the queue and rescheduling the others.
+func (s *System) Deliver(ctx context.Context) error {
+	for {
+		n, err := s.deliverBatch(ctx)
+		if err != nil || n == 0 {
+			return err
+		}
+	}
+}
+
+// deliverBatch claims a batch of due notifications, sends them and records
+// the outcome, returning their number.  No transaction is held while sending.
+func (s *System) deliverBatch(ctx context.Context) (int, error) {
+	q := &Q{Session: s.HorizonDB}
+
+	var deliveries []Delivery
+	err := q.ClaimDeliveries(&deliveries, 20, time.Now().Add(s.ClaimTimeout))
+	if err != nil {
+		return 0, serrors.Wrap(err, "failed to claim deliveries")
+	}
+
+	for _, d := range deliveries {
+		err = s.send(ctx, d)
+		if err == nil {
+			err = q.DeleteDelivery(d.ID)
+			if err != nil {
+				return 0, serrors.Wrap(err, "failed to remove delivery")
+			}
+			continue
+		}
+
+		attempts := d.Attempts + 1
+		fields := log.F{
+			"delivery":     d.ID,
+			"subscription": d.SubscriptionID,
+			"attempts":     attempts,
+			"reason":       err.Error(),
+		}
+
+		if attempts >= s.MaxAttempts {
+			log.WithFields(fields).Warn("Dropping webhook notification")
+			err = q.DeleteDelivery(d.ID)
+			if err != nil {
+				return 0, serrors.Wrap(err, "failed to remove delivery")
+			}
+			continue
+		}
+
+		log.WithFields(fields).Info("Failed to deliver webhook notification")
+		err = q.RetryDelivery(d.ID, attempts, time.Now().Add(s.backoff(attempts)), err.Error())
+		if err != nil {
+			return 0, serrors.Wrap(err, "failed to reschedule delivery")
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// send POSTs the notification of `d` to its subscription's URL.
+func (s *System) send(ctx context.Context, d Delivery) error {
+	req, err := http.NewRequest(http.MethodPost, d.URL, bytes.NewBufferString(d.Payload))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, fmt.Sprintf("%d", d.ID))
+	req.Header.Set(SignatureHeader, Sign(d.Secret, []byte(d.Payload)))
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// backoff returns the time to wait before the next attempt to deliver a
+// notification that failed `attempts` times.
+func (s *System) backoff(attempts int32) time.Duration {
+	d := s.Backoff
+	for i := int32(1); i < attempts && d < s.MaxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > s.MaxBackoff {
+		d = s.MaxBackoff
+	}
+
+	return d
+}
+
+// Sign returns the signature of a notification `body` sent with `secret`, as
+// found in its SignatureHeader.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Matches returns true if `effect` is notified to the subscription.
+func (s Subscription) Matches(effect history.Effect) bool {
+	if s.Account.Valid && s.Account.String != effect.Account {
+		return false
+	}
+
+	if s.EffectType.Valid && history.EffectType(s.EffectType.Int64) != effect.Type {
+		return false
+	}
+
+	if !s.AssetType.Valid {
+		return true
+	}
+
+	var details map[string]interface{}
+	if err := effect.UnmarshalDetails(&details); err != nil {
+		return false
+	}
+
+	// trade effects hold the sold and bought assets
+	for _, prefix := range []string{"", "sold_", "bought_"} {
+		if details[prefix+"asset_type"] != s.AssetType.String {
+			continue
+		}
+
+		if s.AssetType.String == "native" {
+			return true
+		}
+
+		if details[prefix+"asset_code"] == s.AssetCode.String &&
+			details[prefix+"asset_issuer"] == s.AssetIssuer.String {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ledgerCursor returns the paging token following every effect of ledger
+// `seq`.
+func ledgerCursor(seq int32) string {
+	next := toid.ID{LedgerSequence: seq + 1}
+	return fmt.Sprintf("%d-%d", next.ToInt64()-1, 0)
+}
@@ -0,0 +1,233 @@
+package webhooks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/kinecosystem/go/services/horizon/internal/db2/history"
+	"github.com/kinecosystem/go/services/horizon/internal/test"
+	"github.com/kinecosystem/go/support/render/hal"
+)
+
+type testEffect struct {
+	ID string `json:"id"`
+}
+
+func (e testEffect) PagingToken() string {
+	return e.ID
+}
+
+func TestSystem(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	var (
+		lock     sync.Mutex
+		received []*http.Request
+		bodies   []string
+		status   = http.StatusOK
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		lock.Lock()
+		received = append(received, r)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(status)
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	db := tt.HorizonSession()
+	q := &Q{Session: db}
+	hq := &history.Q{Session: db}
+
+	sys := New(db)
+	sys.Client = server.Client()
+	sys.NewEffect = func(ctx context.Context, e history.Effect, l history.Ledger) (hal.Pageable, error) {
+		return testEffect{ID: e.PagingToken()}, nil
+	}
+
+	account := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	sub := Subscription{
+		URL:        server.URL,
+		Secret:     "secret",
+		Account:    null.StringFrom(account),
+		EffectType: null.IntFrom(int64(history.EffectAccountDebited)),
+	}
+	tt.Require.NoError(q.CreateSubscription(&sub))
+
+	var expected []history.Effect
+	err := hq.Effects().ForAccount(account).OfType(history.EffectAccountDebited).Select(&expected)
+	tt.Require.NoError(err)
+	tt.Require.NotEmpty(expected)
+
+	// effects ingested before the system started aren't notified
+	tt.Require.NoError(sys.Start())
+	tt.Require.NoError(sys.Notify(tt.Ctx))
+	var queued int
+	tt.Require.NoError(db.GetRaw(&queued, `SELECT COUNT(*) FROM webhook_deliveries`))
+	tt.Assert.Equal(0, queued)
+
+	// queues a notification for each matching effect
+	tt.Require.NoError(q.SetCursor(ledgerCursor(0)))
+	tt.Require.NoError(sys.Notify(tt.Ctx))
+	tt.Require.NoError(db.GetRaw(&queued, `SELECT COUNT(*) FROM webhook_deliveries`))
+	tt.Assert.Equal(len(expected), queued)
+
+	// the cursor is kept across restarts, the effects aren't notified again
+	var cursor string
+	tt.Require.NoError(q.Cursor(&cursor))
+	restarted := New(db)
+	restarted.NewEffect = sys.NewEffect
+	tt.Require.NoError(restarted.Start())
+	tt.Require.NoError(restarted.Notify(tt.Ctx))
+	var restartedCursor string
+	tt.Require.NoError(q.Cursor(&restartedCursor))
+	tt.Assert.Equal(cursor, restartedCursor)
+	tt.Require.NoError(db.GetRaw(&queued, `SELECT COUNT(*) FROM webhook_deliveries`))
+	tt.Assert.Equal(len(expected), queued)
+
+	// failed deliveries are retried later
+	lock.Lock()
+	status = http.StatusInternalServerError
+	lock.Unlock()
+	tt.Require.NoError(sys.Deliver(tt.Ctx))
+	tt.Assert.Len(received, len(expected))
+
+	var attempts []int32
+	tt.Require.NoError(db.SelectRaw(&attempts, `SELECT attempts FROM webhook_deliveries`))
+	for _, a := range attempts {
+		tt.Assert.Equal(int32(1), a)
+	}
+
+	tt.Require.NoError(sys.Deliver(tt.Ctx))
+	tt.Assert.Len(received, len(expected))
+
+	// accepted deliveries are removed from the queue
+	lock.Lock()
+	status = http.StatusOK
+	received, bodies = nil, nil
+	lock.Unlock()
+	_, err = db.ExecRaw(`UPDATE webhook_deliveries SET next_attempt_at = now() - interval '1 minute'`)
+	tt.Require.NoError(err)
+	tt.Require.NoError(sys.Deliver(tt.Ctx))
+	tt.Require.Len(received, len(expected))
+	tt.Require.NoError(db.GetRaw(&queued, `SELECT COUNT(*) FROM webhook_deliveries`))
+	tt.Assert.Equal(0, queued)
+
+	for i, r := range received {
+		tt.Assert.Equal("application/json", r.Header.Get("Content-Type"))
+		tt.Assert.Equal(Sign("secret", []byte(bodies[i])), r.Header.Get(SignatureHeader))
+		tt.Assert.Contains(bodies[i], `"subscription_id":"`+sub.PagingToken()+`"`)
+	}
+
+	// removing a subscription removes its pending deliveries
+	tt.Require.NoError(q.SetCursor(ledgerCursor(0)))
+	tt.Require.NoError(sys.Notify(tt.Ctx))
+	ok, err := q.DeleteSubscription(sub.ID)
+	tt.Require.NoError(err)
+	tt.Assert.True(ok)
+	tt.Require.NoError(db.GetRaw(&queued, `SELECT COUNT(*) FROM webhook_deliveries`))
+	tt.Assert.Equal(0, queued)
+}
+
+// Claimed deliveries are sent by a single horizon, outside of any
+// transaction, and are claimed again once the claim times out.
+func TestClaimDeliveries(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	db := tt.HorizonSession()
+	q := &Q{Session: db}
+
+	sub := Subscription{URL: "https://localhost", Secret: "secret"}
+	tt.Require.NoError(q.CreateSubscription(&sub))
+	tt.Require.NoError(q.EnqueueDelivery(sub.ID, `{"n":1}`))
+	tt.Require.NoError(q.EnqueueDelivery(sub.ID, `{"n":2}`))
+
+	var claimed []Delivery
+	tt.Require.NoError(q.ClaimDeliveries(&claimed, 1, time.Now().Add(time.Minute)))
+	tt.Require.Len(claimed, 1)
+	tt.Assert.Equal(sub.URL, claimed[0].URL)
+	tt.Assert.Equal(sub.Secret, claimed[0].Secret)
+
+	var others []Delivery
+	tt.Require.NoError(q.ClaimDeliveries(&others, 20, time.Now().Add(time.Minute)))
+	tt.Require.Len(others, 1)
+	tt.Assert.NotEqual(claimed[0].ID, others[0].ID)
+
+	// nothing is due while claimed
+	var none []Delivery
+	tt.Require.NoError(q.ClaimDeliveries(&none, 20, time.Now().Add(time.Minute)))
+	tt.Assert.Len(none, 0)
+
+	// claims that timed out are claimed again
+	_, err := db.ExecRaw(`UPDATE webhook_deliveries SET next_attempt_at = now() - interval '1 minute' WHERE id = ?`, claimed[0].ID)
+	tt.Require.NoError(err)
+	var again []Delivery
+	tt.Require.NoError(q.ClaimDeliveries(&again, 20, time.Now().Add(time.Minute)))
+	tt.Require.Len(again, 1)
+	tt.Assert.Equal(claimed[0].ID, again[0].ID)
+}
+
+func TestSubscription_Matches(t *testing.T) {
+	issuer := "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4"
+	credited := history.Effect{
+		Account: "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2",
+		Type:    history.EffectAccountCredited,
+		DetailsString: null.StringFrom(
+			`{"amount": "10.0", "asset_type": "credit_alphanum4", "asset_code": "USD", "asset_issuer": "` + issuer + `"}`,
+		),
+	}
+	trade := history.Effect{
+		Account: "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2",
+		Type:    history.EffectTrade,
+		DetailsString: null.StringFrom(
+			`{"sold_asset_type": "native", "bought_asset_type": "credit_alphanum4", "bought_asset_code": "USD", "bought_asset_issuer": "` + issuer + `"}`,
+		),
+	}
+
+	cases := []struct {
+		name     string
+		sub      Subscription
+		effect   history.Effect
+		expected bool
+	}{
+		{"everything", Subscription{}, credited, true},
+		{"account", Subscription{Account: null.StringFrom(credited.Account)}, credited, true},
+		{"other account", Subscription{Account: null.StringFrom(issuer)}, credited, false},
+		{"effect type", Subscription{EffectType: null.IntFrom(int64(history.EffectAccountCredited))}, credited, true},
+		{"other effect type", Subscription{EffectType: null.IntFrom(int64(history.EffectAccountDebited))}, credited, false},
+		{"asset", Subscription{
+			AssetType:   null.StringFrom("credit_alphanum4"),
+			AssetCode:   null.StringFrom("USD"),
+			AssetIssuer: null.StringFrom(issuer),
+		}, credited, true},
+		{"other asset", Subscription{
+			AssetType:   null.StringFrom("credit_alphanum4"),
+			AssetCode:   null.StringFrom("EUR"),
+			AssetIssuer: null.StringFrom(issuer),
+		}, credited, false},
+		{"native", Subscription{AssetType: null.StringFrom("native")}, credited, false},
+		{"traded native", Subscription{AssetType: null.StringFrom("native")}, trade, true},
+		{"traded asset", Subscription{
+			AssetType:   null.StringFrom("credit_alphanum4"),
+			AssetCode:   null.StringFrom("USD"),
+			AssetIssuer: null.StringFrom(issuer),
+		}, trade, true},
+	}
+
+	for _, kase := range cases {
+		t.Run(kase.name, func(t *testing.T) {
+			if kase.sub.Matches(kase.effect) != kase.expected {
+				t.Errorf("expected Matches to return %v", kase.expected)
+			}
+		})
+	}
+}
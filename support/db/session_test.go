@@ -1,6 +1,7 @@
 package db
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/kinecosystem/go/support/db/dbtest"
@@ -76,6 +77,15 @@ func TestSession(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal(0, count)
 
+	// Ensure transactions can be started with options
+	require.NoError(sess.BeginTx(&sql.TxOptions{Isolation: sql.LevelRepeatableRead}), "begin failed")
+	var isolation string
+	err = sess.GetRaw(&isolation, "SHOW transaction_isolation")
+	assert.NoError(err)
+	assert.Equal("repeatable read", isolation)
+	assert.Error(sess.BeginTx(nil), "began a transaction twice")
+	assert.NoError(sess.Rollback(), "rollback failed")
+
 	// ensure that selecting into a populated slice clears the slice first
 	db.Load(testSchema)
 	require.Len(names, 3, "ids slice was not preloaded with data")
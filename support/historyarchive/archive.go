@@ -2,7 +2,7 @@
 // under the Apache License, Version 2.0. See the COPYING file at the root
 // of this distribution or at http://www.apache.org/licenses/LICENSE-2.0
 
-package archivist
+package historyarchive
 
 import (
 	"bytes"
@@ -198,7 +198,7 @@ func Connect(u string, opts ConnectOptions) (*Archive, error) {
 	} else if parsed.Scheme == "file" {
 		pth = path.Join(parsed.Host, pth)
 		arch.backend = MakeFsBackend(pth, opts)
-	} else if parsed.Scheme == "http" {
+	} else if parsed.Scheme == "http" || parsed.Scheme == "https" {
 		arch.backend = MakeHttpBackend(parsed, opts)
 	} else if parsed.Scheme == "mock" {
 		arch.backend = MakeMockBackend(opts)
@@ -0,0 +1,85 @@
+// stellar-inspect is a small utility that decodes a transaction envelope,
+// result or meta from its base64-encoded xdr and prints it in a human
+// readable form.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/kinecosystem/go/inspect"
+)
+
+var (
+	infile     = flag.String("infile", "", "file holding the xdr, read from stdin when empty")
+	format     = flag.String("format", "text", "output format: text or json")
+	kind       = flag.String("type", "auto", "type of the xdr: auto, envelope, result or meta")
+	passphrase = flag.String("network", "", "network passphrase, used to hash the transaction and verify signatures")
+	signers    = flag.String("signers", "", "comma separated public keys to match the signatures to")
+)
+
+func main() {
+	flag.Parse()
+
+	var (
+		raw []byte
+		err error
+	)
+
+	switch {
+	case flag.NArg() > 0:
+		raw = []byte(flag.Arg(0))
+	case *infile != "":
+		raw, err = ioutil.ReadFile(*infile)
+	default:
+		raw, err = ioutil.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	opts := inspect.Options{NetworkPassphrase: *passphrase}
+	if *signers != "" {
+		opts.Signers = strings.Split(*signers, ",")
+	}
+
+	b64 := strings.TrimSpace(string(raw))
+
+	var decoded interface{}
+	switch *kind {
+	case "auto":
+		decoded, err = inspect.Decode(b64, opts)
+	case "envelope":
+		decoded, err = inspect.DecodeEnvelope(b64, opts)
+	case "result":
+		decoded, err = inspect.DecodeResult(b64)
+	case "meta":
+		decoded, err = inspect.DecodeMeta(b64)
+	default:
+		err = fmt.Errorf("invalid type: %s", *kind)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch *format {
+	case "json":
+		out, err := json.MarshalIndent(decoded, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(out))
+	case "text":
+		err = inspect.WriteText(os.Stdout, decoded)
+		if err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatalf("invalid format: %s", *format)
+	}
+}